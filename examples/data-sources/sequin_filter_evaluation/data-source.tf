@@ -0,0 +1,30 @@
+# Filter evaluation data source examples
+# Dry-runs a filter against sample records before apply
+
+# Example 1: Inline records with expected outcomes
+data "sequin_filter_evaluation" "paid_only" {
+  filter = "paid-only"
+  records = [
+    jsonencode({ id = 1, status = "paid" }),
+    jsonencode({ id = 2, status = "pending" }),
+  ]
+}
+
+check "paid_only_filter" {
+  assert {
+    condition     = [for r in data.sequin_filter_evaluation.paid_only.results : r.matched] == [true, false]
+    error_message = "paid-only should match paid orders only."
+  }
+}
+
+# Example 2: Sample live rows to see how much traffic a filter lets through
+data "sequin_filter_evaluation" "paid_only_live" {
+  filter      = "paid-only"
+  database    = sequin_database.main.name
+  table       = "public.orders"
+  sample_size = 100
+}
+
+output "paid_only_match_rate" {
+  value = "${data.sequin_filter_evaluation.paid_only_live.matched_count}/${data.sequin_filter_evaluation.paid_only_live.total_count}"
+}
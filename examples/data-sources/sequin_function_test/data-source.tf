@@ -0,0 +1,35 @@
+# Function test data source examples
+# Unit-tests functions against sample messages during plan
+
+# Example 1: Assert a transform reshapes an order as expected
+data "sequin_function_test" "reshape_order" {
+  function = "reshape-order"
+  message = jsonencode({
+    action = "insert"
+    record = { id = 1, total_cents = 1299 }
+    metadata = { table_schema = "public", table_name = "orders" }
+  })
+}
+
+check "reshape_order_output" {
+  assert {
+    condition     = data.sequin_function_test.reshape_order.success && jsondecode(data.sequin_function_test.reshape_order.output).total == 12.99
+    error_message = "reshape-order returned an unexpected result."
+  }
+}
+
+# Example 2: Assert a filter drops test accounts
+data "sequin_function_test" "skip_test_accounts" {
+  function = "skip-test-accounts"
+  message = jsonencode({
+    action = "insert"
+    record = { id = 2, email = "qa@example.test" }
+  })
+}
+
+check "skip_test_accounts" {
+  assert {
+    condition     = data.sequin_function_test.skip_test_accounts.output == "false"
+    error_message = "skip-test-accounts should reject test accounts."
+  }
+}
@@ -0,0 +1,47 @@
+# Replication impact data source examples
+
+# Example 1: Size of the tables a new sink would stream, from its source filters
+data "sequin_replication_impact" "events" {
+  database = sequin_database.main.name
+
+  source = {
+    include_schemas = ["public"]
+    exclude_tables  = ["public.audit_log"]
+  }
+}
+
+output "events_sink_volume" {
+  value = {
+    rows  = data.sequin_replication_impact.events.total_row_count
+    bytes = data.sequin_replication_impact.events.total_size_bytes
+  }
+}
+
+# Example 2: Listed tables, sharing the list with the sink so they cannot drift
+locals {
+  order_tables = ["public.orders", "public.order_items"]
+}
+
+data "sequin_replication_impact" "orders" {
+  database = sequin_database.main.name
+  tables   = local.order_tables
+}
+
+resource "sequin_sink_consumer" "orders" {
+  name     = "orders"
+  database = sequin_database.main.name
+  tables   = [for t in local.order_tables : { name = t }]
+
+  destination = {
+    type  = "kafka"
+    hosts = "broker1:9092"
+    topic = "orders"
+  }
+
+  lifecycle {
+    precondition {
+      condition     = coalesce(data.sequin_replication_impact.orders.total_size_bytes, 0) < 50 * 1024 * 1024 * 1024
+      error_message = "The sink would backfill more than 50 GiB; split it up or raise the limit after review."
+    }
+  }
+}
@@ -0,0 +1,22 @@
+# Replication slots data source examples
+
+# Example 1: Every slot across all databases
+data "sequin_replication_slots" "all" {}
+
+# Example 2: Slots of a single database
+data "sequin_replication_slots" "main" {
+  database = sequin_database.main.name
+}
+
+# Example 3: Alert on runaway WAL growth from a scheduled plan
+check "wal_retention" {
+  assert {
+    condition     = coalesce(data.sequin_replication_slots.all.max_lag_bytes, 0) < 10 * 1024 * 1024 * 1024
+    error_message = "A replication slot is retaining more than 10 GiB of WAL."
+  }
+
+  assert {
+    condition     = data.sequin_replication_slots.all.slot_active != false
+    error_message = "An inactive replication slot is retaining WAL."
+  }
+}
@@ -0,0 +1,28 @@
+# Sensitive data exposures data source examples
+
+# Example 1: Every sink streaming classified data
+data "sequin_sensitive_data_exposures" "all" {
+  depends_on = [sequin_data_classification.users_pii, sequin_sink_consumer.users]
+}
+
+# Example 2: Fail the plan when a sink streams sensitive columns without a
+# reviewed transform
+locals {
+  reviewed_transforms = ["mask-pii"]
+  unredacted = [
+    for e in data.sequin_sensitive_data_exposures.all.exposures : e
+    if !contains(local.reviewed_transforms, e.transform == null ? "" : e.transform)
+  ]
+}
+
+check "no_unredacted_pii" {
+  assert {
+    condition     = length(local.unredacted) == 0
+    error_message = "Sinks stream sensitive data: ${join(", ", distinct(local.unredacted[*].sink_consumer))}"
+  }
+}
+
+# Example 3: A single database
+data "sequin_sensitive_data_exposures" "main" {
+  database = sequin_database.main.name
+}
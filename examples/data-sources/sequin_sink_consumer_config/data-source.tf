@@ -0,0 +1,16 @@
+# Sink consumer config data source examples
+
+# Example 1: Render the server-side configuration of a sink
+data "sequin_sink_consumer_config" "example" {
+  sink_consumer = sequin_sink_consumer.example.name
+}
+
+output "example_config" {
+  value = data.sequin_sink_consumer_config.example.rendered_config
+}
+
+# Example 2: Export to YAML for the Sequin CLI
+resource "local_file" "example_sequin_yaml" {
+  filename = "${path.module}/exports/example.yaml"
+  content  = yamlencode(jsondecode(data.sequin_sink_consumer_config.example.rendered_config))
+}
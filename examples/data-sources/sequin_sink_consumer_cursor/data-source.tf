@@ -0,0 +1,24 @@
+# Sink consumer cursor data source examples
+
+# Example 1: Read the cursor of a sink
+data "sequin_sink_consumer_cursor" "example" {
+  sink_consumer = sequin_sink_consumer.example.name
+}
+
+output "delivered_up_to" {
+  value = data.sequin_sink_consumer_cursor.example.lsn
+}
+
+# Example 2: Hand the position over to a replacement sink
+resource "sequin_sink_consumer" "replacement" {
+  name             = "example-v2"
+  database         = sequin_database.main.id
+  cursor_reset_lsn = data.sequin_sink_consumer_cursor.example.lsn
+
+  tables = [{ name = "public.orders" }]
+
+  destination = {
+    type          = "webhook"
+    http_endpoint = "https://api.example.com"
+  }
+}
@@ -0,0 +1,27 @@
+# Sink consumer metrics data source examples
+
+# Example 1: Read metrics for a sink
+data "sequin_sink_consumer_metrics" "example" {
+  sink_consumer = sequin_sink_consumer.example.name
+}
+
+# Example 2: Fail a check when the sink falls behind
+check "sink_lag" {
+  assert {
+    condition     = data.sequin_sink_consumer_metrics.example.consumer_lag_seconds < 300
+    error_message = "The sink is more than 5 minutes behind."
+  }
+}
+
+# Example 3: Check-friendly booleans; null means the API has no data yet
+check "sink_health" {
+  assert {
+    condition     = data.sequin_sink_consumer_metrics.example.healthy
+    error_message = "The sink has failing messages."
+  }
+
+  assert {
+    condition     = data.sequin_sink_consumer_metrics.example.backfill_complete != false
+    error_message = "The sink's backfill has not finished."
+  }
+}
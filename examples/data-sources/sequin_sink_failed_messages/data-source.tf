@@ -0,0 +1,18 @@
+# Sink failed messages data source examples
+# Lists messages stuck in a sink's failed or discarded set
+
+# Example 1: Every failed and discarded message for a sink
+data "sequin_sink_failed_messages" "example" {
+  sink_consumer = sequin_sink_consumer.example.name
+}
+
+# Example 2: Surface delivery errors for messages still being retried
+data "sequin_sink_failed_messages" "failing" {
+  sink_consumer = sequin_sink_consumer.example.name
+  state         = "failed"
+  limit         = 20
+}
+
+output "failing_errors" {
+  value = { for m in data.sequin_sink_failed_messages.failing.messages : m.id => m.last_error }
+}
@@ -0,0 +1,21 @@
+# Sink messages data source examples
+# Samples recent messages to verify end-to-end delivery after apply
+
+# Example 1: Most recent messages for a sink (pending and delivered)
+data "sequin_sink_messages" "example" {
+  sink_consumer = sequin_sink_consumer.example.name
+}
+
+# Example 2: Smoke test that the sink has delivered at least one message
+data "sequin_sink_messages" "delivered" {
+  sink_consumer = sequin_sink_consumer.example.name
+  state         = "delivered"
+  limit         = 5
+}
+
+check "sink_delivers" {
+  assert {
+    condition     = length(data.sequin_sink_messages.delivered.messages) > 0
+    error_message = "The sink has not delivered any messages yet."
+  }
+}
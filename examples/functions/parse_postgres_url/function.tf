@@ -0,0 +1,25 @@
+# parse_postgres_url function examples
+
+variable "database_url" {
+  type      = string
+  sensitive = true
+}
+
+# Example 1: Configure a database from a single connection string secret
+locals {
+  db = provider::sequin::parse_postgres_url(var.database_url)
+}
+
+resource "sequin_database" "main" {
+  name     = "main"
+  hostname = local.db.hostname
+  port     = local.db.port
+  database = local.db.database
+  username = local.db.username
+  password = local.db.password
+}
+
+# Example 2: Inspect the parts of a URL
+output "database_host" {
+  value = provider::sequin::parse_postgres_url("postgres://sequin@db.example.com:5432/app").hostname
+}
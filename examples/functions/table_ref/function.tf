@@ -0,0 +1,22 @@
+# table_ref function examples
+
+# Example 1: Normalize sink consumer tables at plan time
+resource "sequin_sink_consumer" "orders" {
+  # ...
+  tables = [
+    { name = provider::sequin::table_ref("orders") },                # public.orders
+    { name = provider::sequin::table_ref("Sales.Returns") },         # sales.returns
+    { name = provider::sequin::table_ref("\"Sales\".\"Refunds\"") }, # Sales.Refunds
+  ]
+}
+
+# Example 2: Backfill a table named in a variable
+variable "backfill_table" {
+  type    = string
+  default = "public.orders"
+}
+
+resource "sequin_backfill" "orders" {
+  sink_consumer = sequin_sink_consumer.orders.name
+  table         = provider::sequin::table_ref(var.backfill_table)
+}
@@ -11,3 +11,9 @@ provider "sequin" {
   endpoint = "https://your-instance.sequin.io" # or SEQUIN_ENDPOINT env var
   api_key  = var.sequin_api_key                # or SEQUIN_API_KEY env var
 }
+
+# Sequin Cloud: the endpoint defaults to https://api.sequinstream.com
+# provider "sequin" {
+#   platform = "cloud" # or SEQUIN_PLATFORM env var
+#   api_key  = var.sequin_api_key
+# }
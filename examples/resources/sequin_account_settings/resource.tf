@@ -0,0 +1,8 @@
+# Account settings example
+# Account-wide: declare at most one sequin_account_settings per account
+
+resource "sequin_account_settings" "this" {
+  default_timestamp_format     = "unix_microsecond"
+  default_load_shedding_policy = "discard_on_full"
+  notification_email           = "data-platform@example.com"
+}
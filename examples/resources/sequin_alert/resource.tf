@@ -0,0 +1,32 @@
+# Alert examples
+# Alerts on sink consumer health, declared next to the sinks they watch
+
+# Example 1: Failing messages on a single sink
+resource "sequin_alert" "orders_failing" {
+  name             = "orders-failing"
+  sink_consumer    = sequin_sink_consumer.orders.name
+  condition        = "failing_messages"
+  threshold        = 1
+  duration_seconds = 300
+}
+
+# Example 2: Consumer lag across every sink, notifying Slack and on-call
+resource "sequin_alert" "lag" {
+  name      = "consumer-lag"
+  condition = "consumer_lag"
+  threshold = 600
+
+  notification_channels = [
+    sequin_notification_channel.slack.id,
+    sequin_notification_channel.pagerduty.id,
+  ]
+}
+
+# Example 3: Keep an alert defined but muted during a migration
+resource "sequin_alert" "muted" {
+  name          = "orders-backlog"
+  sink_consumer = sequin_sink_consumer.orders.name
+  condition     = "failing_messages"
+  threshold     = 100
+  enabled       = false
+}
@@ -0,0 +1,56 @@
+# Audit log export examples
+# Ships Sequin audit logs to long-term storage or a SIEM
+
+# Example 1: S3 bucket
+resource "sequin_audit_log_export" "s3" {
+  name = "compliance-archive"
+
+  destination = {
+    type              = "s3"
+    bucket            = "acme-sequin-audit"
+    region            = "us-east-1"
+    prefix            = "sequin/"
+    access_key_id     = var.aws_access_key_id
+    secret_access_key = var.aws_secret_access_key
+  }
+}
+
+# Example 2: HTTP endpoint with an auth header
+resource "sequin_audit_log_export" "siem" {
+  name = "siem"
+
+  destination = {
+    type = "http"
+    url  = "https://siem.example.com/ingest"
+    headers = {
+      Authorization = "Bearer ${var.siem_token}"
+    }
+  }
+}
+
+# Example 3: Temporarily pause shipping without deleting the export
+resource "sequin_audit_log_export" "paused" {
+  name    = "paused-archive"
+  enabled = false
+
+  destination = {
+    type   = "s3"
+    bucket = "acme-sequin-audit-staging"
+    region = "us-east-1"
+  }
+}
+
+# Example 4: Self-hosted MinIO
+resource "sequin_audit_log_export" "minio" {
+  name = "minio-archive"
+
+  destination = {
+    type              = "s3"
+    bucket            = "sequin-audit"
+    region            = "us-east-1"
+    endpoint_url      = "https://minio.internal:9000"
+    force_path_style  = true
+    access_key_id     = var.minio_access_key
+    secret_access_key = var.minio_secret_key
+  }
+}
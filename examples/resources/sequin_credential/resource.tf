@@ -0,0 +1,48 @@
+# Credential examples
+# Named secrets shared by sinks through destination.credential_ref
+
+# Example 1: Kafka SASL credentials shared by every Kafka sink
+resource "sequin_credential" "kafka" {
+  name = "kafka-prod"
+  secrets = {
+    username = "sequin"
+    password = var.kafka_password
+  }
+}
+
+resource "sequin_sink_consumer" "orders" {
+  name     = "orders-to-kafka"
+  database = sequin_database.main.name
+  tables   = [{ name = "public.orders" }]
+
+  destination = {
+    type           = "kafka"
+    hosts          = "broker-1:9092,broker-2:9092"
+    topic          = "orders"
+    tls            = true
+    sasl_mechanism = "SCRAM-SHA-512"
+    credential_ref = sequin_credential.kafka.name
+  }
+}
+
+# Example 2: AWS access keys for SQS and Kinesis sinks
+resource "sequin_credential" "aws" {
+  name = "aws-streaming"
+  secrets = {
+    access_key_id     = var.aws_access_key_id
+    secret_access_key = var.aws_secret_access_key
+  }
+}
+
+resource "sequin_sink_consumer" "events" {
+  name     = "events-to-sqs"
+  database = sequin_database.main.name
+  tables   = [{ name = "public.events" }]
+
+  destination = {
+    type           = "sqs"
+    queue_url      = "https://sqs.us-east-1.amazonaws.com/123456789012/events"
+    region         = "us-east-1"
+    credential_ref = sequin_credential.aws.name
+  }
+}
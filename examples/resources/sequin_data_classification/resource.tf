@@ -0,0 +1,18 @@
+# Data classification examples
+# Tag tables and columns as sensitive so exposure checks can find unredacted sinks
+
+# Example 1: Personal data in selected columns
+resource "sequin_data_classification" "users_pii" {
+  database       = sequin_database.main.name
+  table          = "public.users"
+  columns        = ["email", "phone", "ssn"]
+  classification = "pii"
+  note           = "GDPR Art. 4(1)"
+}
+
+# Example 2: A whole table of cardholder data
+resource "sequin_data_classification" "cards" {
+  database       = sequin_database.main.name
+  table          = "public.payment_cards"
+  classification = "pci"
+}
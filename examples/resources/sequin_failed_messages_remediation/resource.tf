@@ -0,0 +1,24 @@
+# Failed message remediation examples
+# Each run happens on create; change triggers to run the operation again
+
+# Example 1: Re-deliver every failed message once the destination is fixed
+resource "sequin_failed_messages_remediation" "redeliver_all" {
+  sink_consumer = sequin_sink_consumer.example.name
+  operation     = "redeliver"
+
+  triggers = {
+    run = "2024-06-01"
+  }
+}
+
+# Example 2: Purge the discarded messages listed by the data source
+data "sequin_sink_failed_messages" "discarded" {
+  sink_consumer = sequin_sink_consumer.example.name
+  state         = "discarded"
+}
+
+resource "sequin_failed_messages_remediation" "purge_discarded" {
+  sink_consumer = sequin_sink_consumer.example.name
+  operation     = "purge"
+  message_ids   = data.sequin_sink_failed_messages.discarded.message_ids
+}
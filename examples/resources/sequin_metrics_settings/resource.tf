@@ -0,0 +1,17 @@
+# Metrics settings examples
+# Account-wide: declare at most one sequin_metrics_settings per Sequin instance
+
+variable "metrics_token" {
+  type      = string
+  sensitive = true
+}
+
+# Example: Require a bearer token for Prometheus scrapes
+resource "sequin_metrics_settings" "this" {
+  enabled    = true
+  auth_token = var.metrics_token
+}
+
+output "metrics_endpoint" {
+  value = sequin_metrics_settings.this.endpoint
+}
@@ -0,0 +1,36 @@
+# Notification channel examples
+# Destinations for sequin_alert notifications
+
+# Example 1: Slack incoming webhook
+resource "sequin_notification_channel" "slack" {
+  name              = "data-alerts"
+  type              = "slack"
+  slack_webhook_url = var.slack_webhook_url
+}
+
+# Example 2: HTTP webhook with a bearer token
+resource "sequin_notification_channel" "pagerduty" {
+  name          = "pagerduty"
+  type          = "webhook"
+  webhook_url   = "https://events.pagerduty.com/integration/${var.pagerduty_key}/enqueue"
+  webhook_token = var.pagerduty_token
+}
+
+# Example 3: Email
+resource "sequin_notification_channel" "email" {
+  name            = "data-platform"
+  type            = "email"
+  email_addresses = ["data-platform@example.com", "oncall@example.com"]
+}
+
+# Example 4: Route an alert to the channels above
+resource "sequin_alert" "failing" {
+  name      = "failing-messages"
+  condition = "failing_messages"
+  threshold = 1
+
+  notification_channels = [
+    sequin_notification_channel.slack.id,
+    sequin_notification_channel.email.id,
+  ]
+}
@@ -0,0 +1,28 @@
+# Replay examples
+# Re-delivers a sink consumer's messages as a reviewed, approved apply
+
+# Example 1: Replay everything since an incident started
+resource "sequin_replay" "incident" {
+  sink_consumer  = sequin_sink_consumer.orders.name
+  from_timestamp = "2024-05-01T12:00:00Z"
+}
+
+# Example 2: Replay from a replication LSN
+resource "sequin_replay" "from_lsn" {
+  sink_consumer = sequin_sink_consumer.orders.name
+  from_lsn      = "0/16B3748"
+}
+
+# Example 3: Runbook step that re-runs whenever the incident ticket changes
+resource "sequin_replay" "runbook" {
+  sink_consumer  = sequin_sink_consumer.orders.name
+  from_timestamp = var.replay_from
+
+  triggers = {
+    ticket = var.incident_ticket
+  }
+}
+
+output "replayed_messages" {
+  value = sequin_replay.runbook.message_count
+}
@@ -69,8 +69,145 @@ resource "sequin_sink_consumer" "webhook" {
     http_endpoint      = "https://api.example.com"
     http_endpoint_path = "/webhook/notifications"
     batch              = true
+    batch_format       = "ndjson"
+    batch_max_bytes    = 1048576
   }
 
   filter    = "my-filter-function"
   transform = "my-transform-function"
 }
+
+# Webhook delivering to a shared HTTP endpoint (with its auth headers) by ID
+resource "sequin_sink_consumer" "webhook_shared" {
+  name     = "invoices-webhook"
+  database = sequin_database.main.id
+
+  tables = [{ name = "public.invoices" }]
+
+  destination = {
+    type               = "webhook"
+    http_endpoint_id   = var.billing_http_endpoint_id
+    http_endpoint_path = "/webhook/invoices"
+  }
+}
+
+# Cutover: start delivering from where the previous sink stopped
+data "sequin_sink_consumer_cursor" "orders_v1" {
+  sink_consumer = "orders-v1"
+}
+
+resource "sequin_sink_consumer" "orders_v2" {
+  name             = "orders-v2"
+  database         = sequin_database.main.id
+  cursor_reset_lsn = data.sequin_sink_consumer_cursor.orders_v1.lsn
+
+  tables = [{ name = "public.orders" }]
+
+  destination = {
+    type          = "webhook"
+    http_endpoint = "https://api.example.com"
+  }
+}
+
+# Slim messages without a transform: drop a sensitive column, old values and
+# most of the metadata envelope
+resource "sequin_sink_consumer" "users_slim" {
+  name     = "users-slim"
+  database = sequin_database.main.id
+
+  tables  = [{ name = "public.users" }]
+  actions = ["insert", "update"]
+
+  message_shape = {
+    exclude_columns    = ["public.users.password_hash"]
+    include_old_values = false
+    metadata_fields    = ["table_name", "commit_timestamp"]
+  }
+
+  destination = {
+    type          = "webhook"
+    http_endpoint = "https://api.example.com"
+  }
+}
+
+# Partition by tenant so each downstream consumer owns whole tenants, while
+# messages stay grouped (ordered) per order
+resource "sequin_sink_consumer" "orders_by_tenant" {
+  name     = "orders-by-tenant"
+  database = sequin_database.main.id
+
+  tables = [{ name = "public.orders", group_column_names = ["id"] }]
+
+  destination = {
+    type                     = "kafka"
+    hosts                    = "broker1:9092,broker2:9092"
+    topic                    = "orders.by-tenant"
+    partition_key_expression = "tenant_id"
+  }
+}
+
+# Fail over to a second region when the primary queue keeps failing for two
+# minutes, and come back once it recovers
+resource "sequin_sink_consumer" "orders_dr" {
+  name     = "orders-with-failover"
+  database = sequin_database.main.id
+
+  tables = [{ name = "public.orders" }]
+
+  destination = {
+    type                   = "sqs"
+    queue_url              = "https://sqs.us-east-1.amazonaws.com/123456789012/orders"
+    region                 = "us-east-1"
+    aws_credentials_source = "instance_profile"
+  }
+
+  failover_destination = {
+    type                    = "sqs"
+    queue_url               = "https://sqs.us-west-2.amazonaws.com/123456789012/orders-dr"
+    region                  = "us-west-2"
+    aws_credentials_source  = "instance_profile"
+    unavailable_for_seconds = 120
+    conditions              = ["connection_error", "timeout", "server_error"]
+  }
+}
+
+# Move the sink to a new Kafka cluster without a delivery gap: the new sink
+# must become active, and is backfilled, before the old one is deleted
+resource "sequin_sink_consumer" "orders_migration" {
+  name     = "orders-migration"
+  database = sequin_database.main.id
+
+  tables = [{ name = "public.orders" }]
+
+  destination = {
+    type  = "kafka"
+    hosts = "new-broker1:9092,new-broker2:9092"
+    topic = "orders"
+  }
+
+  blue_green = {
+    health_timeout_seconds = 600
+    backfill               = true
+  }
+}
+
+# Rotate the broker password without pausing the sink: after the apply the
+# sink is watched for two minutes and the old password is restored if
+# delivery starts failing
+resource "sequin_sink_consumer" "orders_rotated" {
+  name     = "orders-rotated"
+  database = sequin_database.main.id
+
+  tables = [{ name = "public.orders" }]
+
+  destination = {
+    type           = "kafka"
+    hosts          = "broker1:9092"
+    topic          = "orders"
+    sasl_mechanism = "SCRAM-SHA-512"
+    username       = "sequin"
+    password       = var.kafka_password
+  }
+
+  credential_verify_seconds = 120
+}
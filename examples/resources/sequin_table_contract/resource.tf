@@ -0,0 +1,36 @@
+# Table contract examples
+# Expected columns for streamed tables, so source schema drift is caught early
+
+# Example 1: Core columns of an orders table
+resource "sequin_table_contract" "orders" {
+  database = sequin_database.main.name
+  table    = "public.orders"
+
+  columns = [
+    { name = "id", type = "bigint", nullable = false },
+    { name = "customer_id", type = "bigint" },
+    { name = "total", type = "numeric" },
+    { name = "inserted_at", type = "timestamp with time zone" },
+  ]
+}
+
+# Example 2: Strict contract that also rejects new columns
+resource "sequin_table_contract" "payments" {
+  database            = sequin_database.main.name
+  table               = "billing.payments"
+  allow_extra_columns = false
+
+  columns = [
+    { name = "id", type = "uuid", nullable = false },
+    { name = "amount_cents", type = "integer", nullable = false },
+    { name = "currency", type = "text", nullable = false },
+  ]
+}
+
+# Example 3: Fail runs when a contract is violated
+check "payments_contract" {
+  assert {
+    condition     = sequin_table_contract.payments.status != "violated"
+    error_message = join("\n", sequin_table_contract.payments.violations[*].message)
+  }
+}
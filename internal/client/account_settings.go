@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// accountSettingsPath is the account-wide settings path
+const accountSettingsPath = "/api/account/settings"
+
+// GetAccountSettings retrieves the account settings
+func (c *Client) GetAccountSettings(ctx context.Context) (*AccountSettingsResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, accountSettingsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AccountSettingsResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get account settings: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	return &result, nil
+}
+
+// UpdateAccountSettings updates the account settings
+func (c *Client) UpdateAccountSettings(ctx context.Context, req *AccountSettingsRequest) (*AccountSettingsResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, accountSettingsPath, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AccountSettingsResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to update account settings: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Updated account settings")
+	return &result, nil
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CreateAlert creates a new alert
+func (c *Client) CreateAlert(ctx context.Context, req *AlertRequest) (*AlertResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/api/alerts", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AlertResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to create alert: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Created alert", map[string]any{"id": result.ID, "name": result.Name})
+	return &result, nil
+}
+
+// GetAlert retrieves an alert by ID
+func (c *Client) GetAlert(ctx context.Context, id string) (*AlertResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/alerts/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "alert", id)
+	}
+
+	var result AlertResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get alert: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	return &result, nil
+}
+
+// UpdateAlert updates an existing alert
+func (c *Client) UpdateAlert(ctx context.Context, id string, req *AlertRequest) (*AlertResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/alerts/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AlertResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to update alert: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Updated alert", map[string]any{"id": result.ID})
+	return &result, nil
+}
+
+// DeleteAlert deletes an alert by ID
+func (c *Client) DeleteAlert(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/alerts/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		tflog.Warn(ctx, "Alert already deleted", map[string]any{"id": id})
+		return nil
+	}
+
+	if err := c.handleResponse(ctx, resp, nil); err != nil {
+		return fmt.Errorf("failed to delete alert: %w", err)
+	}
+
+	tflog.Info(ctx, "Deleted alert", map[string]any{"id": id})
+	return nil
+}
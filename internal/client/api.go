@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// SequinAPI is the part of the Sequin API client resources and data sources
+// depend on, so their logic can be tested against clienttest.Mock instead of a
+// live server
+type SequinAPI interface {
+	// Provider settings
+	IsCloud() bool
+	SupportsFeature(feature string) (bool, string)
+	ServerVersion() string
+	DefaultDatabaseName() string
+	CreateRollbackSkipped() bool
+	EnrichmentValidated() bool
+	DefaultAnnotationValues() map[string]string
+
+	// Server
+	Health(ctx context.Context) (*HealthResponse, error)
+
+	// Account
+	GetAccountSettings(ctx context.Context) (*AccountSettingsResponse, error)
+	UpdateAccountSettings(ctx context.Context, req *AccountSettingsRequest) (*AccountSettingsResponse, error)
+	GetMetricsSettings(ctx context.Context) (*MetricsSettingsResponse, error)
+	UpdateMetricsSettings(ctx context.Context, req *MetricsSettingsRequest) (*MetricsSettingsResponse, error)
+
+	// Alerts
+	CreateAlert(ctx context.Context, req *AlertRequest) (*AlertResponse, error)
+	GetAlert(ctx context.Context, id string) (*AlertResponse, error)
+	UpdateAlert(ctx context.Context, id string, req *AlertRequest) (*AlertResponse, error)
+	DeleteAlert(ctx context.Context, id string) error
+
+	// Audit log exports
+	CreateAuditLogExport(ctx context.Context, req *AuditLogExportRequest) (*AuditLogExportResponse, error)
+	GetAuditLogExport(ctx context.Context, id string) (*AuditLogExportResponse, error)
+	UpdateAuditLogExport(ctx context.Context, id string, req *AuditLogExportRequest) (*AuditLogExportResponse, error)
+	DeleteAuditLogExport(ctx context.Context, id string) error
+
+	// Backfills
+	CreateBackfill(ctx context.Context, sinkIDOrName string, req *BackfillCreateRequest) (*BackfillResponse, error)
+	GetBackfill(ctx context.Context, sinkIDOrName string, backfillID string) (*BackfillResponse, error)
+	UpdateBackfill(ctx context.Context, sinkIDOrName string, backfillID string, req *BackfillUpdateRequest) (*BackfillResponse, error)
+	DeleteBackfill(ctx context.Context, sinkIDOrName string, backfillID string) error
+	ListBackfills(ctx context.Context, sinkIDOrName string) ([]BackfillResponse, error)
+
+	// Credentials
+	CreateCredential(ctx context.Context, req *CredentialRequest) (*CredentialResponse, error)
+	GetCredential(ctx context.Context, id string) (*CredentialResponse, error)
+	UpdateCredential(ctx context.Context, id string, req *CredentialRequest) (*CredentialResponse, error)
+	DeleteCredential(ctx context.Context, id string) error
+
+	// Data classifications
+	CreateDataClassification(ctx context.Context, req *DataClassificationRequest) (*DataClassificationResponse, error)
+	GetDataClassification(ctx context.Context, id string) (*DataClassificationResponse, error)
+	UpdateDataClassification(ctx context.Context, id string, req *DataClassificationRequest) (*DataClassificationResponse, error)
+	DeleteDataClassification(ctx context.Context, id string) error
+	ListDataClassifications(ctx context.Context) ([]DataClassificationResponse, error)
+
+	// Databases
+	CreateDatabase(ctx context.Context, req *DatabaseRequest) (*DatabaseResponse, error)
+	GetDatabase(ctx context.Context, id string) (*DatabaseResponse, error)
+	UpdateDatabase(ctx context.Context, id string, req *DatabaseRequest) (*DatabaseResponse, error)
+	DeleteDatabase(ctx context.Context, id string) error
+	TestDatabaseConnection(ctx context.Context, req *DatabaseRequest) (*DatabaseConnectionTestResponse, error)
+	ListReplicationSlots(ctx context.Context, databaseIDOrName string) ([]ReplicationSlotStatus, error)
+	EachTableStats(ctx context.Context, databaseIDOrName string, fn func(TableStats) error) error
+	ListTableColumns(ctx context.Context, databaseIDOrName, table string) ([]TableColumn, error)
+
+	// Functions
+	GetFunction(ctx context.Context, idOrName string) (*FunctionResponse, error)
+	ValidateFunction(ctx context.Context, idOrName string, req *FunctionValidationRequest) (*FunctionValidationResponse, error)
+	EvaluateFilter(ctx context.Context, idOrName string, req *FilterEvaluationRequest) ([]FilterEvaluationResult, error)
+	TestFunction(ctx context.Context, idOrName string, req *FunctionTestRequest) (*FunctionTestResponse, error)
+
+	// HTTP endpoints
+	GetHTTPEndpoint(ctx context.Context, idOrName string) (*HTTPEndpointResponse, error)
+
+	// Notification channels
+	CreateNotificationChannel(ctx context.Context, req *NotificationChannelRequest) (*NotificationChannelResponse, error)
+	GetNotificationChannel(ctx context.Context, id string) (*NotificationChannelResponse, error)
+	UpdateNotificationChannel(ctx context.Context, id string, req *NotificationChannelRequest) (*NotificationChannelResponse, error)
+	DeleteNotificationChannel(ctx context.Context, id string) error
+
+	// Sink consumers
+	CreateSinkConsumer(ctx context.Context, req *SinkConsumerRequest) (*SinkConsumerResponse, error)
+	GetSinkConsumer(ctx context.Context, id string) (*SinkConsumerResponse, error)
+	UpdateSinkConsumer(ctx context.Context, id string, req *SinkConsumerRequest) (*SinkConsumerResponse, error)
+	DeleteSinkConsumer(ctx context.Context, id string) error
+	ListSinkConsumers(ctx context.Context, opts SinkConsumerListOptions) ([]SinkConsumerResponse, error)
+	EachSinkConsumer(ctx context.Context, opts SinkConsumerListOptions, fn func(SinkConsumerResponse) error) error
+	GetSinkConsumerConfig(ctx context.Context, id string) (json.RawMessage, error)
+	GetSinkConsumerMetrics(ctx context.Context, sinkIDOrName string) (*SinkConsumerMetrics, error)
+	GetSinkConsumerCursor(ctx context.Context, sinkIDOrName string) (*SinkConsumerCursor, error)
+	ResetSinkConsumerCursor(ctx context.Context, sinkIDOrName string, req *SinkConsumerCursorResetRequest) (*SinkConsumerCursor, error)
+	ReplaySinkConsumer(ctx context.Context, sinkIDOrName string, req *ReplayRequest) (*ReplayResponse, error)
+	ListSinkMessages(ctx context.Context, sinkIDOrName string, opts SinkMessageListOptions) ([]SinkMessage, error)
+	ListFailedMessages(ctx context.Context, sinkIDOrName string, opts SinkMessageListOptions) ([]SinkMessage, error)
+	RedeliverFailedMessages(ctx context.Context, sinkIDOrName string, req *FailedMessageActionRequest) (*FailedMessageActionResponse, error)
+	PurgeFailedMessages(ctx context.Context, sinkIDOrName string, req *FailedMessageActionRequest) (*FailedMessageActionResponse, error)
+
+	// Table contracts
+	CreateTableContract(ctx context.Context, req *TableContractRequest) (*TableContractResponse, error)
+	GetTableContract(ctx context.Context, id string) (*TableContractResponse, error)
+	UpdateTableContract(ctx context.Context, id string, req *TableContractRequest) (*TableContractResponse, error)
+	DeleteTableContract(ctx context.Context, id string) error
+}
+
+var _ SequinAPI = (*Client)(nil)
+
+// ServerVersion returns the version of the connected Sequin server, or an
+// empty string when it is unknown
+func (c *Client) ServerVersion() string {
+	if c.Server == nil {
+		return ""
+	}
+	return c.Server.Version
+}
+
+// DefaultDatabaseName returns the database sink consumers stream from when
+// they do not set one
+func (c *Client) DefaultDatabaseName() string {
+	return c.DefaultDatabase
+}
+
+// CreateRollbackSkipped reports whether resources whose create succeeded but
+// whose follow-up steps failed are kept instead of deleted
+func (c *Client) CreateRollbackSkipped() bool {
+	return c.SkipCreateRollback
+}
+
+// EnrichmentValidated reports whether enrichment queries are validated
+// against the sink's database at plan time
+func (c *Client) EnrichmentValidated() bool {
+	return c.ValidateEnrichment
+}
+
+// DefaultAnnotationValues returns the annotations merged into those of every
+// database and sink consumer
+func (c *Client) DefaultAnnotationValues() map[string]string {
+	return c.DefaultAnnotations
+}
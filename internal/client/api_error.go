@@ -0,0 +1,76 @@
+package client
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// errorBody is the JSON body Sequin sends with error responses
+type errorBody struct {
+	Summary          string                     `json:"summary"`
+	ValidationErrors map[string]json.RawMessage `json:"validation_errors"`
+}
+
+// parseBody fills Summary and ValidationErrors from a Sequin error body.
+// Bodies in another shape, such as a proxy's HTML error page, leave them
+// empty.
+func (e *APIError) parseBody(body []byte) {
+	var parsed errorBody
+	if json.Unmarshal(body, &parsed) != nil {
+		return
+	}
+	e.Summary = parsed.Summary
+
+	errs := map[string][]string{}
+	for field, raw := range parsed.ValidationErrors {
+		flattenValidationErrors(field, raw, errs)
+	}
+	if len(errs) > 0 {
+		e.ValidationErrors = errs
+		if e.Summary == "" {
+			e.Summary = "validation failed"
+		}
+	}
+}
+
+// flattenValidationErrors collects the messages of a validation error value,
+// which is a message, a list of messages, an object of nested fields, or a
+// list of such objects for list fields, keyed by element index
+func flattenValidationErrors(field string, raw json.RawMessage, errs map[string][]string) {
+	var message string
+	if json.Unmarshal(raw, &message) == nil {
+		errs[field] = append(errs[field], message)
+		return
+	}
+
+	var items []json.RawMessage
+	if json.Unmarshal(raw, &items) == nil {
+		for i, item := range items {
+			if strings.HasPrefix(strings.TrimSpace(string(item)), "{") {
+				flattenValidationErrors(field+"."+strconv.Itoa(i), item, errs)
+			} else {
+				flattenValidationErrors(field, item, errs)
+			}
+		}
+		return
+	}
+
+	var nested map[string]json.RawMessage
+	if json.Unmarshal(raw, &nested) == nil {
+		for name, value := range nested {
+			flattenValidationErrors(field+"."+name, value, errs)
+		}
+	}
+}
+
+// Fields returns the fields with validation errors, sorted
+func (e *APIError) Fields() []string {
+	fields := make([]string, 0, len(e.ValidationErrors))
+	for field := range e.ValidationErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CreateAuditLogExport creates a new audit log export
+func (c *Client) CreateAuditLogExport(ctx context.Context, req *AuditLogExportRequest) (*AuditLogExportResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/api/audit_log_exports", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AuditLogExportResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to create audit log export: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Created audit log export", map[string]any{"id": result.ID, "name": result.Name})
+	return &result, nil
+}
+
+// GetAuditLogExport retrieves an audit log export by ID
+func (c *Client) GetAuditLogExport(ctx context.Context, id string) (*AuditLogExportResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/audit_log_exports/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "audit log export", id)
+	}
+
+	var result AuditLogExportResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get audit log export: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	return &result, nil
+}
+
+// UpdateAuditLogExport updates an existing audit log export
+func (c *Client) UpdateAuditLogExport(ctx context.Context, id string, req *AuditLogExportRequest) (*AuditLogExportResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/audit_log_exports/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AuditLogExportResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to update audit log export: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Updated audit log export", map[string]any{"id": result.ID})
+	return &result, nil
+}
+
+// DeleteAuditLogExport deletes an audit log export by ID
+func (c *Client) DeleteAuditLogExport(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/audit_log_exports/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		tflog.Warn(ctx, "Audit log export already deleted", map[string]any{"id": id})
+		return nil
+	}
+
+	if err := c.handleResponse(ctx, resp, nil); err != nil {
+		return fmt.Errorf("failed to delete audit log export: %w", err)
+	}
+
+	tflog.Info(ctx, "Deleted audit log export", map[string]any{"id": id})
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -19,8 +20,106 @@ type Client struct {
 	APIKey     string
 	Version    string
 	HTTPClient *http.Client
+
+	// Username and Password, when Username is set, authenticate requests with
+	// HTTP basic auth instead of the API key, for self-hosted Sequin behind a
+	// basic-auth reverse proxy
+	Username string
+	Password string
+
+	// tokens supplies bearer tokens in place of APIKey when ConfigureOAuth or
+	// ConfigureTokenCommand was called
+	tokens tokenSource
+
+	// UserAgentSuffix is appended to the User-Agent, e.g. to attribute API
+	// traffic to a pipeline
+	UserAgentSuffix string
+
+	// OmitUserAgentVersion leaves the provider version out of the User-Agent
+	OmitUserAgentVersion bool
+
+	// DebugHTTP logs request and response bodies, with secrets redacted
+	DebugHTTP bool
+
+	// CompressRequests gzip-compresses large request bodies, for sinks with
+	// many tables behind slow links. The server must accept gzip bodies.
+	CompressRequests bool
+
+	// DefaultDatabase is the name or ID of the database sink consumers stream
+	// from when they do not set one
+	DefaultDatabase string
+
+	// SkipCreateRollback disables deleting resources whose create succeeded
+	// but could not be recorded in Terraform state
+	SkipCreateRollback bool
+
+	// StrictMode rejects API responses containing fields this client does not
+	// know about, surfacing provider/server version mismatches
+	StrictMode bool
+
+	// ValidateEnrichment validates enrichment queries against the sink's
+	// database at plan time
+	ValidateEnrichment bool
+
+	// DefaultAnnotations are merged into the annotations of every database
+	// and sink consumer. Annotations set on a resource take precedence.
+	DefaultAnnotations map[string]string
+
+	// Platform is the Sequin flavor the client targets: PlatformCloud or
+	// PlatformSelfHosted. Features only one platform offers are checked
+	// against it at plan time.
+	Platform string
+
+	// Server is the version and capabilities the server reported when the
+	// provider was configured, or nil when it did not report them. Features
+	// the server lacks are rejected at plan time.
+	Server *ServerInfo
+
+	// MaxUnavailableWait bounds how long requests answered with 503 Service
+	// Unavailable, as during a Sequin upgrade, are retried. Zero disables it.
+	MaxUnavailableWait time.Duration
+
+	// MaxRateLimitWait bounds the total time a request answered with 429 Too
+	// Many Requests waits for the rate limit to reset. Zero disables waiting.
+	MaxRateLimitWait time.Duration
+
+	// MaxRetries is how many times 5xx responses and transient transport
+	// errors are retried, waiting from RetryMinDelay doubling up to
+	// RetryMaxDelay between attempts. Zero disables retries.
+	MaxRetries    int
+	RetryMinDelay time.Duration
+	RetryMaxDelay time.Duration
+
+	// Headers are added to every request, e.g. for routing by an API gateway
+	// in front of Sequin
+	Headers map[string]string
+
+	// AccountID, when set, is sent as AccountIDHeader to select the account
+	// requests act on, for API keys spanning several accounts
+	AccountID string
+
+	// APIVersion, when set, is sent as APIVersionHeader to pin the shape of
+	// API responses across server upgrades
+	APIVersion string
+
+	// cache holds recent GET responses when EnableReadCache was called
+	cache *readCache
+
+	// limiter holds a slot per request in flight when
+	// SetMaxConcurrentRequests was called
+	limiter chan struct{}
+
+	// pacer spaces requests out when SetRequestsPerSecond was called
+	pacer *tokenBucket
+
+	// middleware wraps the sending of every request; see Use
+	middleware []Middleware
 }
 
+// DefaultRequestTimeout bounds a single API request unless the provider
+// configures another timeout
+const DefaultRequestTimeout = 30 * time.Second
+
 // New creates a new Sequin API client
 func New(baseURL, apiKey, version string) *Client {
 	return &Client{
@@ -28,46 +127,137 @@ func New(baseURL, apiKey, version string) *Client {
 		APIKey:  apiKey,
 		Version: version,
 		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: DefaultRequestTimeout,
 		},
 	}
 }
 
 // doRequest performs an HTTP request with authentication and logging
 func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
-	var reqBody io.Reader
+	var data []byte
 	if body != nil {
 		jsonData, err := json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonData)
+		data = c.translateRequest(path, jsonData)
+	}
+
+	if method != http.MethodGet {
+		c.cache.clear()
+	} else if cached := c.cache.get(ctx, path); cached != nil {
+		tflog.Debug(ctx, "Using cached API response", map[string]any{
+			"method": method,
+			"path":   path,
+		})
+		return cached, nil
 	}
 
+	send := func() (*http.Response, error) {
+		if ctx.Value(singleAttemptKey{}) != nil {
+			return c.send(ctx, method, path, data)
+		}
+		return c.sendRefreshingToken(ctx, method, path, data)
+	}
+	var resp *http.Response
+	var err error
+	if method == http.MethodGet && c.cache != nil && ctx.Value(noReadCacheKey{}) == nil {
+		resp, err = c.cache.inFlight.do(ctx, path, send)
+	} else {
+		resp, err = send()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if method == http.MethodGet {
+		if err := c.cache.store(path, resp); err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// send performs a single attempt of an API request
+func (c *Client) send(ctx context.Context, method, path string, data []byte) (*http.Response, error) {
+	reqBody, encoding := c.requestBody(data)
+
 	url := c.BaseURL + path
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	if c.Username != "" {
+		req.SetBasicAuth(c.Username, c.Password)
+	} else {
+		token, err := c.bearerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
 	req.Header.Set("Content-Type", "application/json")
+	if encoding != "" {
+		req.Header.Set("Content-Encoding", encoding)
+	}
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", fmt.Sprintf("terraform-provider-sequin/%s", c.Version))
+	req.Header.Set("User-Agent", c.userAgent())
+	if c.APIVersion != "" {
+		req.Header.Set(APIVersionHeader, c.APIVersion)
+	}
+	if c.AccountID != "" {
+		req.Header.Set(AccountIDHeader, c.AccountID)
+	}
+	if nonce, ok := idempotencyNonce(ctx); ok {
+		req.Header.Set(IdempotencyKeyHeader, idempotencyKey(nonce, method, path, data))
+	}
+	if etag := ifMatch(ctx, method); etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
+	for name, value := range c.Headers {
+		req.Header.Set(name, value)
+	}
 
 	tflog.Debug(ctx, "Making API request", map[string]any{
 		"method": method,
 		"url":    url,
 	})
+	c.logRequestBody(ctx, method, path, data)
+
+	if err := c.pace(ctx); err != nil {
+		return nil, err
+	}
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := c.HTTPClient.Do(req)
+	start := time.Now()
+	resp, err := c.roundTrip(c.httpClientFor(ctx), req)
 	if err != nil {
+		release()
+		tflog.Debug(ctx, "API request failed", map[string]any{
+			"method":      method,
+			"path":        path,
+			"duration_ms": time.Since(start).Milliseconds(),
+			"error":       err.Error(),
+		})
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
 	tflog.Debug(ctx, "Received API response", map[string]any{
+		"method":      method,
+		"path":        path,
 		"status_code": resp.StatusCode,
+		"duration_ms": time.Since(start).Milliseconds(),
+		"request_id":  requestID(resp),
 	})
+	// The body streams over the connection, so the slot is held until the
+	// caller closes it
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	c.logResponseBody(ctx, method, path, resp)
 
 	return resp, nil
 }
@@ -82,13 +272,27 @@ func (c *Client) handleResponse(ctx context.Context, resp *http.Response, target
 	}
 
 	if resp.StatusCode >= 400 {
+		apiErr := &APIError{
+			StatusCode: resp.StatusCode,
+			RequestID:  requestID(resp),
+			Body:       string(body),
+		}
+		apiErr.parseBody(body)
 		tflog.Error(ctx, "API error response", map[string]any{
-			"status_code": resp.StatusCode,
+			"status_code": apiErr.StatusCode,
+			"request_id":  apiErr.RequestID,
 		})
-		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		return apiErr
 	}
 
 	if target != nil && len(body) > 0 {
+		// Older servers may still use renamed fields
+		if resp.Request != nil {
+			body = translateResponse(resp.Request.URL.Path, body)
+		}
+		if c.StrictMode {
+			return decodeStrict(body, target)
+		}
 		if err := json.Unmarshal(body, target); err != nil {
 			return fmt.Errorf("failed to unmarshal response: %w", err)
 		}
@@ -97,18 +301,172 @@ func (c *Client) handleResponse(ctx context.Context, resp *http.Response, target
 	return nil
 }
 
-// StatusResponse represents the status of a resource
-type StatusResponse struct {
-	State     string `json:"state"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
-	LastError string `json:"last_error,omitempty"`
+// decodeStrict unmarshals a response body, failing on fields the target type
+// does not declare
+func decodeStrict(body []byte, target interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("failed to unmarshal response in strict mode (the Sequin server may be newer than this provider): %w", err)
+	}
+	return nil
+}
+
+// userAgent identifies the provider, and its version unless omitted, to the
+// API
+func (c *Client) userAgent() string {
+	agent := "terraform-provider-sequin"
+	if !c.OmitUserAgentVersion {
+		agent += "/" + c.Version
+	}
+	if c.UserAgentSuffix != "" {
+		agent += " " + c.UserAgentSuffix
+	}
+	return agent
 }
 
-// IsNotFoundError checks if an error is a 404 Not Found error
+// APIVersionHeader carries the API version requests are pinned to
+const APIVersionHeader = "X-Sequin-API-Version"
+
+// AccountIDHeader carries the account requests act on
+const AccountIDHeader = "X-Sequin-Account-Id"
+
+// requestIDHeaders are the response headers Sequin (or a proxy in front of it)
+// uses to identify a request, in order of preference
+var requestIDHeaders = []string{"X-Request-Id", "Request-Id", "X-Correlation-Id"}
+
+// requestID extracts the request ID from an API response, if present
+func requestID(resp *http.Response) string {
+	for _, header := range requestIDHeaders {
+		if id := resp.Header.Get(header); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// requestIDSuffix formats a response's request ID for inclusion in error messages
+func requestIDSuffix(resp *http.Response) string {
+	if id := requestID(resp); id != "" {
+		return " (request ID: " + id + ")"
+	}
+	return ""
+}
+
+// APIError is returned when the Sequin API responds with an error status
+type APIError struct {
+	StatusCode int
+	RequestID  string // Correlates the failure with Sequin server logs
+	Body       string
+
+	// Summary and ValidationErrors are parsed from Sequin's error JSON when
+	// the body has it. ValidationErrors maps dotted field paths, such as
+	// destination.hosts, to their problems.
+	Summary          string
+	ValidationErrors map[string][]string
+}
+
+// Error formats the API error, including the request ID when available so it
+// can be quoted in support tickets
+func (e *APIError) Error() string {
+	message := e.Body
+	if e.Summary != "" {
+		message = e.Summary
+		for _, field := range e.Fields() {
+			message += fmt.Sprintf("; %s: %s", field, strings.Join(e.ValidationErrors[field], ", "))
+		}
+	}
+	if e.RequestID != "" {
+		return fmt.Sprintf("API error (status %d, request ID: %s): %s", e.StatusCode, e.RequestID, message)
+	}
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, message)
+}
+
+// RequestIDFromError returns the API request ID carried by err, if any
+func RequestIDFromError(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.RequestID
+	}
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		return notFound.RequestID
+	}
+	return ""
+}
+
+// NotFoundError is returned when the API answers 404 Not Found for the
+// resource a request addressed
+type NotFoundError struct {
+	Resource  string // Kind of resource, such as "sink consumer"
+	ID        string // ID or name the request addressed, if any
+	RequestID string
+}
+
+// Error formats the not found error, including the request ID when available
+func (e *NotFoundError) Error() string {
+	message := e.Resource + " not found"
+	if e.ID != "" {
+		message += ": " + e.ID
+	}
+	if e.RequestID != "" {
+		message += " (request ID: " + e.RequestID + ")"
+	}
+	return message
+}
+
+// notFoundError builds the error for a 404 response about a resource
+func notFoundError(resp *http.Response, resource, id string) error {
+	return &NotFoundError{Resource: resource, ID: id, RequestID: requestID(resp)}
+}
+
+// IsNotFoundError checks if an error is a 404 Not Found error, by its status
+// code rather than its message
 func IsNotFoundError(err error) bool {
-	if err == nil {
-		return false
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		return true
 	}
-	return strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "404")
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsConflictError checks if an error is a 409 Conflict, which the API returns
+// when a resource was modified concurrently
+func IsConflictError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict && !IsDependencyError(err)
+}
+
+// DependencyError is returned when the API refuses to delete a resource
+// because others, such as sink consumers, still depend on it
+type DependencyError struct {
+	Err *APIError
+}
+
+// Error returns the API error the refusal was reported with
+func (e *DependencyError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying API error
+func (e *DependencyError) Unwrap() error {
+	return e.Err
+}
+
+// dependencyError marks the 409 or 422 answer to a delete of a resource
+// others can depend on as a DependencyError. Other errors are returned as is.
+func dependencyError(err error) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusConflict || apiErr.StatusCode == http.StatusUnprocessableEntity) {
+		return &DependencyError{Err: apiErr}
+	}
+	return err
+}
+
+// IsDependencyError checks if an error is the API refusing to delete a
+// resource because others still depend on it
+func IsDependencyError(err error) bool {
+	var depErr *DependencyError
+	return errors.As(err, &depErr)
 }
@@ -0,0 +1,715 @@
+// Package clienttest provides a programmable stand-in for the Sequin API
+// client, so resource and data source logic can be tested without a live
+// server.
+package clienttest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+)
+
+// Mock implements client.SequinAPI. Each API method calls the matching Func
+// field, and fails with ErrNotProgrammed when that field is unset. Every call
+// is recorded in order, so tests can assert on the requests a resource made.
+type Mock struct {
+	// Provider settings returned by the accessor methods
+	Cloud              bool
+	Version            string
+	DefaultDatabase    string
+	SkipCreateRollback bool
+	ValidateEnrichment bool
+	DefaultAnnotations map[string]string
+
+	// SupportsFeatureFunc decides feature support; unset supports everything
+	SupportsFeatureFunc func(feature string) (bool, string)
+
+	// API method implementations
+	HealthFunc                    func(ctx context.Context) (*client.HealthResponse, error)
+	GetAccountSettingsFunc        func(ctx context.Context) (*client.AccountSettingsResponse, error)
+	UpdateAccountSettingsFunc     func(ctx context.Context, req *client.AccountSettingsRequest) (*client.AccountSettingsResponse, error)
+	GetMetricsSettingsFunc        func(ctx context.Context) (*client.MetricsSettingsResponse, error)
+	UpdateMetricsSettingsFunc     func(ctx context.Context, req *client.MetricsSettingsRequest) (*client.MetricsSettingsResponse, error)
+	CreateAlertFunc               func(ctx context.Context, req *client.AlertRequest) (*client.AlertResponse, error)
+	GetAlertFunc                  func(ctx context.Context, id string) (*client.AlertResponse, error)
+	UpdateAlertFunc               func(ctx context.Context, id string, req *client.AlertRequest) (*client.AlertResponse, error)
+	DeleteAlertFunc               func(ctx context.Context, id string) error
+	CreateAuditLogExportFunc      func(ctx context.Context, req *client.AuditLogExportRequest) (*client.AuditLogExportResponse, error)
+	GetAuditLogExportFunc         func(ctx context.Context, id string) (*client.AuditLogExportResponse, error)
+	UpdateAuditLogExportFunc      func(ctx context.Context, id string, req *client.AuditLogExportRequest) (*client.AuditLogExportResponse, error)
+	DeleteAuditLogExportFunc      func(ctx context.Context, id string) error
+	CreateBackfillFunc            func(ctx context.Context, sinkIDOrName string, req *client.BackfillCreateRequest) (*client.BackfillResponse, error)
+	GetBackfillFunc               func(ctx context.Context, sinkIDOrName string, backfillID string) (*client.BackfillResponse, error)
+	UpdateBackfillFunc            func(ctx context.Context, sinkIDOrName string, backfillID string, req *client.BackfillUpdateRequest) (*client.BackfillResponse, error)
+	DeleteBackfillFunc            func(ctx context.Context, sinkIDOrName string, backfillID string) error
+	ListBackfillsFunc             func(ctx context.Context, sinkIDOrName string) ([]client.BackfillResponse, error)
+	CreateCredentialFunc          func(ctx context.Context, req *client.CredentialRequest) (*client.CredentialResponse, error)
+	GetCredentialFunc             func(ctx context.Context, id string) (*client.CredentialResponse, error)
+	UpdateCredentialFunc          func(ctx context.Context, id string, req *client.CredentialRequest) (*client.CredentialResponse, error)
+	DeleteCredentialFunc          func(ctx context.Context, id string) error
+	CreateDataClassificationFunc  func(ctx context.Context, req *client.DataClassificationRequest) (*client.DataClassificationResponse, error)
+	GetDataClassificationFunc     func(ctx context.Context, id string) (*client.DataClassificationResponse, error)
+	UpdateDataClassificationFunc  func(ctx context.Context, id string, req *client.DataClassificationRequest) (*client.DataClassificationResponse, error)
+	DeleteDataClassificationFunc  func(ctx context.Context, id string) error
+	ListDataClassificationsFunc   func(ctx context.Context) ([]client.DataClassificationResponse, error)
+	CreateDatabaseFunc            func(ctx context.Context, req *client.DatabaseRequest) (*client.DatabaseResponse, error)
+	GetDatabaseFunc               func(ctx context.Context, id string) (*client.DatabaseResponse, error)
+	UpdateDatabaseFunc            func(ctx context.Context, id string, req *client.DatabaseRequest) (*client.DatabaseResponse, error)
+	DeleteDatabaseFunc            func(ctx context.Context, id string) error
+	TestDatabaseConnectionFunc    func(ctx context.Context, req *client.DatabaseRequest) (*client.DatabaseConnectionTestResponse, error)
+	ListReplicationSlotsFunc      func(ctx context.Context, databaseIDOrName string) ([]client.ReplicationSlotStatus, error)
+	EachTableStatsFunc            func(ctx context.Context, databaseIDOrName string, fn func(client.TableStats) error) error
+	ListTableColumnsFunc          func(ctx context.Context, databaseIDOrName, table string) ([]client.TableColumn, error)
+	GetFunctionFunc               func(ctx context.Context, idOrName string) (*client.FunctionResponse, error)
+	ValidateFunctionFunc          func(ctx context.Context, idOrName string, req *client.FunctionValidationRequest) (*client.FunctionValidationResponse, error)
+	EvaluateFilterFunc            func(ctx context.Context, idOrName string, req *client.FilterEvaluationRequest) ([]client.FilterEvaluationResult, error)
+	TestFunctionFunc              func(ctx context.Context, idOrName string, req *client.FunctionTestRequest) (*client.FunctionTestResponse, error)
+	GetHTTPEndpointFunc           func(ctx context.Context, idOrName string) (*client.HTTPEndpointResponse, error)
+	CreateNotificationChannelFunc func(ctx context.Context, req *client.NotificationChannelRequest) (*client.NotificationChannelResponse, error)
+	GetNotificationChannelFunc    func(ctx context.Context, id string) (*client.NotificationChannelResponse, error)
+	UpdateNotificationChannelFunc func(ctx context.Context, id string, req *client.NotificationChannelRequest) (*client.NotificationChannelResponse, error)
+	DeleteNotificationChannelFunc func(ctx context.Context, id string) error
+	CreateSinkConsumerFunc        func(ctx context.Context, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error)
+	GetSinkConsumerFunc           func(ctx context.Context, id string) (*client.SinkConsumerResponse, error)
+	UpdateSinkConsumerFunc        func(ctx context.Context, id string, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error)
+	DeleteSinkConsumerFunc        func(ctx context.Context, id string) error
+	ListSinkConsumersFunc         func(ctx context.Context, opts client.SinkConsumerListOptions) ([]client.SinkConsumerResponse, error)
+	EachSinkConsumerFunc          func(ctx context.Context, opts client.SinkConsumerListOptions, fn func(client.SinkConsumerResponse) error) error
+	GetSinkConsumerConfigFunc     func(ctx context.Context, id string) (json.RawMessage, error)
+	GetSinkConsumerMetricsFunc    func(ctx context.Context, sinkIDOrName string) (*client.SinkConsumerMetrics, error)
+	GetSinkConsumerCursorFunc     func(ctx context.Context, sinkIDOrName string) (*client.SinkConsumerCursor, error)
+	ResetSinkConsumerCursorFunc   func(ctx context.Context, sinkIDOrName string, req *client.SinkConsumerCursorResetRequest) (*client.SinkConsumerCursor, error)
+	ReplaySinkConsumerFunc        func(ctx context.Context, sinkIDOrName string, req *client.ReplayRequest) (*client.ReplayResponse, error)
+	ListSinkMessagesFunc          func(ctx context.Context, sinkIDOrName string, opts client.SinkMessageListOptions) ([]client.SinkMessage, error)
+	ListFailedMessagesFunc        func(ctx context.Context, sinkIDOrName string, opts client.SinkMessageListOptions) ([]client.SinkMessage, error)
+	RedeliverFailedMessagesFunc   func(ctx context.Context, sinkIDOrName string, req *client.FailedMessageActionRequest) (*client.FailedMessageActionResponse, error)
+	PurgeFailedMessagesFunc       func(ctx context.Context, sinkIDOrName string, req *client.FailedMessageActionRequest) (*client.FailedMessageActionResponse, error)
+	CreateTableContractFunc       func(ctx context.Context, req *client.TableContractRequest) (*client.TableContractResponse, error)
+	GetTableContractFunc          func(ctx context.Context, id string) (*client.TableContractResponse, error)
+	UpdateTableContractFunc       func(ctx context.Context, id string, req *client.TableContractRequest) (*client.TableContractResponse, error)
+	DeleteTableContractFunc       func(ctx context.Context, id string) error
+
+	mu    sync.Mutex
+	calls []string
+}
+
+var _ client.SequinAPI = (*Mock)(nil)
+
+// ErrNotProgrammed is returned by API methods whose Func field is unset
+type ErrNotProgrammed struct {
+	Method string
+}
+
+func (e *ErrNotProgrammed) Error() string {
+	return fmt.Sprintf("clienttest: %s called but not programmed", e.Method)
+}
+
+// Calls returns the names of the API methods called so far, in order
+func (m *Mock) Calls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.calls...)
+}
+
+func (m *Mock) record(method string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, method)
+}
+
+// IsCloud reports the Cloud setting
+func (m *Mock) IsCloud() bool { return m.Cloud }
+
+// SupportsFeature calls SupportsFeatureFunc, supporting every feature when it
+// is unset
+func (m *Mock) SupportsFeature(feature string) (bool, string) {
+	if m.SupportsFeatureFunc == nil {
+		return true, ""
+	}
+	return m.SupportsFeatureFunc(feature)
+}
+
+// ServerVersion reports the Version setting
+func (m *Mock) ServerVersion() string { return m.Version }
+
+// DefaultDatabaseName reports the DefaultDatabase setting
+func (m *Mock) DefaultDatabaseName() string { return m.DefaultDatabase }
+
+// CreateRollbackSkipped reports the SkipCreateRollback setting
+func (m *Mock) CreateRollbackSkipped() bool { return m.SkipCreateRollback }
+
+// EnrichmentValidated reports the ValidateEnrichment setting
+func (m *Mock) EnrichmentValidated() bool { return m.ValidateEnrichment }
+
+// DefaultAnnotationValues reports the DefaultAnnotations setting
+func (m *Mock) DefaultAnnotationValues() map[string]string { return m.DefaultAnnotations }
+
+// Health calls HealthFunc
+func (m *Mock) Health(ctx context.Context) (*client.HealthResponse, error) {
+	m.record("Health")
+	if m.HealthFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "Health"}
+	}
+	return m.HealthFunc(ctx)
+}
+
+// GetAccountSettings calls GetAccountSettingsFunc
+func (m *Mock) GetAccountSettings(ctx context.Context) (*client.AccountSettingsResponse, error) {
+	m.record("GetAccountSettings")
+	if m.GetAccountSettingsFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetAccountSettings"}
+	}
+	return m.GetAccountSettingsFunc(ctx)
+}
+
+// UpdateAccountSettings calls UpdateAccountSettingsFunc
+func (m *Mock) UpdateAccountSettings(ctx context.Context, req *client.AccountSettingsRequest) (*client.AccountSettingsResponse, error) {
+	m.record("UpdateAccountSettings")
+	if m.UpdateAccountSettingsFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "UpdateAccountSettings"}
+	}
+	return m.UpdateAccountSettingsFunc(ctx, req)
+}
+
+// GetMetricsSettings calls GetMetricsSettingsFunc
+func (m *Mock) GetMetricsSettings(ctx context.Context) (*client.MetricsSettingsResponse, error) {
+	m.record("GetMetricsSettings")
+	if m.GetMetricsSettingsFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetMetricsSettings"}
+	}
+	return m.GetMetricsSettingsFunc(ctx)
+}
+
+// UpdateMetricsSettings calls UpdateMetricsSettingsFunc
+func (m *Mock) UpdateMetricsSettings(ctx context.Context, req *client.MetricsSettingsRequest) (*client.MetricsSettingsResponse, error) {
+	m.record("UpdateMetricsSettings")
+	if m.UpdateMetricsSettingsFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "UpdateMetricsSettings"}
+	}
+	return m.UpdateMetricsSettingsFunc(ctx, req)
+}
+
+// CreateAlert calls CreateAlertFunc
+func (m *Mock) CreateAlert(ctx context.Context, req *client.AlertRequest) (*client.AlertResponse, error) {
+	m.record("CreateAlert")
+	if m.CreateAlertFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "CreateAlert"}
+	}
+	return m.CreateAlertFunc(ctx, req)
+}
+
+// GetAlert calls GetAlertFunc
+func (m *Mock) GetAlert(ctx context.Context, id string) (*client.AlertResponse, error) {
+	m.record("GetAlert")
+	if m.GetAlertFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetAlert"}
+	}
+	return m.GetAlertFunc(ctx, id)
+}
+
+// UpdateAlert calls UpdateAlertFunc
+func (m *Mock) UpdateAlert(ctx context.Context, id string, req *client.AlertRequest) (*client.AlertResponse, error) {
+	m.record("UpdateAlert")
+	if m.UpdateAlertFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "UpdateAlert"}
+	}
+	return m.UpdateAlertFunc(ctx, id, req)
+}
+
+// DeleteAlert calls DeleteAlertFunc
+func (m *Mock) DeleteAlert(ctx context.Context, id string) error {
+	m.record("DeleteAlert")
+	if m.DeleteAlertFunc == nil {
+		return &ErrNotProgrammed{Method: "DeleteAlert"}
+	}
+	return m.DeleteAlertFunc(ctx, id)
+}
+
+// CreateAuditLogExport calls CreateAuditLogExportFunc
+func (m *Mock) CreateAuditLogExport(ctx context.Context, req *client.AuditLogExportRequest) (*client.AuditLogExportResponse, error) {
+	m.record("CreateAuditLogExport")
+	if m.CreateAuditLogExportFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "CreateAuditLogExport"}
+	}
+	return m.CreateAuditLogExportFunc(ctx, req)
+}
+
+// GetAuditLogExport calls GetAuditLogExportFunc
+func (m *Mock) GetAuditLogExport(ctx context.Context, id string) (*client.AuditLogExportResponse, error) {
+	m.record("GetAuditLogExport")
+	if m.GetAuditLogExportFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetAuditLogExport"}
+	}
+	return m.GetAuditLogExportFunc(ctx, id)
+}
+
+// UpdateAuditLogExport calls UpdateAuditLogExportFunc
+func (m *Mock) UpdateAuditLogExport(ctx context.Context, id string, req *client.AuditLogExportRequest) (*client.AuditLogExportResponse, error) {
+	m.record("UpdateAuditLogExport")
+	if m.UpdateAuditLogExportFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "UpdateAuditLogExport"}
+	}
+	return m.UpdateAuditLogExportFunc(ctx, id, req)
+}
+
+// DeleteAuditLogExport calls DeleteAuditLogExportFunc
+func (m *Mock) DeleteAuditLogExport(ctx context.Context, id string) error {
+	m.record("DeleteAuditLogExport")
+	if m.DeleteAuditLogExportFunc == nil {
+		return &ErrNotProgrammed{Method: "DeleteAuditLogExport"}
+	}
+	return m.DeleteAuditLogExportFunc(ctx, id)
+}
+
+// CreateBackfill calls CreateBackfillFunc
+func (m *Mock) CreateBackfill(ctx context.Context, sinkIDOrName string, req *client.BackfillCreateRequest) (*client.BackfillResponse, error) {
+	m.record("CreateBackfill")
+	if m.CreateBackfillFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "CreateBackfill"}
+	}
+	return m.CreateBackfillFunc(ctx, sinkIDOrName, req)
+}
+
+// GetBackfill calls GetBackfillFunc
+func (m *Mock) GetBackfill(ctx context.Context, sinkIDOrName string, backfillID string) (*client.BackfillResponse, error) {
+	m.record("GetBackfill")
+	if m.GetBackfillFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetBackfill"}
+	}
+	return m.GetBackfillFunc(ctx, sinkIDOrName, backfillID)
+}
+
+// UpdateBackfill calls UpdateBackfillFunc
+func (m *Mock) UpdateBackfill(ctx context.Context, sinkIDOrName string, backfillID string, req *client.BackfillUpdateRequest) (*client.BackfillResponse, error) {
+	m.record("UpdateBackfill")
+	if m.UpdateBackfillFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "UpdateBackfill"}
+	}
+	return m.UpdateBackfillFunc(ctx, sinkIDOrName, backfillID, req)
+}
+
+// DeleteBackfill calls DeleteBackfillFunc
+func (m *Mock) DeleteBackfill(ctx context.Context, sinkIDOrName string, backfillID string) error {
+	m.record("DeleteBackfill")
+	if m.DeleteBackfillFunc == nil {
+		return &ErrNotProgrammed{Method: "DeleteBackfill"}
+	}
+	return m.DeleteBackfillFunc(ctx, sinkIDOrName, backfillID)
+}
+
+// ListBackfills calls ListBackfillsFunc
+func (m *Mock) ListBackfills(ctx context.Context, sinkIDOrName string) ([]client.BackfillResponse, error) {
+	m.record("ListBackfills")
+	if m.ListBackfillsFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "ListBackfills"}
+	}
+	return m.ListBackfillsFunc(ctx, sinkIDOrName)
+}
+
+// CreateCredential calls CreateCredentialFunc
+func (m *Mock) CreateCredential(ctx context.Context, req *client.CredentialRequest) (*client.CredentialResponse, error) {
+	m.record("CreateCredential")
+	if m.CreateCredentialFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "CreateCredential"}
+	}
+	return m.CreateCredentialFunc(ctx, req)
+}
+
+// GetCredential calls GetCredentialFunc
+func (m *Mock) GetCredential(ctx context.Context, id string) (*client.CredentialResponse, error) {
+	m.record("GetCredential")
+	if m.GetCredentialFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetCredential"}
+	}
+	return m.GetCredentialFunc(ctx, id)
+}
+
+// UpdateCredential calls UpdateCredentialFunc
+func (m *Mock) UpdateCredential(ctx context.Context, id string, req *client.CredentialRequest) (*client.CredentialResponse, error) {
+	m.record("UpdateCredential")
+	if m.UpdateCredentialFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "UpdateCredential"}
+	}
+	return m.UpdateCredentialFunc(ctx, id, req)
+}
+
+// DeleteCredential calls DeleteCredentialFunc
+func (m *Mock) DeleteCredential(ctx context.Context, id string) error {
+	m.record("DeleteCredential")
+	if m.DeleteCredentialFunc == nil {
+		return &ErrNotProgrammed{Method: "DeleteCredential"}
+	}
+	return m.DeleteCredentialFunc(ctx, id)
+}
+
+// CreateDataClassification calls CreateDataClassificationFunc
+func (m *Mock) CreateDataClassification(ctx context.Context, req *client.DataClassificationRequest) (*client.DataClassificationResponse, error) {
+	m.record("CreateDataClassification")
+	if m.CreateDataClassificationFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "CreateDataClassification"}
+	}
+	return m.CreateDataClassificationFunc(ctx, req)
+}
+
+// GetDataClassification calls GetDataClassificationFunc
+func (m *Mock) GetDataClassification(ctx context.Context, id string) (*client.DataClassificationResponse, error) {
+	m.record("GetDataClassification")
+	if m.GetDataClassificationFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetDataClassification"}
+	}
+	return m.GetDataClassificationFunc(ctx, id)
+}
+
+// UpdateDataClassification calls UpdateDataClassificationFunc
+func (m *Mock) UpdateDataClassification(ctx context.Context, id string, req *client.DataClassificationRequest) (*client.DataClassificationResponse, error) {
+	m.record("UpdateDataClassification")
+	if m.UpdateDataClassificationFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "UpdateDataClassification"}
+	}
+	return m.UpdateDataClassificationFunc(ctx, id, req)
+}
+
+// DeleteDataClassification calls DeleteDataClassificationFunc
+func (m *Mock) DeleteDataClassification(ctx context.Context, id string) error {
+	m.record("DeleteDataClassification")
+	if m.DeleteDataClassificationFunc == nil {
+		return &ErrNotProgrammed{Method: "DeleteDataClassification"}
+	}
+	return m.DeleteDataClassificationFunc(ctx, id)
+}
+
+// ListDataClassifications calls ListDataClassificationsFunc
+func (m *Mock) ListDataClassifications(ctx context.Context) ([]client.DataClassificationResponse, error) {
+	m.record("ListDataClassifications")
+	if m.ListDataClassificationsFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "ListDataClassifications"}
+	}
+	return m.ListDataClassificationsFunc(ctx)
+}
+
+// CreateDatabase calls CreateDatabaseFunc
+func (m *Mock) CreateDatabase(ctx context.Context, req *client.DatabaseRequest) (*client.DatabaseResponse, error) {
+	m.record("CreateDatabase")
+	if m.CreateDatabaseFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "CreateDatabase"}
+	}
+	return m.CreateDatabaseFunc(ctx, req)
+}
+
+// GetDatabase calls GetDatabaseFunc
+func (m *Mock) GetDatabase(ctx context.Context, id string) (*client.DatabaseResponse, error) {
+	m.record("GetDatabase")
+	if m.GetDatabaseFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetDatabase"}
+	}
+	return m.GetDatabaseFunc(ctx, id)
+}
+
+// UpdateDatabase calls UpdateDatabaseFunc
+func (m *Mock) UpdateDatabase(ctx context.Context, id string, req *client.DatabaseRequest) (*client.DatabaseResponse, error) {
+	m.record("UpdateDatabase")
+	if m.UpdateDatabaseFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "UpdateDatabase"}
+	}
+	return m.UpdateDatabaseFunc(ctx, id, req)
+}
+
+// DeleteDatabase calls DeleteDatabaseFunc
+func (m *Mock) DeleteDatabase(ctx context.Context, id string) error {
+	m.record("DeleteDatabase")
+	if m.DeleteDatabaseFunc == nil {
+		return &ErrNotProgrammed{Method: "DeleteDatabase"}
+	}
+	return m.DeleteDatabaseFunc(ctx, id)
+}
+
+// TestDatabaseConnection calls TestDatabaseConnectionFunc
+func (m *Mock) TestDatabaseConnection(ctx context.Context, req *client.DatabaseRequest) (*client.DatabaseConnectionTestResponse, error) {
+	m.record("TestDatabaseConnection")
+	if m.TestDatabaseConnectionFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "TestDatabaseConnection"}
+	}
+	return m.TestDatabaseConnectionFunc(ctx, req)
+}
+
+// ListReplicationSlots calls ListReplicationSlotsFunc
+func (m *Mock) ListReplicationSlots(ctx context.Context, databaseIDOrName string) ([]client.ReplicationSlotStatus, error) {
+	m.record("ListReplicationSlots")
+	if m.ListReplicationSlotsFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "ListReplicationSlots"}
+	}
+	return m.ListReplicationSlotsFunc(ctx, databaseIDOrName)
+}
+
+// EachTableStats calls EachTableStatsFunc
+func (m *Mock) EachTableStats(ctx context.Context, databaseIDOrName string, fn func(client.TableStats) error) error {
+	m.record("EachTableStats")
+	if m.EachTableStatsFunc == nil {
+		return &ErrNotProgrammed{Method: "EachTableStats"}
+	}
+	return m.EachTableStatsFunc(ctx, databaseIDOrName, fn)
+}
+
+// ListTableColumns calls ListTableColumnsFunc
+func (m *Mock) ListTableColumns(ctx context.Context, databaseIDOrName, table string) ([]client.TableColumn, error) {
+	m.record("ListTableColumns")
+	if m.ListTableColumnsFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "ListTableColumns"}
+	}
+	return m.ListTableColumnsFunc(ctx, databaseIDOrName, table)
+}
+
+// GetFunction calls GetFunctionFunc
+func (m *Mock) GetFunction(ctx context.Context, idOrName string) (*client.FunctionResponse, error) {
+	m.record("GetFunction")
+	if m.GetFunctionFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetFunction"}
+	}
+	return m.GetFunctionFunc(ctx, idOrName)
+}
+
+// ValidateFunction calls ValidateFunctionFunc
+func (m *Mock) ValidateFunction(ctx context.Context, idOrName string, req *client.FunctionValidationRequest) (*client.FunctionValidationResponse, error) {
+	m.record("ValidateFunction")
+	if m.ValidateFunctionFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "ValidateFunction"}
+	}
+	return m.ValidateFunctionFunc(ctx, idOrName, req)
+}
+
+// EvaluateFilter calls EvaluateFilterFunc
+func (m *Mock) EvaluateFilter(ctx context.Context, idOrName string, req *client.FilterEvaluationRequest) ([]client.FilterEvaluationResult, error) {
+	m.record("EvaluateFilter")
+	if m.EvaluateFilterFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "EvaluateFilter"}
+	}
+	return m.EvaluateFilterFunc(ctx, idOrName, req)
+}
+
+// TestFunction calls TestFunctionFunc
+func (m *Mock) TestFunction(ctx context.Context, idOrName string, req *client.FunctionTestRequest) (*client.FunctionTestResponse, error) {
+	m.record("TestFunction")
+	if m.TestFunctionFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "TestFunction"}
+	}
+	return m.TestFunctionFunc(ctx, idOrName, req)
+}
+
+// GetHTTPEndpoint calls GetHTTPEndpointFunc
+func (m *Mock) GetHTTPEndpoint(ctx context.Context, idOrName string) (*client.HTTPEndpointResponse, error) {
+	m.record("GetHTTPEndpoint")
+	if m.GetHTTPEndpointFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetHTTPEndpoint"}
+	}
+	return m.GetHTTPEndpointFunc(ctx, idOrName)
+}
+
+// CreateNotificationChannel calls CreateNotificationChannelFunc
+func (m *Mock) CreateNotificationChannel(ctx context.Context, req *client.NotificationChannelRequest) (*client.NotificationChannelResponse, error) {
+	m.record("CreateNotificationChannel")
+	if m.CreateNotificationChannelFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "CreateNotificationChannel"}
+	}
+	return m.CreateNotificationChannelFunc(ctx, req)
+}
+
+// GetNotificationChannel calls GetNotificationChannelFunc
+func (m *Mock) GetNotificationChannel(ctx context.Context, id string) (*client.NotificationChannelResponse, error) {
+	m.record("GetNotificationChannel")
+	if m.GetNotificationChannelFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetNotificationChannel"}
+	}
+	return m.GetNotificationChannelFunc(ctx, id)
+}
+
+// UpdateNotificationChannel calls UpdateNotificationChannelFunc
+func (m *Mock) UpdateNotificationChannel(ctx context.Context, id string, req *client.NotificationChannelRequest) (*client.NotificationChannelResponse, error) {
+	m.record("UpdateNotificationChannel")
+	if m.UpdateNotificationChannelFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "UpdateNotificationChannel"}
+	}
+	return m.UpdateNotificationChannelFunc(ctx, id, req)
+}
+
+// DeleteNotificationChannel calls DeleteNotificationChannelFunc
+func (m *Mock) DeleteNotificationChannel(ctx context.Context, id string) error {
+	m.record("DeleteNotificationChannel")
+	if m.DeleteNotificationChannelFunc == nil {
+		return &ErrNotProgrammed{Method: "DeleteNotificationChannel"}
+	}
+	return m.DeleteNotificationChannelFunc(ctx, id)
+}
+
+// CreateSinkConsumer calls CreateSinkConsumerFunc
+func (m *Mock) CreateSinkConsumer(ctx context.Context, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+	m.record("CreateSinkConsumer")
+	if m.CreateSinkConsumerFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "CreateSinkConsumer"}
+	}
+	return m.CreateSinkConsumerFunc(ctx, req)
+}
+
+// GetSinkConsumer calls GetSinkConsumerFunc
+func (m *Mock) GetSinkConsumer(ctx context.Context, id string) (*client.SinkConsumerResponse, error) {
+	m.record("GetSinkConsumer")
+	if m.GetSinkConsumerFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetSinkConsumer"}
+	}
+	return m.GetSinkConsumerFunc(ctx, id)
+}
+
+// UpdateSinkConsumer calls UpdateSinkConsumerFunc
+func (m *Mock) UpdateSinkConsumer(ctx context.Context, id string, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+	m.record("UpdateSinkConsumer")
+	if m.UpdateSinkConsumerFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "UpdateSinkConsumer"}
+	}
+	return m.UpdateSinkConsumerFunc(ctx, id, req)
+}
+
+// DeleteSinkConsumer calls DeleteSinkConsumerFunc
+func (m *Mock) DeleteSinkConsumer(ctx context.Context, id string) error {
+	m.record("DeleteSinkConsumer")
+	if m.DeleteSinkConsumerFunc == nil {
+		return &ErrNotProgrammed{Method: "DeleteSinkConsumer"}
+	}
+	return m.DeleteSinkConsumerFunc(ctx, id)
+}
+
+// ListSinkConsumers calls ListSinkConsumersFunc
+func (m *Mock) ListSinkConsumers(ctx context.Context, opts client.SinkConsumerListOptions) ([]client.SinkConsumerResponse, error) {
+	m.record("ListSinkConsumers")
+	if m.ListSinkConsumersFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "ListSinkConsumers"}
+	}
+	return m.ListSinkConsumersFunc(ctx, opts)
+}
+
+// EachSinkConsumer calls EachSinkConsumerFunc
+func (m *Mock) EachSinkConsumer(ctx context.Context, opts client.SinkConsumerListOptions, fn func(client.SinkConsumerResponse) error) error {
+	m.record("EachSinkConsumer")
+	if m.EachSinkConsumerFunc == nil {
+		return &ErrNotProgrammed{Method: "EachSinkConsumer"}
+	}
+	return m.EachSinkConsumerFunc(ctx, opts, fn)
+}
+
+// GetSinkConsumerConfig calls GetSinkConsumerConfigFunc
+func (m *Mock) GetSinkConsumerConfig(ctx context.Context, id string) (json.RawMessage, error) {
+	m.record("GetSinkConsumerConfig")
+	if m.GetSinkConsumerConfigFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetSinkConsumerConfig"}
+	}
+	return m.GetSinkConsumerConfigFunc(ctx, id)
+}
+
+// GetSinkConsumerMetrics calls GetSinkConsumerMetricsFunc
+func (m *Mock) GetSinkConsumerMetrics(ctx context.Context, sinkIDOrName string) (*client.SinkConsumerMetrics, error) {
+	m.record("GetSinkConsumerMetrics")
+	if m.GetSinkConsumerMetricsFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetSinkConsumerMetrics"}
+	}
+	return m.GetSinkConsumerMetricsFunc(ctx, sinkIDOrName)
+}
+
+// GetSinkConsumerCursor calls GetSinkConsumerCursorFunc
+func (m *Mock) GetSinkConsumerCursor(ctx context.Context, sinkIDOrName string) (*client.SinkConsumerCursor, error) {
+	m.record("GetSinkConsumerCursor")
+	if m.GetSinkConsumerCursorFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetSinkConsumerCursor"}
+	}
+	return m.GetSinkConsumerCursorFunc(ctx, sinkIDOrName)
+}
+
+// ResetSinkConsumerCursor calls ResetSinkConsumerCursorFunc
+func (m *Mock) ResetSinkConsumerCursor(ctx context.Context, sinkIDOrName string, req *client.SinkConsumerCursorResetRequest) (*client.SinkConsumerCursor, error) {
+	m.record("ResetSinkConsumerCursor")
+	if m.ResetSinkConsumerCursorFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "ResetSinkConsumerCursor"}
+	}
+	return m.ResetSinkConsumerCursorFunc(ctx, sinkIDOrName, req)
+}
+
+// ReplaySinkConsumer calls ReplaySinkConsumerFunc
+func (m *Mock) ReplaySinkConsumer(ctx context.Context, sinkIDOrName string, req *client.ReplayRequest) (*client.ReplayResponse, error) {
+	m.record("ReplaySinkConsumer")
+	if m.ReplaySinkConsumerFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "ReplaySinkConsumer"}
+	}
+	return m.ReplaySinkConsumerFunc(ctx, sinkIDOrName, req)
+}
+
+// ListSinkMessages calls ListSinkMessagesFunc
+func (m *Mock) ListSinkMessages(ctx context.Context, sinkIDOrName string, opts client.SinkMessageListOptions) ([]client.SinkMessage, error) {
+	m.record("ListSinkMessages")
+	if m.ListSinkMessagesFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "ListSinkMessages"}
+	}
+	return m.ListSinkMessagesFunc(ctx, sinkIDOrName, opts)
+}
+
+// ListFailedMessages calls ListFailedMessagesFunc
+func (m *Mock) ListFailedMessages(ctx context.Context, sinkIDOrName string, opts client.SinkMessageListOptions) ([]client.SinkMessage, error) {
+	m.record("ListFailedMessages")
+	if m.ListFailedMessagesFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "ListFailedMessages"}
+	}
+	return m.ListFailedMessagesFunc(ctx, sinkIDOrName, opts)
+}
+
+// RedeliverFailedMessages calls RedeliverFailedMessagesFunc
+func (m *Mock) RedeliverFailedMessages(ctx context.Context, sinkIDOrName string, req *client.FailedMessageActionRequest) (*client.FailedMessageActionResponse, error) {
+	m.record("RedeliverFailedMessages")
+	if m.RedeliverFailedMessagesFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "RedeliverFailedMessages"}
+	}
+	return m.RedeliverFailedMessagesFunc(ctx, sinkIDOrName, req)
+}
+
+// PurgeFailedMessages calls PurgeFailedMessagesFunc
+func (m *Mock) PurgeFailedMessages(ctx context.Context, sinkIDOrName string, req *client.FailedMessageActionRequest) (*client.FailedMessageActionResponse, error) {
+	m.record("PurgeFailedMessages")
+	if m.PurgeFailedMessagesFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "PurgeFailedMessages"}
+	}
+	return m.PurgeFailedMessagesFunc(ctx, sinkIDOrName, req)
+}
+
+// CreateTableContract calls CreateTableContractFunc
+func (m *Mock) CreateTableContract(ctx context.Context, req *client.TableContractRequest) (*client.TableContractResponse, error) {
+	m.record("CreateTableContract")
+	if m.CreateTableContractFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "CreateTableContract"}
+	}
+	return m.CreateTableContractFunc(ctx, req)
+}
+
+// GetTableContract calls GetTableContractFunc
+func (m *Mock) GetTableContract(ctx context.Context, id string) (*client.TableContractResponse, error) {
+	m.record("GetTableContract")
+	if m.GetTableContractFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "GetTableContract"}
+	}
+	return m.GetTableContractFunc(ctx, id)
+}
+
+// UpdateTableContract calls UpdateTableContractFunc
+func (m *Mock) UpdateTableContract(ctx context.Context, id string, req *client.TableContractRequest) (*client.TableContractResponse, error) {
+	m.record("UpdateTableContract")
+	if m.UpdateTableContractFunc == nil {
+		return nil, &ErrNotProgrammed{Method: "UpdateTableContract"}
+	}
+	return m.UpdateTableContractFunc(ctx, id, req)
+}
+
+// DeleteTableContract calls DeleteTableContractFunc
+func (m *Mock) DeleteTableContract(ctx context.Context, id string) error {
+	m.record("DeleteTableContract")
+	if m.DeleteTableContractFunc == nil {
+		return &ErrNotProgrammed{Method: "DeleteTableContract"}
+	}
+	return m.DeleteTableContractFunc(ctx, id)
+}
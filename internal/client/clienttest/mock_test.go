@@ -0,0 +1,47 @@
+package clienttest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+)
+
+func TestMock_NotProgrammed(t *testing.T) {
+	m := &Mock{}
+	_, err := m.GetDatabase(context.Background(), "db-1")
+
+	var notProgrammed *ErrNotProgrammed
+	if !errors.As(err, &notProgrammed) || notProgrammed.Method != "GetDatabase" {
+		t.Errorf("GetDatabase() error = %v, want ErrNotProgrammed for GetDatabase", err)
+	}
+}
+
+func TestMock_RecordsCalls(t *testing.T) {
+	m := &Mock{
+		DeleteDatabaseFunc: func(ctx context.Context, id string) error { return nil },
+		GetDatabaseFunc: func(ctx context.Context, id string) (*client.DatabaseResponse, error) {
+			return &client.DatabaseResponse{ID: id}, nil
+		},
+	}
+
+	db, err := m.GetDatabase(context.Background(), "db-1")
+	if err != nil || db.ID != "db-1" {
+		t.Fatalf("GetDatabase() = %v, %v, want the programmed response", db, err)
+	}
+	if err := m.DeleteDatabase(context.Background(), "db-1"); err != nil {
+		t.Fatalf("DeleteDatabase() error: %v", err)
+	}
+
+	calls := m.Calls()
+	if len(calls) != 2 || calls[0] != "GetDatabase" || calls[1] != "DeleteDatabase" {
+		t.Errorf("Calls() = %v, want GetDatabase then DeleteDatabase", calls)
+	}
+}
+
+func TestMock_SupportsFeatureDefault(t *testing.T) {
+	if supported, _ := (&Mock{}).SupportsFeature(client.FeatureEnrichment); !supported {
+		t.Error("an unprogrammed Mock should support every feature")
+	}
+}
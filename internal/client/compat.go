@@ -0,0 +1,143 @@
+package client
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// fieldRename records an API field Sequin renamed. Requests to servers older
+// than Since use the legacy name; responses using it are read as the current
+// name, so one provider release works across the versions on either side.
+type fieldRename struct {
+	Since   string // First Sequin version using Current
+	Object  string // Key of the nested object holding the field, empty for top-level fields
+	Legacy  string
+	Current string
+}
+
+// sinkConsumerRenames lists the renamed sink consumer fields
+var sinkConsumerRenames = []fieldRename{
+	{Since: "0.8.0", Object: "destination", Legacy: "brokers", Current: "hosts"},
+	{Since: "0.8.0", Object: "destination", Legacy: "kinesis_stream_arn", Current: "stream_arn"},
+	{Since: "0.9.0", Object: "destination", Legacy: "http_path", Current: "http_endpoint_path"},
+}
+
+// renamesFor returns the field renames that apply to an API path
+func renamesFor(path string) []fieldRename {
+	// The endpoint may be served below a path prefix
+	if i := strings.Index(path, "/api/"); i > 0 {
+		path = path[i:]
+	}
+	rest, ok := strings.CutPrefix(path, "/api/sinks")
+	if ok && (rest == "" || (strings.HasPrefix(rest, "/") && !strings.Contains(rest[1:], "/"))) {
+		return sinkConsumerRenames
+	}
+	return nil
+}
+
+// translateRequest rewrites current field names in a request body to the
+// legacy names the server expects. Bodies are left alone when the server
+// version is unknown.
+func (c *Client) translateRequest(path string, body []byte) []byte {
+	renames := renamesFor(path)
+	if len(renames) == 0 || c.Server == nil {
+		return body
+	}
+	current, ok := parseVersion(c.Server.Version)
+	if !ok {
+		return body
+	}
+
+	var legacy []fieldRename
+	for _, rename := range renames {
+		if since, known := parseVersion(rename.Since); known && compareVersions(current, since) < 0 {
+			legacy = append(legacy, fieldRename{Object: rename.Object, Legacy: rename.Current, Current: rename.Legacy})
+		}
+	}
+	return renameFields(body, legacy)
+}
+
+// translateResponse rewrites legacy field names in a response body, or in
+// each element of a list response, to the current names
+func translateResponse(path string, body []byte) []byte {
+	renames := renamesFor(path)
+	if len(renames) == 0 {
+		return body
+	}
+
+	var list struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if json.Unmarshal(body, &list) == nil && list.Data != nil {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(body, &fields); err != nil {
+			return body
+		}
+		for i, item := range list.Data {
+			list.Data[i] = renameFields(item, renames)
+		}
+		data, err := json.Marshal(list.Data)
+		if err != nil {
+			return body
+		}
+		fields["data"] = data
+		if translated, err := json.Marshal(fields); err == nil {
+			return translated
+		}
+		return body
+	}
+
+	return renameFields(body, renames)
+}
+
+// renameFields moves each Legacy key of a JSON object to its Current name
+// unless the Current key is already set. Bodies that are not JSON objects are
+// returned unchanged.
+func renameFields(body []byte, renames []fieldRename) []byte {
+	var fields map[string]json.RawMessage
+	if len(renames) == 0 || json.Unmarshal(body, &fields) != nil {
+		return body
+	}
+
+	changed := false
+	nested := map[string]map[string]json.RawMessage{}
+	for _, rename := range renames {
+		target := fields
+		if rename.Object != "" {
+			obj, ok := nested[rename.Object]
+			if !ok {
+				if json.Unmarshal(fields[rename.Object], &obj) != nil || obj == nil {
+					continue
+				}
+				nested[rename.Object] = obj
+			}
+			target = obj
+		}
+
+		value, ok := target[rename.Legacy]
+		if !ok {
+			continue
+		}
+		if _, exists := target[rename.Current]; !exists {
+			target[rename.Current] = value
+		}
+		delete(target, rename.Legacy)
+		changed = true
+	}
+	if !changed {
+		return body
+	}
+
+	for name, obj := range nested {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return body
+		}
+		fields[name] = data
+	}
+	translated, err := json.Marshal(fields)
+	if err != nil {
+		return body
+	}
+	return translated
+}
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// gzipMinSize is the smallest request body worth compressing; below it the
+// gzip header outweighs the savings
+const gzipMinSize = 1024
+
+// requestBody returns the body to send for the request data and its
+// Content-Encoding, gzip-compressing large bodies when CompressRequests is
+// set. Responses are decompressed by the transport, which asks for gzip
+// unless a caller sets Accept-Encoding itself.
+func (c *Client) requestBody(data []byte) (io.Reader, string) {
+	if data == nil {
+		return nil, ""
+	}
+	if !c.CompressRequests || len(data) < gzipMinSize {
+		return bytes.NewReader(data), ""
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return bytes.NewReader(data), ""
+	}
+	if err := zw.Close(); err != nil {
+		return bytes.NewReader(data), ""
+	}
+	return &buf, "gzip"
+}
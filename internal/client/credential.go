@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CreateCredential creates a new stored credential
+func (c *Client) CreateCredential(ctx context.Context, req *CredentialRequest) (*CredentialResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/api/credentials", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CredentialResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to create credential: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Created credential", map[string]any{"id": result.ID, "name": result.Name})
+	return &result, nil
+}
+
+// GetCredential retrieves a stored credential by ID
+func (c *Client) GetCredential(ctx context.Context, id string) (*CredentialResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/credentials/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "credential", id)
+	}
+
+	var result CredentialResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get credential: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	return &result, nil
+}
+
+// UpdateCredential updates an existing stored credential. Sinks referencing
+// it pick up the new secrets without being updated themselves.
+func (c *Client) UpdateCredential(ctx context.Context, id string, req *CredentialRequest) (*CredentialResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/credentials/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CredentialResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to update credential: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Updated credential", map[string]any{"id": result.ID})
+	return &result, nil
+}
+
+// DeleteCredential deletes a stored credential by ID. The API refuses while
+// sink consumers still reference it.
+func (c *Client) DeleteCredential(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/credentials/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		tflog.Warn(ctx, "Credential already deleted", map[string]any{"id": id})
+		return nil
+	}
+
+	if err := c.handleResponse(ctx, resp, nil); err != nil {
+		return fmt.Errorf("failed to delete credential: %w", dependencyError(err))
+	}
+
+	tflog.Info(ctx, "Deleted credential", map[string]any{"id": id})
+	return nil
+}
@@ -0,0 +1,17 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// VerifyCredentials makes a cheap authenticated request, listing at most one
+// database, to check the endpoint is reachable and accepts the API key. The
+// returned error is an *APIError when the server answered.
+func (c *Client) VerifyCredentials(ctx context.Context) error {
+	resp, err := c.doRequest(WithoutReadCache(ctx), http.MethodGet, "/api/postgres_databases?limit=1", nil)
+	if err != nil {
+		return err
+	}
+	return c.handleResponse(ctx, resp, nil)
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GetSinkConsumerCursor retrieves the current delivery cursor of a sink consumer
+func (c *Client) GetSinkConsumerCursor(ctx context.Context, sinkIDOrName string) (*SinkConsumerCursor, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/sinks/%s/cursor", sinkIDOrName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "sink consumer", sinkIDOrName)
+	}
+
+	var result SinkConsumerCursor
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get sink consumer cursor: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ResetSinkConsumerCursor moves the delivery cursor of a sink consumer to the
+// given LSN. Delivery resumes from the first change after it.
+func (c *Client) ResetSinkConsumerCursor(ctx context.Context, sinkIDOrName string, req *SinkConsumerCursorResetRequest) (*SinkConsumerCursor, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/sinks/%s/cursor", sinkIDOrName), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SinkConsumerCursor
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to reset sink consumer cursor: %w", err)
+	}
+
+	tflog.Info(ctx, "Reset sink consumer cursor", map[string]any{"sink": sinkIDOrName, "lsn": result.LSN})
+	return &result, nil
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CreateDataClassification creates a new data classification annotation
+func (c *Client) CreateDataClassification(ctx context.Context, req *DataClassificationRequest) (*DataClassificationResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/api/data_classifications", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result DataClassificationResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to create data classification: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Created data classification", map[string]any{"id": result.ID, "table": result.Table})
+	return &result, nil
+}
+
+// GetDataClassification retrieves a data classification annotation by ID
+func (c *Client) GetDataClassification(ctx context.Context, id string) (*DataClassificationResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/data_classifications/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "data classification", id)
+	}
+
+	var result DataClassificationResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get data classification: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	return &result, nil
+}
+
+// ListDataClassifications lists every data classification annotation in the
+// account
+func (c *Client) ListDataClassifications(ctx context.Context) ([]DataClassificationResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/api/data_classifications", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := collectList[DataClassificationResponse](ctx, c, resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list data classifications: %w", err)
+	}
+
+	return items, nil
+}
+
+// UpdateDataClassification updates an existing data classification annotation
+func (c *Client) UpdateDataClassification(ctx context.Context, id string, req *DataClassificationRequest) (*DataClassificationResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/data_classifications/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result DataClassificationResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to update data classification: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Updated data classification", map[string]any{"id": result.ID})
+	return &result, nil
+}
+
+// DeleteDataClassification deletes a data classification annotation by ID
+func (c *Client) DeleteDataClassification(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/data_classifications/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		tflog.Warn(ctx, "Data classification already deleted", map[string]any{"id": id})
+		return nil
+	}
+
+	if err := c.handleResponse(ctx, resp, nil); err != nil {
+		return fmt.Errorf("failed to delete data classification: %w", err)
+	}
+
+	tflog.Info(ctx, "Deleted data classification", map[string]any{"id": id})
+	return nil
+}
@@ -8,61 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// ReplicationSlot represents a replication slot configuration
-type ReplicationSlot struct {
-	ID              string `json:"id,omitempty"`               // Computed (only in response/update)
-	PublicationName string `json:"publication_name"`           // Required
-	SlotName        string `json:"slot_name"`                  // Required
-	Status          string `json:"status,omitempty"`           // Optional: active, disabled
-}
-
-// PrimaryDatabase represents the primary database configuration when connecting to a replica
-type PrimaryDatabase struct {
-	Hostname string `json:"hostname"`
-	Database string `json:"database"`
-	Username string `json:"username"`
-	Password string `json:"password"`
-	Port     *int   `json:"port,omitempty"`
-	SSL      *bool  `json:"ssl,omitempty"`
-}
-
-// DatabaseRequest represents the request body for creating or updating a database
-type DatabaseRequest struct {
-	Name             string            `json:"name"`
-	URL              string            `json:"url,omitempty"`               // Alternative to individual connection params
-	Hostname         string            `json:"hostname,omitempty"`
-	Port             *int              `json:"port,omitempty"`
-	Database         string            `json:"database,omitempty"`
-	Username         string            `json:"username,omitempty"`
-	Password         string            `json:"password,omitempty"`
-	SSL              *bool             `json:"ssl,omitempty"`
-	IPv6             *bool             `json:"ipv6,omitempty"`
-	ReplicationSlots []ReplicationSlot `json:"replication_slots,omitempty"` // Required for create, optional for update
-	Primary          *PrimaryDatabase  `json:"primary,omitempty"`           // For replica configuration
-}
-
-// DatabaseResponse represents a database resource from the API
-type DatabaseResponse struct {
-	ID               string            `json:"id"`
-	Name             string            `json:"name"`
-	Hostname         string            `json:"hostname"`
-	Port             int               `json:"port"`
-	Database         string            `json:"database"`
-	Username         string            `json:"username"`
-	Password         string            `json:"password"`           // Obfuscated in response
-	SSL              bool              `json:"ssl"`
-	IPv6             bool              `json:"ipv6"`
-	UseLocalTunnel   bool              `json:"use_local_tunnel"`   // Computed
-	PoolSize         int               `json:"pool_size"`          // Computed
-	QueueInterval    int               `json:"queue_interval"`     // Computed
-	QueueTarget      int               `json:"queue_target"`       // Computed
-	ReplicationSlots []ReplicationSlot `json:"replication_slots"`
-	Primary          *PrimaryDatabase  `json:"primary,omitempty"`
-}
-
 // CreateDatabase creates a new database connection
 func (c *Client) CreateDatabase(ctx context.Context, req *DatabaseRequest) (*DatabaseResponse, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/api/postgres_databases", req)
+	resp, err := c.doRequest(withIdempotencyKey(ctx), http.MethodPost, "/api/postgres_databases", req)
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +19,7 @@ func (c *Client) CreateDatabase(ctx context.Context, req *DatabaseRequest) (*Dat
 	if err := c.handleResponse(ctx, resp, &result); err != nil {
 		return nil, fmt.Errorf("failed to create database: %w", err)
 	}
+	result.ETag = resp.Header.Get("ETag")
 
 	tflog.Info(ctx, "Created database", map[string]any{"id": result.ID, "name": result.Name})
 	return &result, nil
@@ -85,17 +34,31 @@ func (c *Client) GetDatabase(ctx context.Context, id string) (*DatabaseResponse,
 
 	if resp.StatusCode == http.StatusNotFound {
 		resp.Body.Close()
-		return nil, fmt.Errorf("database not found: %s", id)
+		return nil, notFoundError(resp, "database", id)
 	}
 
 	var result DatabaseResponse
 	if err := c.handleResponse(ctx, resp, &result); err != nil {
 		return nil, fmt.Errorf("failed to get database: %w", err)
 	}
+	result.ETag = resp.Header.Get("ETag")
 
 	return &result, nil
 }
 
+// ListDatabases lists all databases in the account, walking every page of the
+// list
+func (c *Client) ListDatabases(ctx context.Context) ([]DatabaseResponse, error) {
+	databases, err := paginateAll[DatabaseResponse](ctx, c, "/api/postgres_databases", DefaultPageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list databases: %w", err)
+	}
+
+	return databases, nil
+}
+
+func (d DatabaseResponse) listID() string { return d.ID }
+
 // UpdateDatabase updates an existing database
 func (c *Client) UpdateDatabase(ctx context.Context, id string, req *DatabaseRequest) (*DatabaseResponse, error) {
 	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/postgres_databases/%s", id), req)
@@ -107,6 +70,7 @@ func (c *Client) UpdateDatabase(ctx context.Context, id string, req *DatabaseReq
 	if err := c.handleResponse(ctx, resp, &result); err != nil {
 		return nil, fmt.Errorf("failed to update database: %w", err)
 	}
+	result.ETag = resp.Header.Get("ETag")
 
 	tflog.Info(ctx, "Updated database", map[string]any{"id": result.ID})
 	return &result, nil
@@ -126,7 +90,7 @@ func (c *Client) DeleteDatabase(ctx context.Context, id string) error {
 	}
 
 	if err := c.handleResponse(ctx, resp, nil); err != nil {
-		return fmt.Errorf("failed to delete database: %w", err)
+		return fmt.Errorf("failed to delete database: %w", dependencyError(err))
 	}
 
 	tflog.Info(ctx, "Deleted database", map[string]any{"id": id})
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// TestDatabaseConnection checks that Sequin can connect to a database with
+// the given settings and that its replication prerequisites are in place,
+// without creating it. A failed test is returned as a result with Success
+// false; errors are reserved for requests the API could not process.
+func (c *Client) TestDatabaseConnection(ctx context.Context, req *DatabaseRequest) (*DatabaseConnectionTestResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/api/postgres_databases/test_connection", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result DatabaseConnectionTestResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		// Failed tests are answered with 422 and the reason in the body
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnprocessableEntity &&
+			json.Unmarshal([]byte(apiErr.Body), &result) == nil && result.Reason != "" {
+			result.Success = false
+			return &result, nil
+		}
+		return nil, fmt.Errorf("failed to test database connection: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,71 @@
+package client
+
+// Desired statuses of a sink consumer
+const (
+	SinkStatusActive   = "active"
+	SinkStatusDisabled = "disabled"
+	SinkStatusPaused   = "paused"
+)
+
+// SinkStatuses lists the supported sink consumer status values
+var SinkStatuses = []string{SinkStatusActive, SinkStatusDisabled, SinkStatusPaused}
+
+// Change actions a sink consumer can capture
+const (
+	ActionInsert = "insert"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Actions lists the supported change action values
+var Actions = []string{ActionInsert, ActionUpdate, ActionDelete}
+
+// Sink consumer destination types
+const (
+	DestKafka         = "kafka"
+	DestSQS           = "sqs"
+	DestKinesis       = "kinesis"
+	DestWebhook       = "webhook"
+	DestPubSub        = "gcp_pubsub"
+	DestEventHub      = "azure_event_hub"
+	DestNATS          = "nats"
+	DestRabbitMQ      = "rabbitmq"
+	DestRedisStream   = "redis_stream"
+	DestRedisString   = "redis_string"
+	DestElasticsearch = "elasticsearch"
+	DestTypesense     = "typesense"
+	DestMeilisearch   = "meilisearch"
+)
+
+// DestinationTypes lists the supported destination type values
+var DestinationTypes = []string{
+	DestKafka, DestSQS, DestKinesis, DestWebhook, DestPubSub, DestEventHub,
+	DestNATS, DestRabbitMQ, DestRedisStream, DestRedisString, DestElasticsearch,
+	DestTypesense, DestMeilisearch,
+}
+
+// SearchDestinationTypes lists the destination types that index records as
+// documents
+var SearchDestinationTypes = []string{DestElasticsearch, DestTypesense, DestMeilisearch}
+
+// RabbitMQExchangeTypes lists the supported RabbitMQ exchange types
+var RabbitMQExchangeTypes = []string{"direct", "fanout", "topic", "headers"}
+
+// Elasticsearch authentication types
+const (
+	ElasticsearchAuthAPIKey = "api_key"
+	ElasticsearchAuthBasic  = "basic"
+)
+
+// ElasticsearchAuthTypes lists the supported Elasticsearch authentication types
+var ElasticsearchAuthTypes = []string{ElasticsearchAuthAPIKey, ElasticsearchAuthBasic}
+
+// Backfill states. Completed is reported by the API but cannot be requested.
+const (
+	BackfillStateActive    = "active"
+	BackfillStateCancelled = "cancelled"
+	BackfillStateCompleted = "completed"
+)
+
+// BackfillStates lists the backfill state values that can be requested
+var BackfillStates = []string{BackfillStateActive, BackfillStateCancelled}
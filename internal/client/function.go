@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GetFunction retrieves a function by ID or name
+func (c *Client) GetFunction(ctx context.Context, idOrName string) (*FunctionResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/functions/%s", idOrName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "function", idOrName)
+	}
+
+	var result FunctionResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get function: %w", err)
+	}
+
+	return &result, nil
+}
+
+// TestFunction runs a function against a sample message without side effects
+func (c *Client) TestFunction(ctx context.Context, idOrName string, req *FunctionTestRequest) (*FunctionTestResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/api/functions/%s/test", idOrName), req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "function", idOrName)
+	}
+
+	var result FunctionTestResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to test function: %w", err)
+	}
+
+	return &result, nil
+}
+
+// EvaluateFilter dry-runs a filter function against sample records
+func (c *Client) EvaluateFilter(ctx context.Context, idOrName string, req *FilterEvaluationRequest) ([]FilterEvaluationResult, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/api/functions/%s/evaluate", idOrName), req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "function", idOrName)
+	}
+
+	var result FilterEvaluationResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to evaluate filter: %w", err)
+	}
+
+	return result.Results, nil
+}
+
+// ValidateFunction checks that a function is valid for the given database
+func (c *Client) ValidateFunction(ctx context.Context, idOrName string, req *FunctionValidationRequest) (*FunctionValidationResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/api/functions/%s/validate", idOrName), req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "function", idOrName)
+	}
+
+	var result FunctionValidationResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to validate function: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,8 @@
+package client
+
+// The request and response models the client sends and receives are
+// generated from Sequin's OpenAPI document in api/openapi.json, merged with
+// the x-go extensions and provider-only schemas of api/openapi-overlay.json;
+// the methods calling the API are written by hand around them.
+
+//go:generate go run ../codegen/clientgen -spec ../../api/openapi.json -overlay ../../api/openapi-overlay.json -out models_gen.go
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Health statuses reported by Health when the server omits one
+const (
+	HealthStatusOK          = "ok"
+	HealthStatusUnavailable = "unavailable"
+)
+
+// Healthy reports whether the server is ready to serve requests
+func (h *HealthResponse) Healthy() bool {
+	return h.Status == HealthStatusOK
+}
+
+// Health checks the server's health endpoint. Reads always reach the server.
+// A 503 answer is returned as an unhealthy status rather than an error, once
+// MaxUnavailableWait is exhausted like for any other request.
+func (c *Client) Health(ctx context.Context) (*HealthResponse, error) {
+	resp, err := c.doRequest(WithoutReadCache(ctx), http.MethodGet, "/health", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check server health: %w", err)
+	}
+
+	var result HealthResponse
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		_ = json.Unmarshal(body, &result)
+		if result.Status == "" || result.Status == HealthStatusOK {
+			result.Status = HealthStatusUnavailable
+		}
+	} else {
+		if err := c.handleResponse(ctx, resp, &result); err != nil {
+			return nil, fmt.Errorf("failed to check server health: %w", err)
+		}
+		if result.Status == "" {
+			result.Status = HealthStatusOK
+		}
+	}
+
+	if result.Version == "" {
+		result.Version = c.ServerVersion()
+	}
+	return &result, nil
+}
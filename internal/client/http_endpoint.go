@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GetHTTPEndpoint retrieves an HTTP endpoint by ID or name
+func (c *Client) GetHTTPEndpoint(ctx context.Context, idOrName string) (*HTTPEndpointResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/http_endpoints/%s", idOrName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "HTTP endpoint", idOrName)
+	}
+
+	var result HTTPEndpointResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get HTTP endpoint: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// IdempotencyKeyHeader carries the key the server uses to recognise a repeated
+// create and return the resource it already made instead of a duplicate
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotentKey holds the nonce of the create a context's requests belong to
+type idempotentKey struct{}
+
+// withIdempotencyKey returns a context whose requests carry an
+// Idempotency-Key unique to this create. Retries of the request repeat it, so
+// the server can make them safe, while a later create of the same
+// configuration, such as after -replace or a destroy, gets a new key instead
+// of the response to the original. The framework does not hand plan-time
+// private state to Create, and a failed create leaves nothing in state, so a
+// nonce per create call lasts exactly as long as one kept in the plan would.
+func withIdempotencyKey(ctx context.Context) context.Context {
+	nonce := make([]byte, 16)
+	rand.Read(nonce)
+	return context.WithValue(ctx, idempotentKey{}, hex.EncodeToString(nonce))
+}
+
+// idempotencyNonce returns the nonce of the create a request belongs to, if
+// it carries an Idempotency-Key
+func idempotencyNonce(ctx context.Context) (string, bool) {
+	nonce, ok := ctx.Value(idempotentKey{}).(string)
+	return nonce, ok
+}
+
+// idempotencyKey derives the key of a request from the create's nonce and the
+// request's method, path and body, so a key is never reused for a different
+// request
+func idempotencyKey(nonce, method, path string, data []byte) string {
+	h := sha256.New()
+	h.Write([]byte(nonce))
+	h.Write([]byte{0})
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// ifMatchKey holds the entity tag updates are conditional on
+type ifMatchKey struct{}
+
+// WithIfMatch returns a context whose updates carry an If-Match header with
+// the entity tag, so the server rejects them with 412 Precondition Failed
+// when the resource changed since the tag was read. An empty tag leaves
+// updates unconditional.
+func WithIfMatch(ctx context.Context, etag string) context.Context {
+	if etag == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ifMatchKey{}, etag)
+}
+
+// IfMatchFromContext returns the entity tag WithIfMatch attached to the
+// context, so stand-ins for the client can check updates are conditional
+func IfMatchFromContext(ctx context.Context) string {
+	etag, _ := ctx.Value(ifMatchKey{}).(string)
+	return etag
+}
+
+// ifMatch returns the entity tag a request is conditional on. Only updates
+// are conditional; reads made with the same context are not.
+func ifMatch(ctx context.Context, method string) string {
+	if method != http.MethodPut && method != http.MethodPatch {
+		return ""
+	}
+	return IfMatchFromContext(ctx)
+}
+
+// IsPreconditionFailedError checks if an error is a 412 Precondition Failed,
+// which the API returns when an If-Match update targets a resource modified
+// since its entity tag was read
+func IsPreconditionFailedError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusPreconditionFailed
+}
+
+// EntityTagged is implemented by API responses carrying the entity tag of the
+// resource version they describe
+type EntityTagged interface {
+	EntityTag() string
+}
+
+// EntityTag returns the entity tag of the account settings
+func (r *AccountSettingsResponse) EntityTag() string { return r.ETag }
+
+// EntityTag returns the entity tag of the alert
+func (r *AlertResponse) EntityTag() string { return r.ETag }
+
+// EntityTag returns the entity tag of the audit log export
+func (r *AuditLogExportResponse) EntityTag() string { return r.ETag }
+
+// EntityTag returns the entity tag of the backfill
+func (r *BackfillResponse) EntityTag() string { return r.ETag }
+
+// EntityTag returns the entity tag of the credential
+func (r *CredentialResponse) EntityTag() string { return r.ETag }
+
+// EntityTag returns the entity tag of the data classification
+func (r *DataClassificationResponse) EntityTag() string { return r.ETag }
+
+// EntityTag returns the entity tag of the database
+func (r *DatabaseResponse) EntityTag() string { return r.ETag }
+
+// EntityTag returns the entity tag of the metrics settings
+func (r *MetricsSettingsResponse) EntityTag() string { return r.ETag }
+
+// EntityTag returns the entity tag of the notification channel
+func (r *NotificationChannelResponse) EntityTag() string { return r.ETag }
+
+// EntityTag returns the entity tag of the sink consumer
+func (r *SinkConsumerResponse) EntityTag() string { return r.ETag }
+
+// EntityTag returns the entity tag of the table contract
+func (r *TableContractResponse) EntityTag() string { return r.ETag }
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SetMaxConcurrentRequests limits how many API requests the client has in
+// flight at once, shared by every resource and data source using it. A
+// request is in flight until its response body is closed. Zero or less
+// removes the limit.
+func (c *Client) SetMaxConcurrentRequests(n int) {
+	if n <= 0 {
+		c.limiter = nil
+		return
+	}
+	c.limiter = make(chan struct{}, n)
+}
+
+// acquire waits for a free request slot and returns the function releasing
+// it. Without a limit it returns immediately.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.limiter == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case c.limiter <- struct{}{}:
+		return func() { <-c.limiter }, nil
+	default:
+	}
+
+	start := time.Now()
+	select {
+	case c.limiter <- struct{}{}:
+		tflog.Debug(ctx, "Waited for an API request slot", map[string]any{
+			"limit":   cap(c.limiter),
+			"wait_ms": time.Since(start).Milliseconds(),
+		})
+		return func() { <-c.limiter }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("request failed: %w", ctx.Err())
+	}
+}
+
+// releasingBody is a response body releasing its request slot when closed
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+// Close closes the body and releases the slot, once however often it is
+// called
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// SetRequestsPerSecond paces API requests to a steady rate, shared by every
+// resource and data source using the client, allowing bursts of up to one
+// second's worth of requests after idle periods. Zero or less removes the
+// limit.
+func (c *Client) SetRequestsPerSecond(rps float64) {
+	if rps <= 0 {
+		c.pacer = nil
+		return
+	}
+	burst := math.Max(1, math.Floor(rps))
+	c.pacer = &tokenBucket{rate: rps, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// pace waits until the request rate allows another request. Without a limit
+// it returns immediately.
+func (c *Client) pace(ctx context.Context) error {
+	if c.pacer == nil {
+		return nil
+	}
+
+	wait := c.pacer.reserve(time.Now())
+	if wait <= 0 {
+		return nil
+	}
+
+	select {
+	case <-time.After(wait):
+		tflog.Debug(ctx, "Waited for the API request rate limit", map[string]any{
+			"requests_per_second": c.pacer.rate,
+			"wait_ms":             wait.Milliseconds(),
+		})
+		return nil
+	case <-ctx.Done():
+		c.pacer.refund()
+		return fmt.Errorf("request failed: %w", ctx.Err())
+	}
+}
+
+// tokenBucket hands out tokens at a steady rate, holding at most burst of
+// them while unused
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // Tokens added per second
+	burst  float64
+	tokens float64 // Negative while requests wait for tokens yet to be added
+	last   time.Time
+}
+
+// reserve takes a token and returns how long to wait until it is available
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// refund returns a reserved token whose request was abandoned
+func (b *tokenBucket) refund() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
@@ -0,0 +1,222 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// nextCursorField is the list response member holding the cursor of the
+// following page, on endpoints with cursor pagination
+const nextCursorField = "next_cursor"
+
+// decodeList streams the items of a {"data": [...]} list response to each as
+// they are decoded, so large lists are never held in memory as a whole.
+// Error responses are handled like handleResponse. Returning an error from
+// each stops decoding.
+func decodeList[T any](ctx context.Context, c *Client, resp *http.Response, each func(T) error) error {
+	_, err := decodePage(ctx, c, resp, each)
+	return err
+}
+
+// decodePage is decodeList returning the response's next cursor as well,
+// empty when the response has none
+func decodePage[T any](ctx context.Context, c *Client, resp *http.Response, each func(T) error) (string, error) {
+	if resp.StatusCode >= 400 {
+		return "", c.handleResponse(ctx, resp, nil)
+	}
+	defer resp.Body.Close()
+
+	path := ""
+	if resp.Request != nil {
+		path = resp.Request.URL.Path
+	}
+	renames := renamesFor(path)
+
+	decoder := json.NewDecoder(resp.Body)
+	if tok, err := decoder.Token(); err == io.EOF {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to unmarshal response: %w", err)
+	} else if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return "", fmt.Errorf("failed to unmarshal response: expected an object, got %v", tok)
+	}
+
+	count, next := 0, ""
+	for decoder.More() {
+		tok, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		key, _ := tok.(string)
+		if key == nextCursorField {
+			var cursor *string
+			if err := decoder.Decode(&cursor); err != nil {
+				return "", fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+			if cursor != nil {
+				next = *cursor
+			}
+			continue
+		}
+		if key != "data" {
+			if c.StrictMode {
+				return "", fmt.Errorf("failed to unmarshal response in strict mode (the Sequin server may be newer than this provider): unknown field %q", key)
+			}
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return "", fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+			continue
+		}
+
+		if tok, err := decoder.Token(); err != nil {
+			return "", fmt.Errorf("failed to unmarshal response: %w", err)
+		} else if tok == nil {
+			continue // "data": null
+		} else if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return "", fmt.Errorf("failed to unmarshal response: expected a data list, got %v", tok)
+		}
+
+		for decoder.More() {
+			var item T
+			if err := c.decodeListItem(decoder, renames, &item); err != nil {
+				return "", err
+			}
+			if err := each(item); err != nil {
+				return "", err
+			}
+			count++
+		}
+		if _, err := decoder.Token(); err != nil {
+			return "", fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	tflog.Debug(ctx, "Decoded API list response", map[string]any{"path": path, "items": count})
+	return next, nil
+}
+
+// decodeListItem decodes the next list item into target, translating legacy
+// field names first when the endpoint has renamed fields
+func (c *Client) decodeListItem(decoder *json.Decoder, renames []fieldRename, target any) error {
+	if len(renames) == 0 && !c.StrictMode {
+		if err := decoder.Decode(target); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		return nil
+	}
+
+	var raw json.RawMessage
+	if err := decoder.Decode(&raw); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	raw = renameFields(raw, renames)
+	if c.StrictMode {
+		return decodeStrict(raw, target)
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return nil
+}
+
+// collectList decodes a list response into a slice
+func collectList[T any](ctx context.Context, c *Client, resp *http.Response) ([]T, error) {
+	items := make([]T, 0)
+	err := decodeList(ctx, c, resp, func(item T) error {
+		items = append(items, item)
+		return nil
+	})
+	return items, err
+}
+
+// DefaultPageSize is the number of items requested per page when walking a
+// paginated list
+const DefaultPageSize = 100
+
+// listItem is implemented by list items paginate can walk. The ID lets it
+// notice servers that ignore pagination.
+type listItem interface {
+	listID() string
+}
+
+// paginate walks a list endpoint page by page, calling fn for every item as
+// it is decoded. A response carrying a next cursor is followed with the
+// cursor query parameter; without one, pages are requested with limit and
+// offset query parameters. A perPage of zero uses DefaultPageSize. The walk
+// ends at the last cursor page, at a short page, or at a page repeating
+// earlier items, which is what servers without pagination return for every
+// offset. Returning an error from fn stops the walk.
+func paginate[T listItem](ctx context.Context, c *Client, path string, perPage int, fn func(T) error) error {
+	if perPage <= 0 {
+		perPage = DefaultPageSize
+	}
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+
+	seen := map[string]bool{}
+	cursor := ""
+	for offset := 0; ; offset += perPage {
+		query := url.Values{}
+		query.Set("limit", strconv.Itoa(perPage))
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		} else {
+			query.Set("offset", strconv.Itoa(offset))
+		}
+		resp, err := c.doRequest(ctx, http.MethodGet, path+sep+query.Encode(), nil)
+		if err != nil {
+			return err
+		}
+
+		count, repeated := 0, false
+		next, err := decodePage(ctx, c, resp, func(item T) error {
+			count++
+			if seen[item.listID()] {
+				repeated = true
+				return nil
+			}
+			seen[item.listID()] = true
+			return fn(item)
+		})
+		if err != nil {
+			return err
+		}
+		if repeated || count == 0 {
+			return nil
+		}
+		if next != "" {
+			if next == cursor {
+				return nil
+			}
+			cursor = next
+			continue
+		}
+		if cursor != "" || count < perPage {
+			return nil
+		}
+	}
+}
+
+// paginateAll collects every item of a paginated list endpoint into a slice
+func paginateAll[T listItem](ctx context.Context, c *Client, path string, perPage int) ([]T, error) {
+	items := make([]T, 0)
+	err := paginate(ctx, c, path, perPage, func(item T) error {
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
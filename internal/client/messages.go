@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SinkMessageListOptions narrows a sink message listing
+type SinkMessageListOptions struct {
+	State string // pending or delivered; empty for both
+	Limit int    // Maximum number of messages; zero lists every message
+}
+
+// ListSinkMessages fetches a sample of messages for a sink consumer
+func (c *Client) ListSinkMessages(ctx context.Context, sinkIDOrName string, opts SinkMessageListOptions) ([]SinkMessage, error) {
+	messages, err := c.listMessages(ctx, fmt.Sprintf("/api/sinks/%s/messages", sinkIDOrName), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sink messages: %w", err)
+	}
+	return messages, nil
+}
+
+func (m SinkMessage) listID() string { return m.ID }
+
+// errMessageLimit stops a message listing once the requested number of
+// messages has been collected
+var errMessageLimit = errors.New("message limit reached")
+
+// messageQuery appends the state filter of opts to a message list path
+func messageQuery(path string, opts SinkMessageListOptions) string {
+	if opts.State == "" {
+		return path
+	}
+	query := url.Values{}
+	query.Set("state", opts.State)
+	return path + "?" + query.Encode()
+}
+
+// listMessages walks a message list endpoint page by page until opts.Limit
+// messages are collected, so limits above the server's page size are honoured
+func (c *Client) listMessages(ctx context.Context, path string, opts SinkMessageListOptions) ([]SinkMessage, error) {
+	perPage := DefaultPageSize
+	if opts.Limit > 0 && opts.Limit < perPage {
+		perPage = opts.Limit
+	}
+
+	messages := make([]SinkMessage, 0)
+	err := paginate(ctx, c, messageQuery(path, opts), perPage, func(m SinkMessage) error {
+		messages = append(messages, m)
+		if opts.Limit > 0 && len(messages) >= opts.Limit {
+			return errMessageLimit
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errMessageLimit) {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// ListFailedMessages lists messages in a sink consumer's failed and discarded set
+func (c *Client) ListFailedMessages(ctx context.Context, sinkIDOrName string, opts SinkMessageListOptions) ([]SinkMessage, error) {
+	messages, err := c.listMessages(ctx, fmt.Sprintf("/api/sinks/%s/failed_messages", sinkIDOrName), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list failed messages: %w", err)
+	}
+	return messages, nil
+}
+
+// RedeliverFailedMessages queues failed messages for another delivery attempt
+func (c *Client) RedeliverFailedMessages(ctx context.Context, sinkIDOrName string, req *FailedMessageActionRequest) (*FailedMessageActionResponse, error) {
+	return c.failedMessageAction(ctx, sinkIDOrName, "redeliver", req)
+}
+
+// PurgeFailedMessages permanently discards failed messages
+func (c *Client) PurgeFailedMessages(ctx context.Context, sinkIDOrName string, req *FailedMessageActionRequest) (*FailedMessageActionResponse, error) {
+	return c.failedMessageAction(ctx, sinkIDOrName, "purge", req)
+}
+
+// failedMessageAction runs a redeliver or purge action on failed messages
+func (c *Client) failedMessageAction(ctx context.Context, sinkIDOrName, action string, req *FailedMessageActionRequest) (*FailedMessageActionResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/api/sinks/%s/failed_messages/%s", sinkIDOrName, action), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result FailedMessageActionResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to %s failed messages: %w", action, err)
+	}
+
+	tflog.Info(ctx, "Ran failed message action", map[string]any{"sink": sinkIDOrName, "action": action, "count": result.Count})
+	return &result, nil
+}
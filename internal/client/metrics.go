@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GetSinkConsumerMetrics retrieves operational metrics for a sink consumer
+func (c *Client) GetSinkConsumerMetrics(ctx context.Context, sinkIDOrName string) (*SinkConsumerMetrics, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/sinks/%s/metrics", sinkIDOrName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "sink consumer", sinkIDOrName)
+	}
+
+	var result SinkConsumerMetrics
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get sink consumer metrics: %w", err)
+	}
+
+	return &result, nil
+}
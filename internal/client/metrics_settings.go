@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// metricsSettingsPath is the account-wide metrics endpoint settings path
+const metricsSettingsPath = "/api/settings/metrics"
+
+// GetMetricsSettings retrieves the metrics endpoint settings
+func (c *Client) GetMetricsSettings(ctx context.Context) (*MetricsSettingsResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, metricsSettingsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result MetricsSettingsResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get metrics settings: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	return &result, nil
+}
+
+// UpdateMetricsSettings updates the metrics endpoint settings
+func (c *Client) UpdateMetricsSettings(ctx context.Context, req *MetricsSettingsRequest) (*MetricsSettingsResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, metricsSettingsPath, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result MetricsSettingsResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to update metrics settings: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Updated metrics settings", map[string]any{"enabled": result.Enabled, "auth_required": result.AuthRequired})
+	return &result, nil
+}
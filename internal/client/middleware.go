@@ -0,0 +1,25 @@
+package client
+
+import "net/http"
+
+// RoundTripFunc sends an API request and returns its response
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the sending of API requests, for audit logging, request
+// signing or metrics. It sees every attempt, including retries, with the
+// authentication and other headers already set.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use adds middleware to the client. Middleware added first runs outermost.
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// roundTrip sends the request through the middleware chain
+func (c *Client) roundTrip(httpClient *http.Client, req *http.Request) (*http.Response, error) {
+	next := RoundTripFunc(httpClient.Do)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		next = c.middleware[i](next)
+	}
+	return next(req)
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CreateNotificationChannel creates a new notification channel
+func (c *Client) CreateNotificationChannel(ctx context.Context, req *NotificationChannelRequest) (*NotificationChannelResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/api/notification_channels", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result NotificationChannelResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to create notification channel: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Created notification channel", map[string]any{"id": result.ID, "name": result.Name})
+	return &result, nil
+}
+
+// GetNotificationChannel retrieves a notification channel by ID
+func (c *Client) GetNotificationChannel(ctx context.Context, id string) (*NotificationChannelResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/notification_channels/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "notification channel", id)
+	}
+
+	var result NotificationChannelResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get notification channel: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	return &result, nil
+}
+
+// UpdateNotificationChannel updates an existing notification channel
+func (c *Client) UpdateNotificationChannel(ctx context.Context, id string, req *NotificationChannelRequest) (*NotificationChannelResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/notification_channels/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result NotificationChannelResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to update notification channel: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Updated notification channel", map[string]any{"id": result.ID})
+	return &result, nil
+}
+
+// DeleteNotificationChannel deletes a notification channel by ID
+func (c *Client) DeleteNotificationChannel(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/notification_channels/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		tflog.Warn(ctx, "Notification channel already deleted", map[string]any{"id": id})
+		return nil
+	}
+
+	if err := c.handleResponse(ctx, resp, nil); err != nil {
+		return fmt.Errorf("failed to delete notification channel: %w", err)
+	}
+
+	tflog.Info(ctx, "Deleted notification channel", map[string]any{"id": id})
+	return nil
+}
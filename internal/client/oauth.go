@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// OAuth grant types and token types used by the token exchange
+const (
+	grantClientCredentials = "client_credentials"
+	grantTokenExchange     = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeJWT           = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+// tokenRefreshMargin is how long before expiry a cached token is replaced, so
+// requests never carry a token that expires in flight
+const tokenRefreshMargin = time.Minute
+
+// OAuthConfig configures obtaining bearer tokens from an OAuth2 token
+// endpoint instead of using a static API key
+type OAuthConfig struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+
+	// SubjectTokenFile, when set, holds a workload identity token (such as a
+	// CI OIDC token) exchanged for a Sequin token with the RFC 8693 token
+	// exchange grant. Otherwise the client credentials grant is used. The
+	// file is re-read for every exchange, as CI runners rotate it.
+	SubjectTokenFile string
+}
+
+// tokenSource supplies bearer tokens that may be replaced during a run
+type tokenSource interface {
+	// bearerToken returns the current token, obtaining one if needed
+	bearerToken(ctx context.Context) (string, error)
+
+	// invalidate drops the token the API rejected so the next bearerToken
+	// call obtains a new one. Tokens replaced in the meantime are kept.
+	invalidate(rejected string)
+}
+
+// oauthTokenSource fetches bearer tokens and caches them until shortly
+// before they expire
+type oauthTokenSource struct {
+	config     OAuthConfig
+	httpClient *http.Client
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time // Zero when the token does not expire
+}
+
+// oauthTokenResponse is the token endpoint's success response
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// ConfigureOAuth makes the client authenticate with tokens from an OAuth2
+// token endpoint, fetched with the client's HTTP client and refreshed as they
+// expire. It fetches the first token right away so misconfiguration surfaces
+// when the provider is configured.
+func (c *Client) ConfigureOAuth(ctx context.Context, config OAuthConfig) error {
+	source := &oauthTokenSource{config: config, httpClient: c.HTTPClient}
+	if _, err := source.bearerToken(ctx); err != nil {
+		return err
+	}
+	c.tokens = source
+	return nil
+}
+
+// bearerToken returns the token to authenticate requests with
+func (c *Client) bearerToken(ctx context.Context) (string, error) {
+	if c.tokens == nil {
+		return c.APIKey, nil
+	}
+	return c.tokens.bearerToken(ctx)
+}
+
+// bearerToken returns the cached token, fetching a new one when it is about
+// to expire
+func (s *oauthTokenSource) bearerToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expires.IsZero() || time.Now().Add(tokenRefreshMargin).Before(s.expires)) {
+		return s.token, nil
+	}
+
+	token, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token = token.AccessToken
+	s.expires = time.Time{}
+	if token.ExpiresIn > 0 {
+		s.expires = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	tflog.Debug(ctx, "Obtained Sequin API token", map[string]any{"expires_in": token.ExpiresIn})
+	return s.token, nil
+}
+
+// invalidate drops the cached token if it is the rejected one
+func (s *oauthTokenSource) invalidate(rejected string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == rejected {
+		s.token = ""
+	}
+}
+
+// fetch requests a new token from the token endpoint
+func (s *oauthTokenSource) fetch(ctx context.Context) (*oauthTokenResponse, error) {
+	form := url.Values{}
+	form.Set("client_id", s.config.ClientID)
+	if s.config.ClientSecret != "" {
+		form.Set("client_secret", s.config.ClientSecret)
+	}
+	if len(s.config.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.config.Scopes, " "))
+	}
+	if s.config.SubjectTokenFile != "" {
+		subject, err := os.ReadFile(s.config.SubjectTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read subject token: %w", err)
+		}
+		form.Set("grant_type", grantTokenExchange)
+		form.Set("subject_token", strings.TrimSpace(string(subject)))
+		form.Set("subject_token_type", tokenTypeJWT)
+	} else {
+		form.Set("grant_type", grantClientCredentials)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint returned status %d: %s", resp.StatusCode, body)
+	}
+
+	var token oauthTokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("token response has no access_token")
+	}
+	if token.TokenType != "" && !strings.EqualFold(token.TokenType, "bearer") {
+		return nil, fmt.Errorf("token endpoint returned an unsupported %q token", token.TokenType)
+	}
+	return &token, nil
+}
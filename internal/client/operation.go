@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// WithOperation tags the context with a new operation ID so the log lines of
+// one Terraform operation, including the client's request logs, can be tied
+// together with TF_LOG=debug. The operation names the resource or data source
+// type and the action, e.g. sequin_database.create.
+func WithOperation(ctx context.Context, operation string) context.Context {
+	ctx = tflog.SetField(ctx, "operation_id", newOperationID())
+	ctx = tflog.SetField(ctx, "operation", operation)
+	tflog.Debug(ctx, "Starting operation")
+	return ctx
+}
+
+// newOperationID returns a random identifier for an operation
+func newOperationID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		// crypto/rand never fails on supported platforms
+		return "unknown"
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Sequin platforms the provider can target
+const (
+	PlatformCloud      = "cloud"
+	PlatformSelfHosted = "self_hosted"
+)
+
+// CloudEndpoint is the API endpoint of Sequin Cloud, used when the platform
+// is cloud and no endpoint is configured
+const CloudEndpoint = "https://api.sequinstream.com"
+
+// cloudDomain is the domain Sequin Cloud API endpoints are served from
+const cloudDomain = "sequinstream.com"
+
+// Platforms lists the supported platform values
+var Platforms = []string{PlatformCloud, PlatformSelfHosted}
+
+// InferPlatform guesses the platform from the API endpoint when it is not
+// configured: Sequin Cloud endpoints are served from sequinstream.com, any
+// other host is a self-hosted instance
+func InferPlatform(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return PlatformSelfHosted
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == cloudDomain || strings.HasSuffix(host, "."+cloudDomain) {
+		return PlatformCloud
+	}
+	return PlatformSelfHosted
+}
+
+// IsCloud reports whether the client targets Sequin Cloud
+func (c *Client) IsCloud() bool {
+	return c.Platform == PlatformCloud
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultMaxRateLimitWait bounds the total wait for rate-limited requests
+// unless the provider configures another limit
+const DefaultMaxRateLimitWait = 5 * time.Minute
+
+// rateLimitFallbackDelay is the first wait after a 429 without rate-limit
+// headers, doubling on each further 429
+var rateLimitFallbackDelay = time.Second
+
+// sendWaitingForRateLimit sends the request, waiting and retrying while the
+// server answers 429 Too Many Requests for as long as it asks, until
+// MaxRateLimitWait is spent. The last 429 response is returned once waiting
+// longer would exceed it.
+func (c *Client) sendWaitingForRateLimit(ctx context.Context, method, path string, data []byte) (*http.Response, error) {
+	var waited time.Duration
+	fallback := rateLimitFallbackDelay
+
+	for {
+		resp, err := c.send(ctx, method, path, data)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || c.MaxRateLimitWait <= 0 {
+			return resp, err
+		}
+
+		wait, ok := rateLimitDelay(resp, time.Now())
+		if !ok {
+			wait = fallback
+			fallback *= 2
+		}
+		if waited+wait > c.MaxRateLimitWait {
+			tflog.Warn(ctx, "Sequin API rate limit persists beyond the maximum wait, giving up", map[string]any{
+				"method":   method,
+				"path":     path,
+				"waited":   waited.String(),
+				"max_wait": c.MaxRateLimitWait.String(),
+			})
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		tflog.Info(ctx, "Rate limited by the Sequin API, waiting", map[string]any{
+			"method":   method,
+			"path":     path,
+			"interval": wait.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("request failed: %w", ctx.Err())
+		case <-time.After(wait):
+		}
+		waited += wait
+	}
+}
+
+// rateLimitDelay returns how long a rate-limited response asks the client to
+// wait: Retry-After as seconds or an HTTP date, then RateLimit-Reset as
+// seconds, then X-RateLimit-Reset as a Unix timestamp
+func rateLimitDelay(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if value := resp.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if at, err := http.ParseTime(value); err == nil {
+			return nonNegative(at.Sub(now)), true
+		}
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("RateLimit-Reset")); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if epoch, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		return nonNegative(time.Unix(epoch, 0).Sub(now)), true
+	}
+	return 0, false
+}
+
+// nonNegative clamps negative durations, such as reset times in the past, to zero
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
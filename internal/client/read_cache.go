@@ -0,0 +1,124 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// readCache holds successful GET responses for a short time so resources and
+// data sources reading the same objects during one run share an API call.
+// Identical GETs made while one is in flight share it too. Any write clears
+// it, since a write may change what other paths return.
+type readCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cachedResponse
+
+	inFlight sharedReads
+}
+
+// cachedResponse is a GET response kept by the read cache
+type cachedResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+	request    *http.Request
+	expires    time.Time
+}
+
+// noReadCacheKey marks contexts whose requests bypass the read cache
+type noReadCacheKey struct{}
+
+// EnableReadCache caches successful GET responses for ttl. A zero ttl turns
+// the cache off.
+func (c *Client) EnableReadCache(ttl time.Duration) {
+	if ttl <= 0 {
+		c.cache = nil
+		return
+	}
+	c.cache = &readCache{ttl: ttl, entries: map[string]cachedResponse{}}
+}
+
+// WithoutReadCache returns a context whose requests always reach the server,
+// for callers polling for a change: they neither use the cache nor share a
+// GET already in flight. Fresh responses still refresh the cache.
+func WithoutReadCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noReadCacheKey{}, true)
+}
+
+// get returns a copy of the cached response for the path, or nil on a miss
+func (rc *readCache) get(ctx context.Context, path string) *http.Response {
+	if rc == nil || ctx.Value(noReadCacheKey{}) != nil {
+		return nil
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.entries[path]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(entry.expires) {
+		delete(rc.entries, path)
+		return nil
+	}
+
+	return entry.response()
+}
+
+// response returns a copy of the cached response with its own body reader
+func (entry cachedResponse) response() *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(entry.statusCode),
+		StatusCode:    entry.statusCode,
+		Header:        entry.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(entry.body)),
+		ContentLength: int64(len(entry.body)),
+		Request:       entry.request,
+	}
+}
+
+// store caches a successful response for the path. The body is buffered and
+// the response's body replaced so the caller can still read it.
+func (rc *readCache) store(path string, resp *http.Response) error {
+	if rc == nil || resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.entries[path] = cachedResponse{
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+		request:    resp.Request,
+		expires:    time.Now().Add(rc.ttl),
+	}
+	return nil
+}
+
+// clear drops every cached response and stops GETs in flight from being
+// shared with later requests
+func (rc *readCache) clear() {
+	if rc == nil {
+		return
+	}
+	rc.inFlight.forget()
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.entries = map[string]cachedResponse{}
+}
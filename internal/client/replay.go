@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ReplaySinkConsumer re-delivers a sink consumer's messages from the given
+// LSN or timestamp onwards
+func (c *Client) ReplaySinkConsumer(ctx context.Context, sinkIDOrName string, req *ReplayRequest) (*ReplayResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/api/sinks/%s/replay", sinkIDOrName), req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "sink consumer", sinkIDOrName)
+	}
+
+	var result ReplayResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to replay sink consumer: %w", err)
+	}
+
+	tflog.Info(ctx, "Started sink consumer replay", map[string]any{"sink": sinkIDOrName, "id": result.ID, "count": result.MessageCount})
+	return &result, nil
+}
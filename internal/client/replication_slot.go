@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ListReplicationSlots lists the replication slots of every database, or only
+// those of the given database name or ID when it is not empty
+func (c *Client) ListReplicationSlots(ctx context.Context, databaseIDOrName string) ([]ReplicationSlotStatus, error) {
+	path := "/api/replication_slots"
+	if databaseIDOrName != "" {
+		path += "?" + url.Values{"database": {databaseIDOrName}}.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := collectList[ReplicationSlotStatus](ctx, c, resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replication slots: %w", err)
+	}
+
+	return items, nil
+}
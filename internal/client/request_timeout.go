@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// requestTimeoutKey holds the per-request timeout of a context
+type requestTimeoutKey struct{}
+
+// WithRequestTimeout returns a context whose API requests may each take up
+// to d instead of the client's timeout, for operations known to be slower or
+// faster than the rest
+func WithRequestTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, d)
+}
+
+// httpClientFor returns the HTTP client to send a request with, applying the
+// context's request timeout
+func (c *Client) httpClientFor(ctx context.Context) *http.Client {
+	d, ok := ctx.Value(requestTimeoutKey{}).(time.Duration)
+	if !ok || d <= 0 || d == c.HTTPClient.Timeout {
+		return c.HTTPClient
+	}
+	httpClient := *c.HTTPClient
+	httpClient.Timeout = d
+	return &httpClient
+}
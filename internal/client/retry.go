@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Defaults of the retry settings for 5xx responses and transient transport
+// errors
+const (
+	DefaultMaxRetries    = 3
+	DefaultRetryMinDelay = time.Second
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// sendWithRetry sends the request, retrying 5xx responses and transient
+// transport errors up to MaxRetries times with exponential backoff between
+// RetryMinDelay and RetryMaxDelay. The last response or error is returned.
+func (c *Client) sendWithRetry(ctx context.Context, method, path string, data []byte) (*http.Response, error) {
+	delay := c.RetryMinDelay
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.sendWaitingForAvailability(ctx, method, path, data)
+		if attempt > c.MaxRetries || ctx.Err() != nil || !c.retryable(ctx, method, resp, err) {
+			return resp, err
+		}
+
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			resp.Body.Close()
+		}
+		tflog.Warn(ctx, "Retrying failed API request", map[string]any{
+			"method":   method,
+			"path":     path,
+			"attempt":  attempt,
+			"reason":   reason,
+			"interval": delay.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("request failed: %w", ctx.Err())
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > c.RetryMaxDelay {
+			delay = c.RetryMaxDelay
+		}
+	}
+}
+
+// retryable reports whether a failed attempt is worth repeating. Creates
+// without an Idempotency-Key are only retried when the server cannot have
+// acted on them: a 503, a 502 signalling maintenance, or a connection that was
+// never established. With a key the server recognises the repeat, so they are
+// retried like any other request.
+func (c *Client) retryable(ctx context.Context, method string, resp *http.Response, err error) bool {
+	_, idempotent := idempotencyNonce(ctx)
+	unsafe := method == http.MethodPost && !idempotent
+
+	if err != nil {
+		if unsafe {
+			return errors.Is(err, syscall.ECONNREFUSED) || temporaryDNSError(err)
+		}
+		return transientError(err)
+	}
+
+	switch {
+	case unavailable(method, resp):
+		// Already retried for MaxUnavailableWait when that is enabled
+		return c.MaxUnavailableWait <= 0
+	case resp.StatusCode >= 500:
+		return !unsafe
+	}
+	return false
+}
+
+// transientError reports whether a transport error may succeed on retry:
+// timeouts, temporary DNS failures, refused or reset connections and
+// connections closed mid-request or mid-response
+func transientError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return temporaryDNSError(err) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// temporaryDNSError reports whether resolving the API host failed in a way
+// that may pass, such as an unreachable resolver. The request was never sent.
+func temporaryDNSError(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && (dnsErr.IsTemporary || dnsErr.IsTimeout)
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Server features that not every supported Sequin version offers
+const (
+	FeatureEnrichment                = "enrichment"
+	FeatureKinesisDestination        = "kinesis_destination"
+	FeatureMessageShape              = "message_shape"
+	FeatureUnixMicrosecondTimestamps = "unix_microsecond_timestamps"
+)
+
+// featureMinVersions lists the first Sequin version offering each feature,
+// used when the server reports its version but no feature list
+var featureMinVersions = map[string]string{
+	FeatureEnrichment:                "0.10.0",
+	FeatureKinesisDestination:        "0.7.0",
+	FeatureMessageShape:              "0.11.0",
+	FeatureUnixMicrosecondTimestamps: "0.9.0",
+}
+
+// serverProbeTimeout bounds the single attempt DetectServer makes
+const serverProbeTimeout = 10 * time.Second
+
+// singleAttemptKey marks a context whose requests are sent once, without
+// retries, token refreshes or waits for availability and rate limits
+type singleAttemptKey struct{}
+
+// GetServerInfo retrieves the server's version and capabilities. Servers
+// older than the endpoint return a not found error.
+func (c *Client) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/api/version", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "server info", "")
+	}
+
+	var result ServerInfo
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get server info: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DetectServer makes one attempt, bounded by a short timeout, to learn the
+// server's version and capabilities, and stores them in Server. Unlike other
+// requests it neither retries nor waits out maintenance or rate limits, so a
+// struggling server cannot stall provider configuration.
+func (c *Client) DetectServer(ctx context.Context) (*ServerInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, serverProbeTimeout)
+	defer cancel()
+
+	info, err := c.GetServerInfo(context.WithValue(WithoutReadCache(ctx), singleAttemptKey{}, true))
+	if err != nil {
+		return nil, err
+	}
+	c.Server = info
+	return info, nil
+}
+
+// SupportsFeature reports whether the server offers a feature, and the first
+// Sequin version that does. Features are assumed supported when the server
+// did not report its capabilities or runs an unreleased build.
+func (c *Client) SupportsFeature(feature string) (bool, string) {
+	minVersion := featureMinVersions[feature]
+	if c.Server == nil {
+		return true, minVersion
+	}
+
+	if len(c.Server.Features) > 0 {
+		for _, f := range c.Server.Features {
+			if f == feature {
+				return true, minVersion
+			}
+		}
+		return false, minVersion
+	}
+
+	current, ok := parseVersion(c.Server.Version)
+	required, known := parseVersion(minVersion)
+	if !ok || !known {
+		return true, minVersion
+	}
+	return compareVersions(current, required) >= 0, minVersion
+}
+
+// parseVersion parses a "v1.2.3" style version, ignoring pre-release and
+// build suffixes. Missing minor and patch numbers count as zero.
+func parseVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+	parts := strings.Split(version, ".")
+	if version == "" || len(parts) > 3 {
+		return parsed, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return parsed, false
+		}
+		parsed[i] = n
+	}
+	return parsed, true
+}
+
+// compareVersions returns -1, 0 or 1 as a is older than, equal to or newer
+// than b
+func compareVersions(a, b [3]int) int {
+	for i := range a {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// sharedReads lets identical GETs issued while one is in flight wait for it
+// and share its response, so resources refreshed in parallel that read the
+// same object make one API call. It is part of the read cache and only used
+// when that is enabled; a response is only shared with requests made before
+// it arrived.
+type sharedReads struct {
+	mu    sync.Mutex
+	calls map[string]*sharedRead
+}
+
+// sharedRead is a GET in flight, the number of requests waiting for it and,
+// once done, its response if it can be shared
+type sharedRead struct {
+	done     chan struct{}
+	waiters  int
+	response *cachedResponse
+}
+
+// do performs fetch for the path, or waits for the identical GET already in
+// flight and returns a copy of its response. Only responses other requests
+// are waiting for are buffered, so a list read by one caller still streams.
+// Requests whose in-flight GET failed or got a non-200 response fetch on
+// their own, so each caller keeps its own retries and error.
+func (s *sharedReads) do(ctx context.Context, path string, fetch func() (*http.Response, error)) (*http.Response, error) {
+	s.mu.Lock()
+	if call, ok := s.calls[path]; ok {
+		call.waiters++
+		s.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("request failed: %w", ctx.Err())
+		}
+		if call.response != nil {
+			return call.response.response(), nil
+		}
+		return fetch()
+	}
+	call := &sharedRead{done: make(chan struct{})}
+	if s.calls == nil {
+		s.calls = map[string]*sharedRead{}
+	}
+	s.calls[path] = call
+	s.mu.Unlock()
+	defer close(call.done)
+
+	resp, err := fetch()
+
+	// Later requests start their own GET
+	s.mu.Lock()
+	if s.calls[path] == call {
+		delete(s.calls, path)
+	}
+	waiters := call.waiters
+	s.mu.Unlock()
+
+	if err != nil || resp.StatusCode != http.StatusOK || waiters == 0 {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	call.response = &cachedResponse{
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+		request:    resp.Request,
+	}
+	return resp, nil
+}
+
+// forget stops GETs in flight from being shared with later requests, after a
+// write that may change what they return
+func (s *sharedReads) forget() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = nil
+}
@@ -2,102 +2,16 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// SinkConsumerTable represents a table configuration in a sink consumer
-type SinkConsumerTable struct {
-	Name             string   `json:"name"`
-	GroupColumnNames []string `json:"group_column_names,omitempty"`
-}
-
-// SinkConsumerSource represents the source configuration
-type SinkConsumerSource struct {
-	IncludeSchemas []string `json:"include_schemas,omitempty"`
-	ExcludeSchemas []string `json:"exclude_schemas,omitempty"`
-	IncludeTables  []string `json:"include_tables,omitempty"`
-	ExcludeTables  []string `json:"exclude_tables,omitempty"`
-}
-
-// SinkConsumerDestination represents the destination configuration
-type SinkConsumerDestination struct {
-	Type string `json:"type"` // kafka, sqs, kinesis, webhook
-
-	// Kafka fields
-	Hosts              string `json:"hosts,omitempty"`
-	Topic              string `json:"topic,omitempty"`
-	TLS                *bool  `json:"tls,omitempty"`
-	Username           string `json:"username,omitempty"`
-	Password           string `json:"password,omitempty"`
-	SASLMechanism      string `json:"sasl_mechanism,omitempty"`
-	AWSRegion          string `json:"aws_region,omitempty"`
-	AWSAccessKeyID     string `json:"aws_access_key_id,omitempty"`
-	AWSSecretAccessKey string `json:"aws_secret_access_key,omitempty"`
-
-	// SQS fields
-	QueueURL       string `json:"queue_url,omitempty"`
-	Region         string `json:"region,omitempty"`
-	AccessKeyID    string `json:"access_key_id,omitempty"`
-	SecretAccessKey string `json:"secret_access_key,omitempty"`
-	IsFIFO         *bool  `json:"is_fifo,omitempty"`
-
-	// Kinesis fields
-	StreamARN string `json:"stream_arn,omitempty"`
-
-	// Webhook fields
-	HTTPEndpoint     string `json:"http_endpoint,omitempty"`
-	HTTPEndpointPath string `json:"http_endpoint_path,omitempty"`
-	Batch            *bool  `json:"batch,omitempty"`
-}
-
-// SinkConsumerRequest represents the request body for creating or updating a sink consumer
-type SinkConsumerRequest struct {
-	Name               string                  `json:"name"`
-	Status             string                  `json:"status,omitempty"`                // active, disabled, paused
-	Database           string                  `json:"database"`
-	Source             *SinkConsumerSource     `json:"source,omitempty"`
-	Tables             []SinkConsumerTable     `json:"tables"`
-	Actions            []string                `json:"actions,omitempty"`                // insert, update, delete
-	Destination        SinkConsumerDestination `json:"destination"`
-	Filter             string                  `json:"filter,omitempty"`
-	Transform          string                  `json:"transform,omitempty"`
-	Enrichment         string                  `json:"enrichment,omitempty"`
-	Routing            string                  `json:"routing,omitempty"`
-	MessageGrouping    *bool                   `json:"message_grouping,omitempty"`
-	BatchSize          *int                    `json:"batch_size,omitempty"`
-	MaxRetryCount      *int                    `json:"max_retry_count,omitempty"`
-	LoadSheddingPolicy string                  `json:"load_shedding_policy,omitempty"` // pause_on_full, discard_on_full
-	TimestampFormat    string                  `json:"timestamp_format,omitempty"`     // iso8601, unix_microsecond
-}
-
-// SinkConsumerResponse represents a sink consumer resource from the API
-type SinkConsumerResponse struct {
-	ID                 string                  `json:"id"`
-	Name               string                  `json:"name"`
-	Status             string                  `json:"status"`
-	Database           string                  `json:"database"`
-	Source             *SinkConsumerSource     `json:"source,omitempty"`
-	Tables             []SinkConsumerTable     `json:"tables"`
-	Actions            []string                `json:"actions"`
-	Destination        SinkConsumerDestination `json:"destination"`
-	Filter             string                  `json:"filter,omitempty"`
-	Transform          string                  `json:"transform,omitempty"`
-	Enrichment         string                  `json:"enrichment,omitempty"`
-	Routing            string                  `json:"routing,omitempty"`
-	MessageGrouping    bool                    `json:"message_grouping"`
-	BatchSize          int                     `json:"batch_size"`
-	MaxRetryCount      *int                    `json:"max_retry_count,omitempty"`
-	LoadSheddingPolicy string                  `json:"load_shedding_policy"`
-	TimestampFormat    string                  `json:"timestamp_format"`
-	StatusInfo         StatusResponse          `json:"status_info"`
-}
-
 // CreateSinkConsumer creates a new sink consumer
 func (c *Client) CreateSinkConsumer(ctx context.Context, req *SinkConsumerRequest) (*SinkConsumerResponse, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/api/sinks", req)
+	resp, err := c.doRequest(withIdempotencyKey(ctx), http.MethodPost, "/api/sinks", req)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +20,7 @@ func (c *Client) CreateSinkConsumer(ctx context.Context, req *SinkConsumerReques
 	if err := c.handleResponse(ctx, resp, &result); err != nil {
 		return nil, fmt.Errorf("failed to create sink consumer: %w", err)
 	}
+	result.ETag = resp.Header.Get("ETag")
 
 	tflog.Info(ctx, "Created sink consumer", map[string]any{"id": result.ID, "name": result.Name})
 	return &result, nil
@@ -120,17 +35,39 @@ func (c *Client) GetSinkConsumer(ctx context.Context, id string) (*SinkConsumerR
 
 	if resp.StatusCode == http.StatusNotFound {
 		resp.Body.Close()
-		return nil, fmt.Errorf("sink consumer not found: %s", id)
+		return nil, notFoundError(resp, "sink consumer", id)
 	}
 
 	var result SinkConsumerResponse
 	if err := c.handleResponse(ctx, resp, &result); err != nil {
 		return nil, fmt.Errorf("failed to get sink consumer: %w", err)
 	}
+	result.ETag = resp.Header.Get("ETag")
 
 	return &result, nil
 }
 
+// GetSinkConsumerConfig retrieves a sink consumer as the raw JSON the API
+// returns, including fields this provider does not model
+func (c *Client) GetSinkConsumerConfig(ctx context.Context, id string) (json.RawMessage, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/sinks/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "sink consumer", id)
+	}
+
+	var result json.RawMessage
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get sink consumer: %w", err)
+	}
+
+	return result, nil
+}
+
 // UpdateSinkConsumer updates an existing sink consumer
 func (c *Client) UpdateSinkConsumer(ctx context.Context, id string, req *SinkConsumerRequest) (*SinkConsumerResponse, error) {
 	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/sinks/%s", id), req)
@@ -142,11 +79,45 @@ func (c *Client) UpdateSinkConsumer(ctx context.Context, id string, req *SinkCon
 	if err := c.handleResponse(ctx, resp, &result); err != nil {
 		return nil, fmt.Errorf("failed to update sink consumer: %w", err)
 	}
+	result.ETag = resp.Header.Get("ETag")
 
 	tflog.Info(ctx, "Updated sink consumer", map[string]any{"id": result.ID})
 	return &result, nil
 }
 
+// SinkConsumerListOptions configures listing sink consumers
+type SinkConsumerListOptions struct {
+	PerPage int // Sink consumers requested per page; zero uses DefaultPageSize
+}
+
+// ListSinkConsumers lists all sink consumers in the account, walking every
+// page of the list
+func (c *Client) ListSinkConsumers(ctx context.Context, opts SinkConsumerListOptions) ([]SinkConsumerResponse, error) {
+	sinks := make([]SinkConsumerResponse, 0)
+	err := c.EachSinkConsumer(ctx, opts, func(sink SinkConsumerResponse) error {
+		sinks = append(sinks, sink)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sinks, nil
+}
+
+// EachSinkConsumer calls fn for every sink consumer in the account as the
+// list is decoded, page by page, so callers keeping only some sinks never hold
+// the whole list. An error from fn stops the listing and is returned.
+func (c *Client) EachSinkConsumer(ctx context.Context, opts SinkConsumerListOptions, fn func(SinkConsumerResponse) error) error {
+	if err := paginate(ctx, c, "/api/sinks", opts.PerPage, fn); err != nil {
+		return fmt.Errorf("failed to list sink consumers: %w", err)
+	}
+
+	return nil
+}
+
+func (s SinkConsumerResponse) listID() string { return s.ID }
+
 // DeleteSinkConsumer deletes a sink consumer by ID
 func (c *Client) DeleteSinkConsumer(ctx context.Context, id string) error {
 	resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/sinks/%s", id), nil)
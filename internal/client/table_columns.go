@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ListTableColumns lists the columns of a table, given as schema.table, in a
+// database
+func (c *Client) ListTableColumns(ctx context.Context, databaseIDOrName, table string) ([]TableColumn, error) {
+	path := fmt.Sprintf("/api/databases/%s/tables/%s/columns", databaseIDOrName, url.PathEscape(table))
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "table", table)
+	}
+
+	columns, err := collectList[TableColumn](ctx, c, resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list table columns: %w", err)
+	}
+
+	return columns, nil
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CreateTableContract creates a new table contract
+func (c *Client) CreateTableContract(ctx context.Context, req *TableContractRequest) (*TableContractResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/api/table_contracts", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TableContractResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to create table contract: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Created table contract", map[string]any{"id": result.ID, "table": result.Table})
+	return &result, nil
+}
+
+// GetTableContract retrieves a table contract by ID
+func (c *Client) GetTableContract(ctx context.Context, id string) (*TableContractResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/table_contracts/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, notFoundError(resp, "table contract", id)
+	}
+
+	var result TableContractResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to get table contract: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	return &result, nil
+}
+
+// UpdateTableContract updates an existing table contract
+func (c *Client) UpdateTableContract(ctx context.Context, id string, req *TableContractRequest) (*TableContractResponse, error) {
+	resp, err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/table_contracts/%s", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TableContractResponse
+	if err := c.handleResponse(ctx, resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to update table contract: %w", err)
+	}
+	result.ETag = resp.Header.Get("ETag")
+
+	tflog.Info(ctx, "Updated table contract", map[string]any{"id": result.ID})
+	return &result, nil
+}
+
+// DeleteTableContract deletes a table contract by ID
+func (c *Client) DeleteTableContract(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/table_contracts/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		tflog.Warn(ctx, "Table contract already deleted", map[string]any{"id": id})
+		return nil
+	}
+
+	if err := c.handleResponse(ctx, resp, nil); err != nil {
+		return fmt.Errorf("failed to delete table contract: %w", err)
+	}
+
+	tflog.Info(ctx, "Deleted table contract", map[string]any{"id": id})
+	return nil
+}
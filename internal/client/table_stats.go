@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// EachTableStats calls fn with the stats of every table in a database as the
+// list is decoded. An error from fn stops the listing and is returned.
+func (c *Client) EachTableStats(ctx context.Context, databaseIDOrName string, fn func(TableStats) error) error {
+	resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/databases/%s/tables", databaseIDOrName), nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return notFoundError(resp, "database", databaseIDOrName)
+	}
+
+	if err := decodeList(ctx, c, resp, fn); err != nil {
+		return fmt.Errorf("failed to list table stats: %w", err)
+	}
+
+	return nil
+}
+
+// ListTableStats lists the estimated row count and size of every table in a
+// database
+func (c *Client) ListTableStats(ctx context.Context, databaseIDOrName string) ([]TableStats, error) {
+	stats := make([]TableStats, 0)
+	err := c.EachTableStats(ctx, databaseIDOrName, func(table TableStats) error {
+		stats = append(stats, table)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// commandTokenSource obtains bearer tokens by running a command that prints
+// one, such as a secrets manager CLI issuing short-lived tokens
+type commandTokenSource struct {
+	argv []string
+
+	mu    sync.Mutex
+	token string
+}
+
+// ConfigureTokenCommand makes the client replace its bearer token with the
+// output of a command whenever the API answers 401 Unauthorized, and retry
+// the request. The API key, if any, is used until then; without one the
+// command runs right away so misconfiguration surfaces when the provider is
+// configured.
+func (c *Client) ConfigureTokenCommand(ctx context.Context, argv []string) error {
+	if len(argv) == 0 {
+		return fmt.Errorf("token command is empty")
+	}
+	source := &commandTokenSource{argv: argv, token: c.APIKey}
+	if _, err := source.bearerToken(ctx); err != nil {
+		return err
+	}
+	c.tokens = source
+	return nil
+}
+
+// bearerToken returns the current token, running the command when there is
+// none
+func (s *commandTokenSource) bearerToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" {
+		return s.token, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.argv[0], s.argv[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("token command %s failed: %w: %s", s.argv[0], err, msg)
+		}
+		return "", fmt.Errorf("token command %s failed: %w", s.argv[0], err)
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("token command %s printed no token", s.argv[0])
+	}
+	s.token = token
+	tflog.Debug(ctx, "Obtained Sequin API token from token command", map[string]any{"command": s.argv[0]})
+	return s.token, nil
+}
+
+// invalidate drops the token if it is the rejected one
+func (s *commandTokenSource) invalidate(rejected string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == rejected {
+		s.token = ""
+	}
+}
+
+// sendRefreshingToken sends a request, and when the API rejects a token that
+// can be replaced, obtains a new one and sends the request once more
+func (c *Client) sendRefreshingToken(ctx context.Context, method, path string, data []byte) (*http.Response, error) {
+	resp, err := c.sendWithRetry(ctx, method, path, data)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || c.tokens == nil || c.Username != "" {
+		return resp, err
+	}
+
+	rejected := ""
+	if resp.Request != nil {
+		rejected = strings.TrimPrefix(resp.Request.Header.Get("Authorization"), "Bearer ")
+	}
+	resp.Body.Close()
+
+	tflog.Info(ctx, "API rejected the bearer token, obtaining a new one", map[string]any{
+		"method": method,
+		"path":   path,
+	})
+	c.tokens.invalidate(rejected)
+	return c.sendWithRetry(ctx, method, path, data)
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Backoff between retries of requests answered with 503 Service Unavailable or
+// 502 Bad Gateway
+var (
+	unavailableRetryDelay    = time.Second
+	unavailableRetryMaxDelay = 30 * time.Second
+)
+
+// sendWaitingForAvailability sends the request, retrying with exponential
+// backoff while the API is unavailable, as during maintenance, until
+// MaxUnavailableWait elapses. The last response is returned once the wait is
+// exhausted so it is reported like any other API error.
+func (c *Client) sendWaitingForAvailability(ctx context.Context, method, path string, data []byte) (*http.Response, error) {
+	deadline := time.Now().Add(c.MaxUnavailableWait)
+	delay := unavailableRetryDelay
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.sendWaitingForRateLimit(ctx, method, path, data)
+		if err != nil || !unavailable(method, resp) || c.MaxUnavailableWait <= 0 {
+			return resp, err
+		}
+
+		wait := retryAfter(resp, delay)
+		if time.Now().Add(wait).After(deadline) {
+			tflog.Warn(ctx, "Sequin API still unavailable, giving up", map[string]any{
+				"method":   method,
+				"path":     path,
+				"attempts": attempt,
+				"max_wait": c.MaxUnavailableWait.String(),
+			})
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		tflog.Warn(ctx, "Sequin API unavailable, possibly for maintenance, retrying", map[string]any{
+			"method":      method,
+			"path":        path,
+			"status_code": resp.StatusCode,
+			"attempt":     attempt,
+			"interval":    wait.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("request failed: %w", ctx.Err())
+		case <-time.After(wait):
+		}
+
+		delay *= 2
+		if delay > unavailableRetryMaxDelay {
+			delay = unavailableRetryMaxDelay
+		}
+	}
+}
+
+// unavailable reports whether a response means the API is temporarily down.
+// A 503 is never processed by the API. A 502 from a gateway in front of it may
+// follow a request the API did act on, so creates only wait it out when the
+// gateway signals maintenance with Retry-After.
+func unavailable(method string, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway:
+		return method != http.MethodPost || resp.Header.Get("Retry-After") != ""
+	}
+	return false
+}
+
+// retryAfter returns the delay the server asked for in its Retry-After header,
+// capped at the maximum backoff, or fallback when it did not set one
+func retryAfter(resp *http.Response, fallback time.Duration) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return fallback
+	}
+	if wait := time.Duration(seconds) * time.Second; wait < unavailableRetryMaxDelay {
+		return wait
+	}
+	return unavailableRetryMaxDelay
+}
@@ -0,0 +1,42 @@
+// Command clientgen writes the API client's models generated from Sequin's
+// OpenAPI document, merged with an optional overlay of x-go extensions. It is
+// run by go generate in internal/client.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/codegen"
+)
+
+func main() {
+	spec := flag.String("spec", "", "path of the OpenAPI document")
+	overlay := flag.String("overlay", "", "path of a JSON merge patch applied to the document")
+	out := flag.String("out", "", "path of the Go file to write")
+	pkg := flag.String("package", "client", "package of the generated file")
+	flag.Parse()
+
+	data, err := os.ReadFile(*spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *overlay != "" {
+		patch, err := os.ReadFile(*overlay)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if data, err = codegen.Merge(data, patch); err != nil {
+			log.Fatal(err)
+		}
+	}
+	code, err := codegen.Generate(data, *pkg, filepath.ToSlash(filepath.Join("api", filepath.Base(*spec))))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
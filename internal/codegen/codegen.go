@@ -0,0 +1,306 @@
+// Package codegen generates the API client's request and response structs
+// from the component schemas of Sequin's OpenAPI document, so new destination
+// types and fields are picked up by refreshing api/openapi.json and running
+// go generate instead of hand-writing structs.
+//
+// Only the subset of OpenAPI the document uses is supported: object schemas
+// with string, boolean, integer, number, array, string map and $ref
+// properties, untyped properties holding arbitrary JSON, and allOf for
+// embedding. Extensions, usually added through an overlay (see Merge),
+// control the Go output:
+//
+//   - x-go-name overrides the Go name derived from a property name
+//   - x-go-group starts a new block of fields with the given comment
+//   - x-go-header-fields adds fields filled from response headers
+//   - x-go-type-skip-optional-pointer keeps an optional boolean, integer or
+//     number a plain value whose zero value is omitted
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"strings"
+	"unicode"
+)
+
+// commentWidth is the column comments are wrapped at, excluding indentation
+const commentWidth = 76
+
+// initialisms are the name parts written in upper case in Go names
+var initialisms = map[string]bool{
+	"api": true, "arn": true, "aws": true, "fifo": true, "gcp": true, "http": true, "id": true,
+	"jwt": true, "lsn": true, "sasl": true, "ssl": true, "tls": true, "ttl": true, "url": true,
+}
+
+// schema is the part of an OpenAPI schema object the generator reads
+type schema struct {
+	Ref                  string          `json:"$ref"`
+	Type                 string          `json:"type"`
+	Format               string          `json:"format"`
+	Description          string          `json:"description"`
+	Properties           json.RawMessage `json:"properties"`
+	Required             []string        `json:"required"`
+	Nullable             bool            `json:"nullable"`
+	Items                *schema         `json:"items"`
+	AdditionalProperties *schema         `json:"additionalProperties"`
+	AllOf                []schema        `json:"allOf"`
+
+	GoName              string        `json:"x-go-name"`
+	GoGroup             string        `json:"x-go-group"`
+	GoHeaderFields      []headerField `json:"x-go-header-fields"`
+	SkipOptionalPointer bool          `json:"x-go-type-skip-optional-pointer"`
+}
+
+// headerField is a struct field filled from a response header rather than
+// the JSON body
+type headerField struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// document is the part of an OpenAPI document the generator reads
+type document struct {
+	Components struct {
+		Schemas json.RawMessage `json:"schemas"`
+	} `json:"components"`
+}
+
+// Generate returns the gofmt-formatted Go source of package pkg declaring a
+// struct for every component schema of spec, in the order of the spec
+func Generate(spec []byte, pkg, source string) ([]byte, error) {
+	var doc document
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: %w", err)
+	}
+	names, schemas, err := orderedSchemas(doc.Components.Schemas)
+	if err != nil {
+		return nil, fmt.Errorf("parsing component schemas: %w", err)
+	}
+
+	var body bytes.Buffer
+	for _, name := range names {
+		if err := writeStruct(&body, name, schemas[name]); err != nil {
+			return nil, fmt.Errorf("schema %s: %w", name, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by clientgen from %s. DO NOT EDIT.\n\npackage %s\n", source, pkg)
+	if bytes.Contains(body.Bytes(), []byte("json.RawMessage")) {
+		buf.WriteString("\nimport \"encoding/json\"\n")
+	}
+	buf.Write(body.Bytes())
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return out, nil
+}
+
+// writeStruct writes the declaration of one struct
+func writeStruct(buf *bytes.Buffer, name string, s schema) error {
+	buf.WriteString("\n")
+	if s.Description != "" {
+		writeComment(buf, "", name+" is "+lowerFirst(strings.TrimSuffix(s.Description, ".")))
+	}
+	fmt.Fprintf(buf, "type %s struct {\n", name)
+
+	parts := s.AllOf
+	if len(parts) == 0 {
+		parts = []schema{s}
+	}
+	first := true
+	for _, part := range parts {
+		if part.Ref != "" {
+			fmt.Fprintf(buf, "\t%s\n", refName(part.Ref))
+			first = false
+			continue
+		}
+		if part.Type != "object" {
+			return fmt.Errorf("expected an object schema, got %q", part.Type)
+		}
+		if !first {
+			// Separate the fields from embedded structs
+			buf.WriteString("\n")
+			first = true
+		}
+		if err := writeFields(buf, part, &first); err != nil {
+			return err
+		}
+	}
+	for _, field := range s.GoHeaderFields {
+		fmt.Fprintf(buf, "\t%s string `json:\"-\"`", field.Name)
+		if field.Description != "" {
+			fmt.Fprintf(buf, " // %s", field.Description)
+		}
+		buf.WriteString("\n")
+	}
+
+	buf.WriteString("}\n")
+	return nil
+}
+
+// writeFields writes a field for every property of an object schema
+func writeFields(buf *bytes.Buffer, s schema, first *bool) error {
+	keys, properties, err := orderedSchemas(s.Properties)
+	if err != nil {
+		return err
+	}
+	required := map[string]bool{}
+	for _, key := range s.Required {
+		required[key] = true
+	}
+
+	for _, key := range keys {
+		property := properties[key]
+		if property.GoGroup != "" {
+			if !*first {
+				buf.WriteString("\n")
+			}
+			writeComment(buf, "\t", property.GoGroup)
+		}
+		*first = false
+
+		goType, err := fieldType(property, required[key])
+		if err != nil {
+			return fmt.Errorf("property %s: %w", key, err)
+		}
+		tag := key
+		if !required[key] {
+			tag += ",omitempty"
+		}
+		fieldName := property.GoName
+		if fieldName == "" {
+			fieldName = GoName(key)
+		}
+		fmt.Fprintf(buf, "\t%s %s `json:\"%s\"`", fieldName, goType, tag)
+		if property.Description != "" {
+			fmt.Fprintf(buf, " // %s", property.Description)
+		}
+		buf.WriteString("\n")
+	}
+	return nil
+}
+
+// fieldType returns the Go type of a property. Optional and nullable
+// booleans, integers, numbers and objects are pointers so unset values are
+// omitted or sent as null rather than as zero values. Strings are pointers
+// only when nullable, to tell null from empty.
+func fieldType(s schema, required bool) (string, error) {
+	pointer := ""
+	if (!required && !s.SkipOptionalPointer) || s.Nullable {
+		pointer = "*"
+	}
+
+	switch {
+	case s.Ref != "":
+		return pointer + refName(s.Ref), nil
+	case s.Type == "" && s.Items == nil && s.AdditionalProperties == nil && len(s.AllOf) == 0:
+		return "json.RawMessage", nil
+	case s.Type == "string" && s.Nullable:
+		return "*string", nil
+	case s.Type == "string":
+		return "string", nil
+	case s.Type == "boolean":
+		return pointer + "bool", nil
+	case s.Type == "integer" && s.Format == "int64":
+		return pointer + "int64", nil
+	case s.Type == "integer":
+		return pointer + "int", nil
+	case s.Type == "number":
+		return pointer + "float64", nil
+	case s.Type == "array" && s.Items != nil:
+		item, err := fieldType(*s.Items, true)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + item, nil
+	case s.Type == "object" && s.AdditionalProperties != nil:
+		value, err := fieldType(*s.AdditionalProperties, true)
+		if err != nil {
+			return "", err
+		}
+		return "map[string]" + value, nil
+	}
+	return "", fmt.Errorf("unsupported schema type %q", s.Type)
+}
+
+// GoName converts a snake_case property name into an exported Go name,
+// writing initialisms such as id and url in upper case
+func GoName(property string) string {
+	var name strings.Builder
+	for _, part := range strings.Split(property, "_") {
+		if part == "" {
+			continue
+		}
+		if initialisms[part] {
+			name.WriteString(strings.ToUpper(part))
+			continue
+		}
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		name.WriteString(string(runes))
+	}
+	return name.String()
+}
+
+// refName returns the schema name a local $ref points to
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// lowerFirst lowercases the first letter of a sentence unless it starts an
+// initialism such as API
+func lowerFirst(s string) string {
+	runes := []rune(s)
+	if len(runes) > 1 && unicode.IsUpper(runes[1]) {
+		return s
+	}
+	if len(runes) > 0 {
+		runes[0] = unicode.ToLower(runes[0])
+	}
+	return string(runes)
+}
+
+// writeComment writes text as line comments wrapped at commentWidth
+func writeComment(buf *bytes.Buffer, indent, text string) {
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > commentWidth {
+			fmt.Fprintf(buf, "%s// %s\n", indent, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		fmt.Fprintf(buf, "%s// %s\n", indent, line)
+	}
+}
+
+// orderedSchemas decodes a JSON object of schemas, returning its keys in
+// document order since Go maps do not keep it
+func orderedSchemas(raw json.RawMessage) ([]string, map[string]schema, error) {
+	if len(raw) == 0 {
+		return nil, nil, nil
+	}
+	keys, values, err := orderedObject(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemas := make(map[string]schema, len(values))
+	for key, value := range values {
+		var s schema
+		if err := json.Unmarshal(value, &s); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", key, err)
+		}
+		schemas[key] = s
+	}
+	return keys, schemas, nil
+}
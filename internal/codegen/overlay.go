@@ -0,0 +1,99 @@
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// Merge applies overlay to the OpenAPI document spec as a JSON merge patch
+// (RFC 7386): objects are merged recursively, null removes a member and any
+// other value replaces it. Keys keep their order in spec, and keys only in
+// overlay are appended, so the generated fields follow the document.
+//
+// The overlay carries the x-go extensions and any schemas Sequin's published
+// document lacks, so the vendored document can be refreshed as is.
+func Merge(spec, overlay []byte) ([]byte, error) {
+	merged, err := mergePatch(spec, overlay)
+	if err != nil {
+		return nil, fmt.Errorf("merging overlay: %w", err)
+	}
+	return merged, nil
+}
+
+// mergePatch returns target with patch applied
+func mergePatch(target, patch json.RawMessage) (json.RawMessage, error) {
+	patchKeys, patchValues, err := orderedObject(patch)
+	if err != nil {
+		// A patch that is not an object replaces the target
+		return patch, nil
+	}
+	keys, values, err := orderedObject(target)
+	if err != nil {
+		keys, values = nil, map[string]json.RawMessage{}
+	}
+
+	for _, key := range patchKeys {
+		value := patchValues[key]
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			if _, ok := values[key]; ok {
+				delete(values, key)
+				keys = slices.DeleteFunc(keys, func(k string) bool { return k == key })
+			}
+			continue
+		}
+		existing, ok := values[key]
+		if !ok {
+			keys = append(keys, key)
+		}
+		if values[key], err = mergePatch(existing, value); err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteString(":")
+		buf.Write(values[key])
+	}
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
+
+// orderedObject decodes a JSON object into its members, returning its keys
+// in document order
+func orderedObject(raw json.RawMessage) ([]string, map[string]json.RawMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if token, err := dec.Token(); err != nil || token != json.Delim('{') {
+		return nil, nil, fmt.Errorf("expected a JSON object")
+	}
+
+	var keys []string
+	values := map[string]json.RawMessage{}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key := token.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", key, err)
+		}
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+		values[key] = value
+	}
+	return keys, values, nil
+}
@@ -0,0 +1,229 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ datasource.DataSource              = &FilterEvaluationDataSource{}
+	_ datasource.DataSourceWithConfigure = &FilterEvaluationDataSource{}
+)
+
+// FilterEvaluationDataSource defines the data source implementation
+type FilterEvaluationDataSource struct {
+	client client.SequinAPI
+}
+
+// FilterEvaluationDataSourceModel describes the data source data model
+type FilterEvaluationDataSourceModel struct {
+	ID           types.String            `tfsdk:"id"`
+	Filter       types.String            `tfsdk:"filter"`
+	Records      types.List              `tfsdk:"records"`
+	Database     types.String            `tfsdk:"database"`
+	Table        types.String            `tfsdk:"table"`
+	SampleSize   types.Int64             `tfsdk:"sample_size"`
+	Results      []FilterEvaluationModel `tfsdk:"results"`
+	MatchedCount types.Int64             `tfsdk:"matched_count"`
+	TotalCount   types.Int64             `tfsdk:"total_count"`
+}
+
+// FilterEvaluationModel describes the filter outcome for a single record
+type FilterEvaluationModel struct {
+	Record  string `tfsdk:"record"`
+	Matched bool   `tfsdk:"matched"`
+	Error   string `tfsdk:"error"`
+}
+
+// NewFilterEvaluationDataSource creates a new data source
+func NewFilterEvaluationDataSource() datasource.DataSource {
+	return &FilterEvaluationDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *FilterEvaluationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_filter_evaluation"
+}
+
+// Schema defines the data source schema
+func (d *FilterEvaluationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Dry-runs a filter function against sample records, provided inline or sampled from a table, and reports which records match.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this evaluation (the filter reference).",
+				Computed:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "Name or ID of the filter function to evaluate.",
+				Required:    true,
+			},
+			"records": schema.ListAttribute{
+				Description: "Records to evaluate, each a JSON string. Conflicts with table.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ExactlyOneOf(path.MatchRoot("table")),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Name or ID of the database to sample from. Required with table.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("table")),
+				},
+			},
+			"table": schema.StringAttribute{
+				Description: "Table to sample records from, in schema.table format. Conflicts with records.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("database")),
+				},
+			},
+			"sample_size": schema.Int64Attribute{
+				Description: "Number of rows to sample from table (1-1000). Server default when omitted.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+					int64validator.AlsoRequires(path.MatchRoot("table")),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "Filter outcome for each evaluated record.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"record": schema.StringAttribute{
+							Description: "Evaluated record as a JSON string.",
+							Computed:    true,
+						},
+						"matched": schema.BoolAttribute{
+							Description: "Whether the filter let the record through.",
+							Computed:    true,
+						},
+						"error": schema.StringAttribute{
+							Description: "Error raised while evaluating this record, if any.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"matched_count": schema.Int64Attribute{
+				Description: "Number of records the filter matched.",
+				Computed:    true,
+			},
+			"total_count": schema.Int64Attribute{
+				Description: "Number of records evaluated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source
+func (d *FilterEvaluationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read evaluates the filter against the sample records
+func (d *FilterEvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = client.WithOperation(ctx, "sequin_filter_evaluation.read")
+
+	var data FilterEvaluationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	evalReq := &client.FilterEvaluationRequest{
+		Database: data.Database.ValueString(),
+		Table:    data.Table.ValueString(),
+	}
+	if !data.SampleSize.IsNull() {
+		evalReq.SampleSize = int(data.SampleSize.ValueInt64())
+	}
+	if !data.Records.IsNull() {
+		var records []string
+		resp.Diagnostics.Append(data.Records.ElementsAs(ctx, &records, false)...)
+		for i, record := range records {
+			if !json.Valid([]byte(record)) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("records").AtListIndex(i),
+					"Invalid Sample Record",
+					"Each record must be a JSON string; use jsonencode() to build it.",
+				)
+				continue
+			}
+			evalReq.Records = append(evalReq.Records, json.RawMessage(record))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := data.Filter.ValueString()
+	results, err := d.client.EvaluateFilter(ctx, filter, evalReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Evaluating Filter",
+			"Could not evaluate filter "+filter+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(filter)
+	mapFilterEvaluation(results, &data)
+
+	tflog.Debug(ctx, "Evaluated filter", map[string]any{
+		"filter":  filter,
+		"matched": data.MatchedCount.ValueInt64(),
+		"total":   data.TotalCount.ValueInt64(),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapFilterEvaluation converts API results to the data source model
+func mapFilterEvaluation(results []client.FilterEvaluationResult, data *FilterEvaluationDataSourceModel) {
+	data.Results = make([]FilterEvaluationModel, 0, len(results))
+	matched := 0
+	for _, result := range results {
+		if result.Matched {
+			matched++
+		}
+		data.Results = append(data.Results, FilterEvaluationModel{
+			Record:  string(result.Record),
+			Matched: result.Matched,
+			Error:   result.Error,
+		})
+	}
+	data.MatchedCount = types.Int64Value(int64(matched))
+	data.TotalCount = types.Int64Value(int64(len(results)))
+}
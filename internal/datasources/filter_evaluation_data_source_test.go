@@ -0,0 +1,65 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestFilterEvaluationDataSource_Metadata(t *testing.T) {
+	ds := NewFilterEvaluationDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_filter_evaluation" {
+		t.Errorf("TypeName = %q, want sequin_filter_evaluation", resp.TypeName)
+	}
+}
+
+func TestFilterEvaluationDataSource_Schema(t *testing.T) {
+	ds := NewFilterEvaluationDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "filter", "records", "database", "table", "sample_size", "results", "matched_count", "total_count"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestMapFilterEvaluation(t *testing.T) {
+	var data FilterEvaluationDataSourceModel
+
+	mapFilterEvaluation([]client.FilterEvaluationResult{
+		{Record: json.RawMessage(`{"paid":true}`), Matched: true},
+		{Record: json.RawMessage(`{"paid":false}`), Matched: false},
+		{Record: json.RawMessage(`{}`), Error: "key not found: paid"},
+	}, &data)
+
+	if data.TotalCount.ValueInt64() != 3 {
+		t.Errorf("TotalCount = %d, want 3", data.TotalCount.ValueInt64())
+	}
+	if data.MatchedCount.ValueInt64() != 1 {
+		t.Errorf("MatchedCount = %d, want 1", data.MatchedCount.ValueInt64())
+	}
+	if data.Results[0].Record != `{"paid":true}` {
+		t.Errorf("Record = %q, want raw JSON", data.Results[0].Record)
+	}
+	if data.Results[2].Error != "key not found: paid" {
+		t.Errorf("Error = %q, want evaluation error", data.Results[2].Error)
+	}
+
+	mapFilterEvaluation(nil, &data)
+	if data.Results == nil {
+		t.Error("empty result should be an empty list, not null")
+	}
+}
@@ -0,0 +1,151 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ datasource.DataSource              = &FunctionTestDataSource{}
+	_ datasource.DataSourceWithConfigure = &FunctionTestDataSource{}
+)
+
+// FunctionTestDataSource defines the data source implementation
+type FunctionTestDataSource struct {
+	client client.SequinAPI
+}
+
+// FunctionTestDataSourceModel describes the data source data model
+type FunctionTestDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Function types.String `tfsdk:"function"`
+	Message  types.String `tfsdk:"message"`
+	Output   types.String `tfsdk:"output"`
+	Error    types.String `tfsdk:"error"`
+	Success  types.Bool   `tfsdk:"success"`
+}
+
+// NewFunctionTestDataSource creates a new data source
+func NewFunctionTestDataSource() datasource.DataSource {
+	return &FunctionTestDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *FunctionTestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function_test"
+}
+
+// Schema defines the data source schema
+func (d *FunctionTestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a function (transform, filter, routing, ...) against a sample message and returns its output, so functions can be unit-tested with check blocks during plan.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this test run (the function reference).",
+				Computed:    true,
+			},
+			"function": schema.StringAttribute{
+				Description: "Name or ID of the function to run.",
+				Required:    true,
+			},
+			"message": schema.StringAttribute{
+				Description: "Sample message as a JSON string, e.g. jsonencode({ record = {...}, changes = {...}, action = \"insert\", metadata = {...} }).",
+				Required:    true,
+			},
+			"output": schema.StringAttribute{
+				Description: "Function output as a JSON string. Null when the function failed.",
+				Computed:    true,
+			},
+			"error": schema.StringAttribute{
+				Description: "Error raised by the function. Null when it succeeded.",
+				Computed:    true,
+			},
+			"success": schema.BoolAttribute{
+				Description: "Whether the function ran without error.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source
+func (d *FunctionTestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read runs the function against the sample message
+func (d *FunctionTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = client.WithOperation(ctx, "sequin_function_test.read")
+
+	var data FunctionTestDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	message := data.Message.ValueString()
+	if !json.Valid([]byte(message)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("message"),
+			"Invalid Sample Message",
+			"message must be a JSON string; use jsonencode() to build it.",
+		)
+		return
+	}
+
+	function := data.Function.ValueString()
+	result, err := d.client.TestFunction(ctx, function, &client.FunctionTestRequest{
+		Message: json.RawMessage(message),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Testing Function",
+			"Could not run function "+function+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(function)
+	mapFunctionTestResult(result, &data)
+
+	tflog.Debug(ctx, "Tested function", map[string]any{"function": function, "success": data.Success.ValueBool()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapFunctionTestResult maps the API result onto the data source model
+func mapFunctionTestResult(result *client.FunctionTestResponse, data *FunctionTestDataSourceModel) {
+	data.Success = types.BoolValue(result.Error == "")
+	data.Output = types.StringNull()
+	data.Error = types.StringNull()
+
+	if result.Error != "" {
+		data.Error = types.StringValue(result.Error)
+		return
+	}
+	if len(result.Output) > 0 {
+		data.Output = types.StringValue(string(result.Output))
+	}
+}
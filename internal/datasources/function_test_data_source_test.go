@@ -0,0 +1,63 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestFunctionTestDataSource_Metadata(t *testing.T) {
+	ds := NewFunctionTestDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_function_test" {
+		t.Errorf("TypeName = %q, want sequin_function_test", resp.TypeName)
+	}
+}
+
+func TestFunctionTestDataSource_Schema(t *testing.T) {
+	ds := NewFunctionTestDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "function", "message", "output", "error", "success"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestMapFunctionTestResult(t *testing.T) {
+	var data FunctionTestDataSourceModel
+
+	mapFunctionTestResult(&client.FunctionTestResponse{Output: json.RawMessage(`{"id":1}`)}, &data)
+	if !data.Success.ValueBool() {
+		t.Error("Success = false, want true")
+	}
+	if data.Output.ValueString() != `{"id":1}` {
+		t.Errorf("Output = %q, want raw JSON", data.Output.ValueString())
+	}
+	if !data.Error.IsNull() {
+		t.Error("Error should be null on success")
+	}
+
+	mapFunctionTestResult(&client.FunctionTestResponse{Error: "undefined function foo/1"}, &data)
+	if data.Success.ValueBool() {
+		t.Error("Success = true, want false")
+	}
+	if data.Error.ValueString() != "undefined function foo/1" {
+		t.Errorf("Error = %q, want function error", data.Error.ValueString())
+	}
+	if !data.Output.IsNull() {
+		t.Error("Output should be null on failure")
+	}
+}
@@ -0,0 +1,247 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ datasource.DataSource              = &ReplicationImpactDataSource{}
+	_ datasource.DataSourceWithConfigure = &ReplicationImpactDataSource{}
+)
+
+// ReplicationImpactDataSource defines the data source implementation
+type ReplicationImpactDataSource struct {
+	client client.SequinAPI
+}
+
+// ReplicationImpactDataSourceModel describes the data source data model
+type ReplicationImpactDataSourceModel struct {
+	ID             types.String            `tfsdk:"id"`
+	Database       types.String            `tfsdk:"database"`
+	Tables         []string                `tfsdk:"tables"`
+	Source         *ReplicationSourceModel `tfsdk:"source"`
+	Estimates      []TableEstimateModel    `tfsdk:"estimates"`
+	TotalRowCount  types.Int64             `tfsdk:"total_row_count"`
+	TotalSizeBytes types.Int64             `tfsdk:"total_size_bytes"`
+}
+
+// ReplicationSourceModel mirrors the source filters of a sink consumer
+type ReplicationSourceModel struct {
+	IncludeSchemas []string `tfsdk:"include_schemas"`
+	ExcludeSchemas []string `tfsdk:"exclude_schemas"`
+	IncludeTables  []string `tfsdk:"include_tables"`
+	ExcludeTables  []string `tfsdk:"exclude_tables"`
+}
+
+// TableEstimateModel describes the estimated size of a selected table
+type TableEstimateModel struct {
+	Table     string      `tfsdk:"table"`
+	RowCount  types.Int64 `tfsdk:"row_count"`
+	SizeBytes types.Int64 `tfsdk:"size_bytes"`
+}
+
+// NewReplicationImpactDataSource creates a new data source
+func NewReplicationImpactDataSource() datasource.DataSource {
+	return &ReplicationImpactDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *ReplicationImpactDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_impact"
+}
+
+// Schema defines the data source schema
+func (d *ReplicationImpactDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	filter := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Description: description,
+			Optional:    true,
+			ElementType: types.StringType,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Estimates the row count and size of the tables a sink consumer would stream, from the same tables and source filters, so the expected replication and backfill volume of a new sink shows up in plans for review.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this lookup (the database).",
+				Computed:    true,
+			},
+			"database": schema.StringAttribute{
+				Description: "Name or ID of the database the sink streams from.",
+				Required:    true,
+			},
+			"tables": schema.ListAttribute{
+				Description: "Schema-qualified tables the sink lists, as in sequin_sink_consumer tables.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"source": schema.SingleNestedAttribute{
+				Description: "Source filters of the sink, as in the sequin_sink_consumer source block. Every table is estimated when neither tables nor source is set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"include_schemas": filter("Schema names to include."),
+					"exclude_schemas": filter("Schema names to exclude."),
+					"include_tables":  filter("Schema-qualified table names to include."),
+					"exclude_tables":  filter("Schema-qualified table names to exclude."),
+				},
+			},
+			"estimates": schema.ListNestedAttribute{
+				Description: "Selected tables, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"table": schema.StringAttribute{
+							Description: "Schema-qualified table name.",
+							Computed:    true,
+						},
+						"row_count": schema.Int64Attribute{
+							Description: "Estimated row count from Postgres statistics. Null when the table was never analyzed.",
+							Computed:    true,
+						},
+						"size_bytes": schema.Int64Attribute{
+							Description: "Total size of the table including indexes and TOAST. Null when unknown.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"total_row_count": schema.Int64Attribute{
+				Description: "Sum of the known row counts. Null when no selected table reports one.",
+				Computed:    true,
+			},
+			"total_size_bytes": schema.Int64Attribute{
+				Description: "Sum of the known table sizes. Null when no selected table reports one.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source
+func (d *ReplicationImpactDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read estimates the size of the selected tables
+func (d *ReplicationImpactDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = client.WithOperation(ctx, "sequin_replication_impact.read")
+
+	var data ReplicationImpactDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var source *client.SinkConsumerSource
+	if data.Source != nil {
+		source = &client.SinkConsumerSource{
+			IncludeSchemas: data.Source.IncludeSchemas,
+			ExcludeSchemas: data.Source.ExcludeSchemas,
+			IncludeTables:  data.Source.IncludeTables,
+			ExcludeTables:  data.Source.ExcludeTables,
+		}
+	}
+
+	// Databases may have many more tables than a sink streams, so only the
+	// selected ones are kept as the list streams in
+	database := data.Database.ValueString()
+	var stats []client.TableStats
+	existing := make(map[string]bool, len(data.Tables))
+	err := d.client.EachTableStats(ctx, database, func(table client.TableStats) error {
+		existing[table.Table] = true
+		if tableSelected(table.Table, data.Tables, source) {
+			stats = append(stats, table)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Table Statistics",
+			"Could not list the tables of database "+database+": "+err.Error(),
+		)
+		return
+	}
+
+	// A listed table missing from the database would silently count as zero
+	for _, table := range data.Tables {
+		if !existing[table] {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("tables"),
+				"Table Not Found",
+				"Table "+table+" does not exist in database "+database+" and is not part of the estimate.",
+			)
+		}
+	}
+
+	data.ID = types.StringValue(database)
+	data.Estimates, data.TotalRowCount, data.TotalSizeBytes = estimateTables(stats, data.Tables, source)
+
+	tflog.Debug(ctx, "Read replication impact", map[string]any{"database": database, "tables": len(data.Estimates)})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// tableSelected reports whether the listed tables or source filters select
+// the table. Without tables or source every table is selected.
+func tableSelected(table string, tables []string, source *client.SinkConsumerSource) bool {
+	if len(tables) == 0 && source == nil {
+		return true
+	}
+	return containsString(tables, table) || (source != nil && sourceSelectsTable(source, table))
+}
+
+// estimateTables returns the tables selected by the listed tables and source
+// filters, sorted by name, with the totals of their known estimates. Without
+// tables or source every table is selected. An empty result is an empty list
+// rather than null so length() checks work.
+func estimateTables(stats []client.TableStats, tables []string, source *client.SinkConsumerSource) ([]TableEstimateModel, types.Int64, types.Int64) {
+	estimates := make([]TableEstimateModel, 0)
+	totalRows, totalBytes := types.Int64Null(), types.Int64Null()
+	for _, table := range stats {
+		if !tableSelected(table.Table, tables, source) {
+			continue
+		}
+
+		estimate := TableEstimateModel{
+			Table:     table.Table,
+			RowCount:  types.Int64Null(),
+			SizeBytes: types.Int64Null(),
+		}
+		if table.RowCount != nil {
+			estimate.RowCount = types.Int64Value(*table.RowCount)
+			totalRows = types.Int64Value(totalRows.ValueInt64() + *table.RowCount)
+		}
+		if table.SizeBytes != nil {
+			estimate.SizeBytes = types.Int64Value(*table.SizeBytes)
+			totalBytes = types.Int64Value(totalBytes.ValueInt64() + *table.SizeBytes)
+		}
+		estimates = append(estimates, estimate)
+	}
+
+	sort.Slice(estimates, func(i, j int) bool { return estimates[i].Table < estimates[j].Table })
+	return estimates, totalRows, totalBytes
+}
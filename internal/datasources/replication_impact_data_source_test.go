@@ -0,0 +1,103 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestReplicationImpactDataSource_Metadata(t *testing.T) {
+	ds := NewReplicationImpactDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_replication_impact" {
+		t.Errorf("TypeName = %q, want sequin_replication_impact", resp.TypeName)
+	}
+}
+
+func TestReplicationImpactDataSource_Schema(t *testing.T) {
+	ds := NewReplicationImpactDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "database", "tables", "source", "estimates", "total_row_count", "total_size_bytes"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestEstimateTables(t *testing.T) {
+	rows := func(n int64) *int64 { return &n }
+	stats := []client.TableStats{
+		{Table: "public.orders", RowCount: rows(1000), SizeBytes: rows(8192)},
+		{Table: "public.users", RowCount: rows(50), SizeBytes: rows(4096)},
+		{Table: "audit.events", RowCount: rows(900000), SizeBytes: rows(1 << 30)},
+		{Table: "public.new_table"},
+	}
+
+	cases := map[string]struct {
+		tables     []string
+		source     *client.SinkConsumerSource
+		want       []string
+		wantRows   int64
+		wantTotals bool
+	}{
+		"everything without filters": {
+			want:     []string{"audit.events", "public.new_table", "public.orders", "public.users"},
+			wantRows: 901050, wantTotals: true,
+		},
+		"listed tables": {
+			tables: []string{"public.orders"},
+			want:   []string{"public.orders"}, wantRows: 1000, wantTotals: true,
+		},
+		"source filters": {
+			source: &client.SinkConsumerSource{IncludeSchemas: []string{"public"}, ExcludeTables: []string{"public.users"}},
+			want:   []string{"public.new_table", "public.orders"}, wantRows: 1000, wantTotals: true,
+		},
+		"never analyzed": {
+			tables: []string{"public.new_table"},
+			want:   []string{"public.new_table"},
+		},
+		"nothing selected": {
+			tables: []string{"public.missing"},
+			want:   []string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			estimates, totalRows, totalBytes := estimateTables(stats, tc.tables, tc.source)
+
+			if estimates == nil {
+				t.Fatal("estimates should be an empty list, not null")
+			}
+			var got []string
+			for _, e := range estimates {
+				got = append(got, e.Table)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("tables = %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("tables = %v, want %v", got, tc.want)
+				}
+			}
+			if tc.wantTotals {
+				if totalRows.ValueInt64() != tc.wantRows {
+					t.Errorf("total_row_count = %d, want %d", totalRows.ValueInt64(), tc.wantRows)
+				}
+			} else if !totalRows.IsNull() || !totalBytes.IsNull() {
+				t.Errorf("totals = %s, %s; want null without known estimates", totalRows, totalBytes)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ datasource.DataSource              = &ReplicationSlotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ReplicationSlotsDataSource{}
+)
+
+// ReplicationSlotsDataSource defines the data source implementation
+type ReplicationSlotsDataSource struct {
+	client client.SequinAPI
+}
+
+// ReplicationSlotsDataSourceModel describes the data source data model
+type ReplicationSlotsDataSourceModel struct {
+	ID          types.String           `tfsdk:"id"`
+	Database    types.String           `tfsdk:"database"`
+	Slots       []ReplicationSlotModel `tfsdk:"slots"`
+	MaxLagBytes types.Int64            `tfsdk:"max_lag_bytes"`
+	SlotActive  types.Bool             `tfsdk:"slot_active"`
+}
+
+// ReplicationSlotModel describes a single replication slot
+type ReplicationSlotModel struct {
+	SlotName          string       `tfsdk:"slot_name"`
+	Database          string       `tfsdk:"database"`
+	DatabaseID        string       `tfsdk:"database_id"`
+	Active            types.Bool   `tfsdk:"active"`
+	ConfirmedFlushLSN types.String `tfsdk:"confirmed_flush_lsn"`
+	LagBytes          types.Int64  `tfsdk:"lag_bytes"`
+}
+
+// NewReplicationSlotsDataSource creates a new data source
+func NewReplicationSlotsDataSource() datasource.DataSource {
+	return &ReplicationSlotsDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *ReplicationSlotsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_slots"
+}
+
+// Schema defines the data source schema
+func (d *ReplicationSlotsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the replication slots of every connected database with their confirmed flush LSN and retained WAL, e.g. to fail a check block on runaway WAL growth.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this lookup (the database filter, or \"all\").",
+				Computed:    true,
+			},
+			"database": schema.StringAttribute{
+				Description: "Name or ID of a database to limit the result to. Lists the slots of every database when omitted.",
+				Optional:    true,
+			},
+			"slots": schema.ListNestedAttribute{
+				Description: "Replication slots.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"slot_name": schema.StringAttribute{
+							Description: "Name of the replication slot.",
+							Computed:    true,
+						},
+						"database": schema.StringAttribute{
+							Description: "Name of the database the slot belongs to.",
+							Computed:    true,
+						},
+						"database_id": schema.StringAttribute{
+							Description: "ID of the database the slot belongs to.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether Sequin is connected to the slot. Inactive slots keep retaining WAL. Null when unknown.",
+							Computed:    true,
+						},
+						"confirmed_flush_lsn": schema.StringAttribute{
+							Description: "Last LSN the slot's consumer confirmed, e.g. 0/16B3748. Null when unknown.",
+							Computed:    true,
+						},
+						"lag_bytes": schema.Int64Attribute{
+							Description: "Bytes of WAL retained for the slot. Null when Postgres doesn't report it.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"max_lag_bytes": schema.Int64Attribute{
+				Description: "Largest lag_bytes across the listed slots. Null when no slot reports lag.",
+				Computed:    true,
+			},
+			"slot_active": schema.BoolAttribute{
+				Description: "Whether every listed slot is active. For use in check blocks. Null when no slot reports its state.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source
+func (d *ReplicationSlotsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read fetches the replication slots from the API
+func (d *ReplicationSlotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = client.WithOperation(ctx, "sequin_replication_slots.read")
+
+	var data ReplicationSlotsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database := data.Database.ValueString()
+	slots, err := d.client.ListReplicationSlots(ctx, database)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Replication Slots",
+			"Could not list replication slots: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue("all")
+	if database != "" {
+		data.ID = types.StringValue(database)
+	}
+	data.Slots, data.MaxLagBytes = mapReplicationSlots(slots)
+	data.SlotActive = allSlotsActive(data.Slots)
+
+	tflog.Debug(ctx, "Read replication slots", map[string]any{"database": database, "count": len(slots)})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapReplicationSlots converts API slots to the data source model and returns
+// the largest reported lag. An empty result is an empty list rather than null
+// so length() checks work.
+func mapReplicationSlots(slots []client.ReplicationSlotStatus) ([]ReplicationSlotModel, types.Int64) {
+	models := make([]ReplicationSlotModel, 0, len(slots))
+	maxLag := types.Int64Null()
+	for _, slot := range slots {
+		lag := types.Int64Null()
+		if slot.LagBytes != nil {
+			lag = types.Int64Value(*slot.LagBytes)
+			if maxLag.IsNull() || *slot.LagBytes > maxLag.ValueInt64() {
+				maxLag = lag
+			}
+		}
+		models = append(models, ReplicationSlotModel{
+			SlotName:          slot.SlotName,
+			Database:          slot.Database,
+			DatabaseID:        slot.DatabaseID,
+			Active:            optionalBool(slot.Active),
+			ConfirmedFlushLSN: optionalString(slot.ConfirmedFlushLSN),
+			LagBytes:          lag,
+		})
+	}
+	return models, maxLag
+}
+
+// allSlotsActive reports whether every slot that reports its state is active.
+// Slots with an unknown state are skipped; null means none reported it.
+func allSlotsActive(slots []ReplicationSlotModel) types.Bool {
+	result := types.BoolNull()
+	for _, slot := range slots {
+		if slot.Active.IsNull() {
+			continue
+		}
+		if !slot.Active.ValueBool() {
+			return types.BoolValue(false)
+		}
+		result = types.BoolValue(true)
+	}
+	return result
+}
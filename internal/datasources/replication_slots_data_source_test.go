@@ -0,0 +1,96 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReplicationSlotsDataSource_Metadata(t *testing.T) {
+	ds := NewReplicationSlotsDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_replication_slots" {
+		t.Errorf("TypeName = %q, want sequin_replication_slots", resp.TypeName)
+	}
+}
+
+func TestReplicationSlotsDataSource_Schema(t *testing.T) {
+	ds := NewReplicationSlotsDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "database", "slots", "max_lag_bytes", "slot_active"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestMapReplicationSlots(t *testing.T) {
+	small, large := int64(1024), int64(8<<30)
+	active, inactive := true, false
+	slots, maxLag := mapReplicationSlots([]client.ReplicationSlotStatus{
+		{SlotName: "sequin_main", Database: "main", Active: &active, ConfirmedFlushLSN: "0/16B3748", LagBytes: &small},
+		{SlotName: "sequin_legacy", Database: "legacy", Active: &inactive, LagBytes: &large},
+		{SlotName: "sequin_new", Database: "analytics"},
+	})
+
+	if len(slots) != 3 {
+		t.Fatalf("len(slots) = %d, want 3", len(slots))
+	}
+	if maxLag.ValueInt64() != large {
+		t.Errorf("max_lag_bytes = %d, want %d", maxLag.ValueInt64(), large)
+	}
+	if slots[1].Active.ValueBool() {
+		t.Error("legacy slot should be inactive")
+	}
+	if !slots[2].Active.IsNull() || !slots[2].LagBytes.IsNull() || !slots[2].ConfirmedFlushLSN.IsNull() {
+		t.Error("values the API omits should be null")
+	}
+}
+
+func TestMapReplicationSlots_Empty(t *testing.T) {
+	slots, maxLag := mapReplicationSlots(nil)
+
+	if slots == nil || len(slots) != 0 {
+		t.Errorf("slots = %#v, want empty list", slots)
+	}
+	if !maxLag.IsNull() {
+		t.Error("max_lag_bytes should be null without slots")
+	}
+}
+
+func TestAllSlotsActive(t *testing.T) {
+	active, inactive := types.BoolValue(true), types.BoolValue(false)
+	unknown := types.BoolNull()
+
+	cases := map[string]struct {
+		states []types.Bool
+		want   types.Bool
+	}{
+		"all active":       {[]types.Bool{active, active}, types.BoolValue(true)},
+		"one inactive":     {[]types.Bool{active, inactive}, types.BoolValue(false)},
+		"unknown skipped":  {[]types.Bool{unknown, active}, types.BoolValue(true)},
+		"nothing reported": {[]types.Bool{unknown}, types.BoolNull()},
+		"no slots":         {nil, types.BoolNull()},
+	}
+	for name, tc := range cases {
+		slots := make([]ReplicationSlotModel, 0, len(tc.states))
+		for _, state := range tc.states {
+			slots = append(slots, ReplicationSlotModel{Active: state})
+		}
+		if got := allSlotsActive(slots); !got.Equal(tc.want) {
+			t.Errorf("%s: allSlotsActive() = %s, want %s", name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ datasource.DataSource              = &SensitiveDataExposuresDataSource{}
+	_ datasource.DataSourceWithConfigure = &SensitiveDataExposuresDataSource{}
+)
+
+// SensitiveDataExposuresDataSource defines the data source implementation
+type SensitiveDataExposuresDataSource struct {
+	client client.SequinAPI
+}
+
+// SensitiveDataExposuresDataSourceModel describes the data source data model
+type SensitiveDataExposuresDataSourceModel struct {
+	ID        types.String             `tfsdk:"id"`
+	Database  types.String             `tfsdk:"database"`
+	Exposures []SensitiveExposureModel `tfsdk:"exposures"`
+	Exposed   types.Bool               `tfsdk:"exposed"`
+}
+
+// SensitiveExposureModel describes a sink streaming classified data
+type SensitiveExposureModel struct {
+	SinkConsumer   string       `tfsdk:"sink_consumer"`
+	SinkConsumerID string       `tfsdk:"sink_consumer_id"`
+	Table          string       `tfsdk:"table"`
+	Columns        types.List   `tfsdk:"columns"`
+	Classification string       `tfsdk:"classification"`
+	Transform      types.String `tfsdk:"transform"`
+}
+
+// NewSensitiveDataExposuresDataSource creates a new data source
+func NewSensitiveDataExposuresDataSource() datasource.DataSource {
+	return &SensitiveDataExposuresDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *SensitiveDataExposuresDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sensitive_data_exposures"
+}
+
+// Schema defines the data source schema
+func (d *SensitiveDataExposuresDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports sink consumers that stream columns classified with sequin_data_classification without removing them through message_shape, so OPA or Sentinel policies and check blocks can block them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this lookup (the database filter, or \"all\").",
+				Computed:    true,
+			},
+			"database": schema.StringAttribute{
+				Description: "Name or ID of a database to limit the report to. Checks every database when omitted.",
+				Optional:    true,
+			},
+			"exposures": schema.ListNestedAttribute{
+				Description: "One entry per sink consumer and classified table it streams sensitive data from.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sink_consumer": schema.StringAttribute{
+							Description: "Name of the sink consumer.",
+							Computed:    true,
+						},
+						"sink_consumer_id": schema.StringAttribute{
+							Description: "ID of the sink consumer.",
+							Computed:    true,
+						},
+						"table": schema.StringAttribute{
+							Description: "Schema-qualified name of the classified table.",
+							Computed:    true,
+						},
+						"columns": schema.ListAttribute{
+							Description: "Classified columns the sink delivers. Null when the whole table is classified.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"classification": schema.StringAttribute{
+							Description: "Sensitivity class: pii, phi, pci, confidential.",
+							Computed:    true,
+						},
+						"transform": schema.StringAttribute{
+							Description: "Transform function of the sink, if any. The provider cannot tell whether a transform redacts the data, so policies may allow reviewed transforms. Null without a transform.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"exposed": schema.BoolAttribute{
+				Description: "Whether any sink streams classified data. For use in check blocks.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source
+func (d *SensitiveDataExposuresDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read compares the classifications with the sink consumers streaming them
+func (d *SensitiveDataExposuresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = client.WithOperation(ctx, "sequin_sensitive_data_exposures.read")
+
+	var data SensitiveDataExposuresDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	classifications, err := d.client.ListDataClassifications(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Data Classifications",
+			"Could not list data classifications: "+err.Error(),
+		)
+		return
+	}
+	database := data.Database.ValueString()
+	classifications = filterClassifications(classifications, database)
+
+	// Only sinks on classified databases can expose anything, so the rest
+	// are dropped as the list streams in
+	var sinks []client.SinkConsumerResponse
+	err = d.client.EachSinkConsumer(ctx, client.SinkConsumerListOptions{}, func(sink client.SinkConsumerResponse) error {
+		if classifiedDatabase(classifications, sink.Database) {
+			sinks = append(sinks, sink)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Sink Consumers",
+			"Could not list sink consumers: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue("all")
+	if database != "" {
+		data.ID = types.StringValue(database)
+	}
+	data.Exposures = findSensitiveExposures(ctx, classifications, sinks, &resp.Diagnostics)
+	data.Exposed = types.BoolValue(len(data.Exposures) > 0)
+
+	tflog.Debug(ctx, "Read sensitive data exposures", map[string]any{"database": database, "count": len(data.Exposures)})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterClassifications keeps the classifications of the given database name
+// or ID, or all of them when it is empty
+func filterClassifications(classifications []client.DataClassificationResponse, database string) []client.DataClassificationResponse {
+	if database == "" {
+		return classifications
+	}
+	var filtered []client.DataClassificationResponse
+	for _, classification := range classifications {
+		if classification.Database == database || classification.DatabaseID == database {
+			filtered = append(filtered, classification)
+		}
+	}
+	return filtered
+}
+
+// classifiedDatabase reports whether any classification belongs to the
+// database name or ID
+func classifiedDatabase(classifications []client.DataClassificationResponse, database string) bool {
+	for _, classification := range classifications {
+		if classification.Database == database || classification.DatabaseID == database {
+			return true
+		}
+	}
+	return false
+}
+
+// findSensitiveExposures returns, sorted by sink and table, every classified
+// table a sink streams without removing its classified columns. An empty
+// result is an empty list rather than null so length() checks work.
+func findSensitiveExposures(ctx context.Context, classifications []client.DataClassificationResponse, sinks []client.SinkConsumerResponse, diags *diag.Diagnostics) []SensitiveExposureModel {
+	exposures := make([]SensitiveExposureModel, 0)
+	for _, sink := range sinks {
+		for _, classification := range classifications {
+			if sink.Database != classification.Database && sink.Database != classification.DatabaseID {
+				continue
+			}
+			if !sinkStreamsTable(sink, classification.Table) {
+				continue
+			}
+
+			columns := types.ListNull(types.StringType)
+			if len(classification.Columns) > 0 {
+				var delivered []string
+				for _, column := range classification.Columns {
+					if columnDelivered(sink.MessageShape, classification.Table, column) {
+						delivered = append(delivered, column)
+					}
+				}
+				if len(delivered) == 0 {
+					continue
+				}
+				list, d := types.ListValueFrom(ctx, types.StringType, delivered)
+				diags.Append(d...)
+				columns = list
+			}
+
+			exposures = append(exposures, SensitiveExposureModel{
+				SinkConsumer:   sink.Name,
+				SinkConsumerID: sink.ID,
+				Table:          classification.Table,
+				Columns:        columns,
+				Classification: classification.Classification,
+				Transform:      sinkTransform(sink.Transform),
+			})
+		}
+	}
+
+	sort.SliceStable(exposures, func(i, j int) bool {
+		if exposures[i].SinkConsumer != exposures[j].SinkConsumer {
+			return exposures[i].SinkConsumer < exposures[j].SinkConsumer
+		}
+		return exposures[i].Table < exposures[j].Table
+	})
+	return exposures
+}
+
+// sinkStreamsTable reports whether a sink streams the schema-qualified table,
+// either listed in its tables or matched by its source filters
+func sinkStreamsTable(sink client.SinkConsumerResponse, table string) bool {
+	for _, t := range sink.Tables {
+		if t.Name == table {
+			return true
+		}
+	}
+
+	return sink.Source != nil && sourceSelectsTable(sink.Source, table)
+}
+
+// sourceSelectsTable reports whether source filters match the schema-qualified
+// table. Exclusions win, and a source without inclusions matches every table.
+func sourceSelectsTable(source *client.SinkConsumerSource, table string) bool {
+	schemaName, _, _ := strings.Cut(table, ".")
+	if containsString(source.ExcludeSchemas, schemaName) || containsString(source.ExcludeTables, table) {
+		return false
+	}
+	if len(source.IncludeSchemas) == 0 && len(source.IncludeTables) == 0 {
+		return true
+	}
+	return containsString(source.IncludeSchemas, schemaName) || containsString(source.IncludeTables, table)
+}
+
+// columnDelivered reports whether the message shape keeps a column of the
+// table. Columns are matched by name or as schema.table.column.
+func columnDelivered(shape *client.SinkConsumerMessageShape, table, column string) bool {
+	if shape == nil {
+		return true
+	}
+	qualified := table + "." + column
+	if containsString(shape.ExcludeColumns, column) || containsString(shape.ExcludeColumns, qualified) {
+		return false
+	}
+	if len(shape.IncludeColumns) == 0 {
+		return true
+	}
+	return containsString(shape.IncludeColumns, column) || containsString(shape.IncludeColumns, qualified)
+}
+
+// sinkTransform maps the sink's transform to null when it has none. The API
+// reports a missing transform as "none".
+func sinkTransform(transform string) types.String {
+	if transform == "none" {
+		return types.StringNull()
+	}
+	return optionalString(transform)
+}
+
+// containsString reports whether values holds value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
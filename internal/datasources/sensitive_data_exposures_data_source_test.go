@@ -0,0 +1,103 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestSensitiveDataExposuresDataSource_Metadata(t *testing.T) {
+	ds := NewSensitiveDataExposuresDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_sensitive_data_exposures" {
+		t.Errorf("TypeName = %q, want sequin_sensitive_data_exposures", resp.TypeName)
+	}
+}
+
+func TestSensitiveDataExposuresDataSource_Schema(t *testing.T) {
+	ds := NewSensitiveDataExposuresDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "database", "exposures", "exposed"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestFindSensitiveExposures(t *testing.T) {
+	classifications := []client.DataClassificationResponse{
+		{Database: "production", DatabaseID: "db-1", Table: "public.users", Columns: []string{"email", "ssn"}, Classification: "pii"},
+		{Database: "production", DatabaseID: "db-1", Table: "public.cards", Classification: "pci"},
+	}
+	sinks := []client.SinkConsumerResponse{
+		// Streams users with ssn removed: email is still exposed
+		{ID: "s-1", Name: "users-raw", Database: "db-1", Tables: []client.SinkConsumerTable{{Name: "public.users"}},
+			MessageShape: &client.SinkConsumerMessageShape{ExcludeColumns: []string{"public.users.ssn"}}},
+		// Only delivers id: nothing exposed
+		{ID: "s-2", Name: "users-ids", Database: "production", Tables: []client.SinkConsumerTable{{Name: "public.users"}},
+			MessageShape: &client.SinkConsumerMessageShape{IncludeColumns: []string{"id"}}},
+		// Picks up cards through its source filter, behind a transform
+		{ID: "s-3", Name: "everything", Database: "db-1", Transform: "mask-cards",
+			Source: &client.SinkConsumerSource{IncludeSchemas: []string{"public"}, ExcludeTables: []string{"public.users"}}},
+		// Other database
+		{ID: "s-4", Name: "analytics", Database: "db-2", Tables: []client.SinkConsumerTable{{Name: "public.users"}}},
+	}
+
+	var diags diag.Diagnostics
+	exposures := findSensitiveExposures(context.Background(), classifications, sinks, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(exposures) != 2 {
+		t.Fatalf("exposures = %+v, want 2", exposures)
+	}
+	everything, raw := exposures[0], exposures[1]
+	if everything.SinkConsumer != "everything" || everything.Table != "public.cards" || !everything.Columns.IsNull() {
+		t.Errorf("exposure = %+v, want whole public.cards table via everything", everything)
+	}
+	if everything.Transform.ValueString() != "mask-cards" {
+		t.Errorf("transform = %s, want mask-cards", everything.Transform)
+	}
+	if raw.SinkConsumer != "users-raw" || len(raw.Columns.Elements()) != 1 {
+		t.Errorf("exposure = %+v, want only email via users-raw", raw)
+	}
+	if !raw.Transform.IsNull() {
+		t.Error("transform should be null when the sink has none")
+	}
+}
+
+func TestFindSensitiveExposures_Empty(t *testing.T) {
+	exposures := findSensitiveExposures(context.Background(), nil, nil, &diag.Diagnostics{})
+	if exposures == nil || len(exposures) != 0 {
+		t.Errorf("exposures = %#v, want empty list", exposures)
+	}
+}
+
+func TestFilterClassifications(t *testing.T) {
+	classifications := []client.DataClassificationResponse{
+		{ID: "dc-1", Database: "production", DatabaseID: "db-1"},
+		{ID: "dc-2", Database: "staging", DatabaseID: "db-2"},
+	}
+
+	if got := filterClassifications(classifications, ""); len(got) != 2 {
+		t.Errorf("no filter returned %d classifications, want 2", len(got))
+	}
+	for _, ref := range []string{"production", "db-1"} {
+		if got := filterClassifications(classifications, ref); len(got) != 1 || got[0].ID != "dc-1" {
+			t.Errorf("filter %q = %+v, want dc-1", ref, got)
+		}
+	}
+}
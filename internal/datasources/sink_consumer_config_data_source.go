@@ -0,0 +1,153 @@
+package datasources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// volatileSinkConsumerFields are server-assigned or runtime fields left out of
+// the rendered configuration so it only changes when the configuration does
+var volatileSinkConsumerFields = []string{"id", "status_info", "health", "inserted_at", "updated_at"}
+
+// volatileDestinationFields are runtime fields left out of the rendered
+// destination
+var volatileDestinationFields = []string{"credential_fingerprint"}
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ datasource.DataSource              = &SinkConsumerConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &SinkConsumerConfigDataSource{}
+)
+
+// SinkConsumerConfigDataSource defines the data source implementation
+type SinkConsumerConfigDataSource struct {
+	client client.SequinAPI
+}
+
+// SinkConsumerConfigDataSourceModel describes the data source data model
+type SinkConsumerConfigDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	SinkConsumer   types.String `tfsdk:"sink_consumer"`
+	RenderedConfig types.String `tfsdk:"rendered_config"`
+}
+
+// NewSinkConsumerConfigDataSource creates a new data source
+func NewSinkConsumerConfigDataSource() datasource.DataSource {
+	return &SinkConsumerConfigDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *SinkConsumerConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sink_consumer_config"
+}
+
+// Schema defines the data source schema
+func (d *SinkConsumerConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Renders a sink consumer's full server-side configuration as normalized JSON, for GitOps diffing or exporting to sequin.yaml with yamlencode(jsondecode(...)).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this lookup (the sink consumer reference).",
+				Computed:    true,
+			},
+			"sink_consumer": schema.StringAttribute{
+				Description: "Name or ID of the sink consumer.",
+				Required:    true,
+			},
+			"rendered_config": schema.StringAttribute{
+				Description: "Configuration as indented JSON with sorted keys. IDs, status and timestamps are left out so the output only changes with the configuration. Secrets are never returned by the API.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source
+func (d *SinkConsumerConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read fetches and renders the configuration
+func (d *SinkConsumerConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = client.WithOperation(ctx, "sequin_sink_consumer_config.read")
+
+	var data SinkConsumerConfigDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sinkConsumer := data.SinkConsumer.ValueString()
+	raw, err := d.client.GetSinkConsumerConfig(ctx, sinkConsumer)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Sink Consumer",
+			"Could not read sink consumer "+sinkConsumer+": "+err.Error(),
+		)
+		return
+	}
+
+	rendered, err := renderSinkConsumerConfig(raw)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Rendering Sink Consumer Config",
+			"Could not render the configuration of sink consumer "+sinkConsumer+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(sinkConsumer)
+	data.RenderedConfig = types.StringValue(rendered)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// renderSinkConsumerConfig normalizes an API sink consumer into indented JSON
+// with sorted keys, leaving out volatile fields. Numbers are kept as written
+// by the API.
+func renderSinkConsumerConfig(raw json.RawMessage) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var config map[string]any
+	if err := decoder.Decode(&config); err != nil {
+		return "", fmt.Errorf("invalid sink consumer JSON: %w", err)
+	}
+
+	for _, field := range volatileSinkConsumerFields {
+		delete(config, field)
+	}
+	if destination, ok := config["destination"].(map[string]any); ok {
+		for _, field := range volatileDestinationFields {
+			delete(destination, field)
+		}
+	}
+
+	// encoding/json sorts map keys, which makes the output stable
+	rendered, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(rendered), nil
+}
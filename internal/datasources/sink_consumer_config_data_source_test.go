@@ -0,0 +1,78 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestSinkConsumerConfigDataSource_Metadata(t *testing.T) {
+	ds := NewSinkConsumerConfigDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_sink_consumer_config" {
+		t.Errorf("TypeName = %q, want sequin_sink_consumer_config", resp.TypeName)
+	}
+}
+
+func TestSinkConsumerConfigDataSource_Schema(t *testing.T) {
+	ds := NewSinkConsumerConfigDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "sink_consumer", "rendered_config"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestRenderSinkConsumerConfig(t *testing.T) {
+	raw := json.RawMessage(`{
+		"name": "orders",
+		"id": "sink-1",
+		"batch_size": 10000000000000001,
+		"status_info": {"state": "active", "updated_at": "2024-05-01T12:00:00Z"},
+		"destination": {"type": "kafka", "hosts": "broker:9092", "credential_fingerprint": "fp-1"},
+		"future_setting": true
+	}`)
+
+	rendered, err := renderSinkConsumerConfig(raw)
+	if err != nil {
+		t.Fatalf("renderSinkConsumerConfig() error: %v", err)
+	}
+
+	want := `{
+  "batch_size": 10000000000000001,
+  "destination": {
+    "hosts": "broker:9092",
+    "type": "kafka"
+  },
+  "future_setting": true,
+  "name": "orders"
+}`
+	if rendered != want {
+		t.Errorf("rendered =\n%s\nwant\n%s", rendered, want)
+	}
+
+	// Reordered input renders identically
+	reordered, _ := renderSinkConsumerConfig(json.RawMessage(`{"future_setting": true, "name": "orders", "destination": {"type": "kafka", "hosts": "broker:9092"}, "batch_size": 10000000000000001}`))
+	if reordered != rendered {
+		t.Error("key order in the API response should not change the rendered config")
+	}
+}
+
+func TestRenderSinkConsumerConfig_Invalid(t *testing.T) {
+	if _, err := renderSinkConsumerConfig(json.RawMessage(`[1, 2]`)); err == nil || !strings.Contains(err.Error(), "invalid sink consumer JSON") {
+		t.Errorf("error = %v, want invalid JSON error", err)
+	}
+}
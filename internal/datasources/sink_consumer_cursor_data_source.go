@@ -0,0 +1,123 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ datasource.DataSource              = &SinkConsumerCursorDataSource{}
+	_ datasource.DataSourceWithConfigure = &SinkConsumerCursorDataSource{}
+)
+
+// SinkConsumerCursorDataSource defines the data source implementation
+type SinkConsumerCursorDataSource struct {
+	client client.SequinAPI
+}
+
+// SinkConsumerCursorDataSourceModel describes the data source data model
+type SinkConsumerCursorDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	SinkConsumer types.String `tfsdk:"sink_consumer"`
+	LSN          types.String `tfsdk:"lsn"`
+	CommittedAt  types.String `tfsdk:"committed_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// NewSinkConsumerCursorDataSource creates a new data source
+func NewSinkConsumerCursorDataSource() datasource.DataSource {
+	return &SinkConsumerCursorDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *SinkConsumerCursorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sink_consumer_cursor"
+}
+
+// Schema defines the data source schema
+func (d *SinkConsumerCursorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the delivery cursor of a sink consumer, e.g. to start a new sink exactly where an old one stopped during a cutover.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this lookup (the sink consumer reference).",
+				Computed:    true,
+			},
+			"sink_consumer": schema.StringAttribute{
+				Description: "Name or ID of the sink consumer.",
+				Required:    true,
+			},
+			"lsn": schema.StringAttribute{
+				Description: "Replication LSN of the last delivered change, e.g. 0/16B3748.",
+				Computed:    true,
+			},
+			"committed_at": schema.StringAttribute{
+				Description: "ISO 8601 commit time of the change at lsn, if known.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "ISO 8601 timestamp when the cursor last advanced, if known.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source
+func (d *SinkConsumerCursorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read fetches the cursor from the API
+func (d *SinkConsumerCursorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = client.WithOperation(ctx, "sequin_sink_consumer_cursor.read")
+
+	var data SinkConsumerCursorDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sinkConsumer := data.SinkConsumer.ValueString()
+	cursor, err := d.client.GetSinkConsumerCursor(ctx, sinkConsumer)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Sink Consumer Cursor",
+			"Could not read cursor for sink consumer "+sinkConsumer+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(sinkConsumer)
+	mapSinkConsumerCursor(cursor, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapSinkConsumerCursor copies the API cursor into the data source model.
+// Timestamps the API omits are null rather than empty strings.
+func mapSinkConsumerCursor(cursor *client.SinkConsumerCursor, data *SinkConsumerCursorDataSourceModel) {
+	data.LSN = types.StringValue(cursor.LSN)
+	data.CommittedAt = optionalString(cursor.CommittedAt)
+	data.UpdatedAt = optionalString(cursor.UpdatedAt)
+}
@@ -0,0 +1,54 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestSinkConsumerCursorDataSource_Metadata(t *testing.T) {
+	ds := NewSinkConsumerCursorDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_sink_consumer_cursor" {
+		t.Errorf("TypeName = %q, want sequin_sink_consumer_cursor", resp.TypeName)
+	}
+}
+
+func TestSinkConsumerCursorDataSource_Schema(t *testing.T) {
+	ds := NewSinkConsumerCursorDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "sink_consumer", "lsn", "committed_at", "updated_at"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestMapSinkConsumerCursor(t *testing.T) {
+	data := &SinkConsumerCursorDataSourceModel{}
+	mapSinkConsumerCursor(&client.SinkConsumerCursor{
+		LSN:         "0/16B3748",
+		CommittedAt: "2024-05-01T12:00:00Z",
+	}, data)
+
+	if data.LSN.ValueString() != "0/16B3748" {
+		t.Errorf("LSN = %q, want 0/16B3748", data.LSN.ValueString())
+	}
+	if data.CommittedAt.ValueString() != "2024-05-01T12:00:00Z" {
+		t.Errorf("CommittedAt = %q, want API value", data.CommittedAt.ValueString())
+	}
+	if !data.UpdatedAt.IsNull() {
+		t.Error("UpdatedAt should be null when the API omits it")
+	}
+}
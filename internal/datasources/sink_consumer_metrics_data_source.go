@@ -0,0 +1,186 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ datasource.DataSource              = &SinkConsumerMetricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &SinkConsumerMetricsDataSource{}
+)
+
+// SinkConsumerMetricsDataSource defines the data source implementation
+type SinkConsumerMetricsDataSource struct {
+	client client.SequinAPI
+}
+
+// SinkConsumerMetricsDataSourceModel describes the data source data model
+type SinkConsumerMetricsDataSourceModel struct {
+	ID                     types.String  `tfsdk:"id"`
+	SinkConsumer           types.String  `tfsdk:"sink_consumer"`
+	MessagesDeliveredCount types.Int64   `tfsdk:"messages_delivered_count"`
+	MessagesFailingCount   types.Int64   `tfsdk:"messages_failing_count"`
+	MessagesPendingCount   types.Int64   `tfsdk:"messages_pending_count"`
+	Throughput             types.Float64 `tfsdk:"throughput"`
+	AvgLatencyMs           types.Float64 `tfsdk:"avg_latency_ms"`
+	ConsumerLagSeconds     types.Float64 `tfsdk:"consumer_lag_seconds"`
+	MeasuredAt             types.String  `tfsdk:"measured_at"`
+	Healthy                types.Bool    `tfsdk:"healthy"`
+	BackfillComplete       types.Bool    `tfsdk:"backfill_complete"`
+}
+
+// NewSinkConsumerMetricsDataSource creates a new data source
+func NewSinkConsumerMetricsDataSource() datasource.DataSource {
+	return &SinkConsumerMetricsDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *SinkConsumerMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sink_consumer_metrics"
+}
+
+// Schema defines the data source schema
+func (d *SinkConsumerMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads operational metrics for a sink consumer, for use in check blocks and monitoring modules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this lookup (the sink consumer reference).",
+				Computed:    true,
+			},
+			"sink_consumer": schema.StringAttribute{
+				Description: "Name or ID of the sink consumer.",
+				Required:    true,
+			},
+			"messages_delivered_count": schema.Int64Attribute{
+				Description: "Total number of messages delivered.",
+				Computed:    true,
+			},
+			"messages_failing_count": schema.Int64Attribute{
+				Description: "Number of messages currently failing delivery.",
+				Computed:    true,
+			},
+			"messages_pending_count": schema.Int64Attribute{
+				Description: "Number of messages waiting to be delivered.",
+				Computed:    true,
+			},
+			"throughput": schema.Float64Attribute{
+				Description: "Delivery throughput in messages per second.",
+				Computed:    true,
+			},
+			"avg_latency_ms": schema.Float64Attribute{
+				Description: "Average delivery latency in milliseconds.",
+				Computed:    true,
+			},
+			"consumer_lag_seconds": schema.Float64Attribute{
+				Description: "Age in seconds of the oldest change not yet delivered.",
+				Computed:    true,
+			},
+			"measured_at": schema.StringAttribute{
+				Description: "ISO 8601 timestamp of the measurement. Null when the API has not measured the sink yet.",
+				Computed:    true,
+			},
+			"healthy": schema.BoolAttribute{
+				Description: "Whether no messages are failing delivery. For use in check blocks.",
+				Computed:    true,
+			},
+			"backfill_complete": schema.BoolAttribute{
+				Description: "Whether the sink's backfills have finished: false while one is running or when all were cancelled. For use in check blocks. Null when the sink has no backfills.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source
+func (d *SinkConsumerMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read fetches the current metrics from the API
+func (d *SinkConsumerMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = client.WithOperation(ctx, "sequin_sink_consumer_metrics.read")
+
+	var data SinkConsumerMetricsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sinkConsumer := data.SinkConsumer.ValueString()
+	metrics, err := d.client.GetSinkConsumerMetrics(ctx, sinkConsumer)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Sink Consumer Metrics",
+			"Could not read metrics for sink consumer "+sinkConsumer+": "+err.Error(),
+		)
+		return
+	}
+
+	backfills, err := d.client.ListBackfills(ctx, sinkConsumer)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Sink Consumer Backfills",
+			"Could not list backfills for sink consumer "+sinkConsumer+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(sinkConsumer)
+	mapSinkConsumerMetrics(metrics, &data)
+	data.BackfillComplete = backfillComplete(backfills)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapSinkConsumerMetrics copies API metrics into the data source model
+func mapSinkConsumerMetrics(metrics *client.SinkConsumerMetrics, data *SinkConsumerMetricsDataSourceModel) {
+	data.MessagesDeliveredCount = types.Int64Value(metrics.MessagesDeliveredCount)
+	data.MessagesFailingCount = types.Int64Value(metrics.MessagesFailingCount)
+	data.MessagesPendingCount = types.Int64Value(metrics.MessagesPendingCount)
+	data.Throughput = types.Float64Value(metrics.Throughput)
+	data.AvgLatencyMs = types.Float64Value(metrics.AvgLatencyMs)
+	data.ConsumerLagSeconds = types.Float64Value(metrics.ConsumerLagSeconds)
+	data.MeasuredAt = optionalString(metrics.MeasuredAt)
+	data.Healthy = types.BoolValue(metrics.MessagesFailingCount == 0)
+}
+
+// backfillComplete summarizes a sink's backfills: false while any is still
+// running, true once at least one completed, false if all were cancelled, and
+// null when there are none
+func backfillComplete(backfills []client.BackfillResponse) types.Bool {
+	if len(backfills) == 0 {
+		return types.BoolNull()
+	}
+	completed := false
+	for _, backfill := range backfills {
+		switch backfill.State {
+		case client.BackfillStateActive:
+			return types.BoolValue(false)
+		case client.BackfillStateCompleted:
+			completed = true
+		}
+	}
+	return types.BoolValue(completed)
+}
@@ -0,0 +1,94 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSinkConsumerMetricsDataSource_Metadata(t *testing.T) {
+	ds := NewSinkConsumerMetricsDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_sink_consumer_metrics" {
+		t.Errorf("TypeName = %q, want sequin_sink_consumer_metrics", resp.TypeName)
+	}
+}
+
+func TestSinkConsumerMetricsDataSource_Schema(t *testing.T) {
+	ds := NewSinkConsumerMetricsDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{
+		"id", "sink_consumer", "messages_delivered_count", "messages_failing_count",
+		"messages_pending_count", "throughput", "avg_latency_ms", "consumer_lag_seconds", "measured_at",
+		"healthy", "backfill_complete",
+	} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestMapSinkConsumerMetrics(t *testing.T) {
+	data := &SinkConsumerMetricsDataSourceModel{}
+	mapSinkConsumerMetrics(&client.SinkConsumerMetrics{
+		MessagesDeliveredCount: 1200,
+		MessagesFailingCount:   3,
+		Throughput:             42.5,
+		ConsumerLagSeconds:     1.25,
+	}, data)
+
+	if data.MessagesDeliveredCount.ValueInt64() != 1200 {
+		t.Errorf("MessagesDeliveredCount = %d, want 1200", data.MessagesDeliveredCount.ValueInt64())
+	}
+	if data.MessagesFailingCount.ValueInt64() != 3 {
+		t.Errorf("MessagesFailingCount = %d, want 3", data.MessagesFailingCount.ValueInt64())
+	}
+	if data.Throughput.ValueFloat64() != 42.5 {
+		t.Errorf("Throughput = %v, want 42.5", data.Throughput.ValueFloat64())
+	}
+	if data.ConsumerLagSeconds.ValueFloat64() != 1.25 {
+		t.Errorf("ConsumerLagSeconds = %v, want 1.25", data.ConsumerLagSeconds.ValueFloat64())
+	}
+	if data.MessagesPendingCount.IsNull() {
+		t.Error("MessagesPendingCount should be known (zero), not null")
+	}
+	if data.Healthy.ValueBool() {
+		t.Error("Healthy should be false with failing messages")
+	}
+	if !data.MeasuredAt.IsNull() {
+		t.Error("MeasuredAt should be null when the API omits it")
+	}
+}
+
+func TestBackfillComplete(t *testing.T) {
+	cases := map[string]struct {
+		states []string
+		want   types.Bool
+	}{
+		"no backfills":  {nil, types.BoolNull()},
+		"running":       {[]string{"completed", "active"}, types.BoolValue(false)},
+		"completed":     {[]string{"cancelled", "completed"}, types.BoolValue(true)},
+		"all cancelled": {[]string{"cancelled"}, types.BoolValue(false)},
+	}
+	for name, tc := range cases {
+		backfills := make([]client.BackfillResponse, 0, len(tc.states))
+		for _, state := range tc.states {
+			backfills = append(backfills, client.BackfillResponse{State: state})
+		}
+		if got := backfillComplete(backfills); !got.Equal(tc.want) {
+			t.Errorf("%s: backfillComplete() = %s, want %s", name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultFailedMessagesLimit bounds the listing when no limit is configured
+const defaultFailedMessagesLimit = 100
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ datasource.DataSource              = &SinkFailedMessagesDataSource{}
+	_ datasource.DataSourceWithConfigure = &SinkFailedMessagesDataSource{}
+)
+
+// SinkFailedMessagesDataSource defines the data source implementation
+type SinkFailedMessagesDataSource struct {
+	client client.SequinAPI
+}
+
+// SinkFailedMessagesDataSourceModel describes the data source data model
+type SinkFailedMessagesDataSourceModel struct {
+	ID           types.String         `tfsdk:"id"`
+	SinkConsumer types.String         `tfsdk:"sink_consumer"`
+	State        types.String         `tfsdk:"state"`
+	Limit        types.Int64          `tfsdk:"limit"`
+	MessageIDs   []string             `tfsdk:"message_ids"`
+	Messages     []FailedMessageModel `tfsdk:"messages"`
+}
+
+// FailedMessageModel describes a single failed or discarded message
+type FailedMessageModel struct {
+	ID              string `tfsdk:"id"`
+	State           string `tfsdk:"state"`
+	Action          string `tfsdk:"action"`
+	Table           string `tfsdk:"table"`
+	Record          string `tfsdk:"record"`
+	DeliverCount    int64  `tfsdk:"deliver_count"`
+	LastError       string `tfsdk:"last_error"`
+	InsertedAt      string `tfsdk:"inserted_at"`
+	LastDeliveredAt string `tfsdk:"last_delivered_at"`
+}
+
+// NewSinkFailedMessagesDataSource creates a new data source
+func NewSinkFailedMessagesDataSource() datasource.DataSource {
+	return &SinkFailedMessagesDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *SinkFailedMessagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sink_failed_messages"
+}
+
+// Schema defines the data source schema
+func (d *SinkFailedMessagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists messages stuck in a sink consumer's failed or discarded set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this lookup (the sink consumer reference).",
+				Computed:    true,
+			},
+			"sink_consumer": schema.StringAttribute{
+				Description: "Name or ID of the sink consumer.",
+				Required:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Only return messages in this state: failed, discarded. Returns both when omitted.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("failed", "discarded"),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of messages to return (1-1000). Defaults to 100.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"message_ids": schema.ListAttribute{
+				Description: "IDs of the returned messages, convenient for passing to sequin_failed_messages_remediation.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"messages": schema.ListNestedAttribute{
+				Description: "Failed or discarded messages.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Message ID.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Message state: failed, discarded.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Change action: insert, update, delete, read.",
+							Computed:    true,
+						},
+						"table": schema.StringAttribute{
+							Description: "Source table in schema.table format.",
+							Computed:    true,
+						},
+						"record": schema.StringAttribute{
+							Description: "Message record as a JSON string.",
+							Computed:    true,
+						},
+						"deliver_count": schema.Int64Attribute{
+							Description: "Number of delivery attempts.",
+							Computed:    true,
+						},
+						"last_error": schema.StringAttribute{
+							Description: "Error from the most recent delivery attempt.",
+							Computed:    true,
+						},
+						"inserted_at": schema.StringAttribute{
+							Description: "ISO 8601 timestamp when the message was produced.",
+							Computed:    true,
+						},
+						"last_delivered_at": schema.StringAttribute{
+							Description: "ISO 8601 timestamp of the last delivery attempt.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source
+func (d *SinkFailedMessagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read fetches the failed message set from the API
+func (d *SinkFailedMessagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = client.WithOperation(ctx, "sequin_sink_failed_messages.read")
+
+	var data SinkFailedMessagesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sinkConsumer := data.SinkConsumer.ValueString()
+	opts := client.SinkMessageListOptions{
+		State: data.State.ValueString(),
+		Limit: defaultFailedMessagesLimit,
+	}
+	if !data.Limit.IsNull() {
+		opts.Limit = int(data.Limit.ValueInt64())
+	}
+
+	messages, err := d.client.ListFailedMessages(ctx, sinkConsumer, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Failed Messages",
+			"Could not list failed messages for sink consumer "+sinkConsumer+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(sinkConsumer)
+	data.Messages = mapFailedMessages(messages)
+	data.MessageIDs = make([]string, 0, len(messages))
+	for _, message := range messages {
+		data.MessageIDs = append(data.MessageIDs, message.ID)
+	}
+
+	tflog.Debug(ctx, "Read failed messages", map[string]any{"sink_consumer": sinkConsumer, "count": len(messages)})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapFailedMessages converts API messages to the data source model
+func mapFailedMessages(messages []client.SinkMessage) []FailedMessageModel {
+	models := make([]FailedMessageModel, 0, len(messages))
+	for _, message := range messages {
+		models = append(models, FailedMessageModel{
+			ID:              message.ID,
+			State:           message.State,
+			Action:          message.Action,
+			Table:           message.Table,
+			Record:          string(message.Record),
+			DeliverCount:    int64(message.DeliverCount),
+			LastError:       message.LastError,
+			InsertedAt:      message.InsertedAt,
+			LastDeliveredAt: message.LastDeliveredAt,
+		})
+	}
+	return models
+}
@@ -0,0 +1,60 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestSinkFailedMessagesDataSource_Metadata(t *testing.T) {
+	ds := NewSinkFailedMessagesDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_sink_failed_messages" {
+		t.Errorf("TypeName = %q, want sequin_sink_failed_messages", resp.TypeName)
+	}
+}
+
+func TestSinkFailedMessagesDataSource_Schema(t *testing.T) {
+	ds := NewSinkFailedMessagesDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "sink_consumer", "state", "limit", "message_ids", "messages"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestMapFailedMessages(t *testing.T) {
+	messages := mapFailedMessages([]client.SinkMessage{
+		{
+			ID:           "msg-001",
+			State:        "failed",
+			DeliverCount: 5,
+			LastError:    "HTTP 500 from https://example.com/webhook",
+		},
+	})
+
+	if len(messages) != 1 {
+		t.Fatalf("got %d messages, want 1", len(messages))
+	}
+	if messages[0].LastError != "HTTP 500 from https://example.com/webhook" {
+		t.Errorf("LastError = %q, want delivery error", messages[0].LastError)
+	}
+	if messages[0].DeliverCount != 5 {
+		t.Errorf("DeliverCount = %d, want 5", messages[0].DeliverCount)
+	}
+	if empty := mapFailedMessages(nil); empty == nil {
+		t.Error("empty result should be an empty list, not null")
+	}
+}
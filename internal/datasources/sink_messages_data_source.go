@@ -0,0 +1,204 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultSinkMessagesLimit keeps the sample small enough for smoke tests
+const defaultSinkMessagesLimit = 10
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ datasource.DataSource              = &SinkMessagesDataSource{}
+	_ datasource.DataSourceWithConfigure = &SinkMessagesDataSource{}
+)
+
+// SinkMessagesDataSource defines the data source implementation
+type SinkMessagesDataSource struct {
+	client client.SequinAPI
+}
+
+// SinkMessagesDataSourceModel describes the data source data model
+type SinkMessagesDataSourceModel struct {
+	ID           types.String       `tfsdk:"id"`
+	SinkConsumer types.String       `tfsdk:"sink_consumer"`
+	State        types.String       `tfsdk:"state"`
+	Limit        types.Int64        `tfsdk:"limit"`
+	Messages     []SinkMessageModel `tfsdk:"messages"`
+}
+
+// SinkMessageModel describes a single sampled message
+type SinkMessageModel struct {
+	ID              string `tfsdk:"id"`
+	State           string `tfsdk:"state"`
+	Action          string `tfsdk:"action"`
+	Table           string `tfsdk:"table"`
+	Record          string `tfsdk:"record"`
+	DeliverCount    int64  `tfsdk:"deliver_count"`
+	InsertedAt      string `tfsdk:"inserted_at"`
+	LastDeliveredAt string `tfsdk:"last_delivered_at"`
+}
+
+// NewSinkMessagesDataSource creates a new data source
+func NewSinkMessagesDataSource() datasource.DataSource {
+	return &SinkMessagesDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *SinkMessagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sink_messages"
+}
+
+// Schema defines the data source schema
+func (d *SinkMessagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a small sample of pending or delivered messages for a sink consumer, e.g. to assert end-to-end delivery after apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this lookup (the sink consumer reference).",
+				Computed:    true,
+			},
+			"sink_consumer": schema.StringAttribute{
+				Description: "Name or ID of the sink consumer.",
+				Required:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Only return messages in this state: pending, delivered. Returns both when omitted.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pending", "delivered"),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of messages to return (1-100). Defaults to 10.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"messages": schema.ListNestedAttribute{
+				Description: "Sampled messages, most recent first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Message ID.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Delivery state: pending, delivered.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Change action: insert, update, delete, read.",
+							Computed:    true,
+						},
+						"table": schema.StringAttribute{
+							Description: "Source table in schema.table format.",
+							Computed:    true,
+						},
+						"record": schema.StringAttribute{
+							Description: "Message record as a JSON string. Use jsondecode() to inspect fields.",
+							Computed:    true,
+						},
+						"deliver_count": schema.Int64Attribute{
+							Description: "Number of delivery attempts.",
+							Computed:    true,
+						},
+						"inserted_at": schema.StringAttribute{
+							Description: "ISO 8601 timestamp when the message was produced.",
+							Computed:    true,
+						},
+						"last_delivered_at": schema.StringAttribute{
+							Description: "ISO 8601 timestamp of the last delivery attempt, if any.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source
+func (d *SinkMessagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read fetches the message sample from the API
+func (d *SinkMessagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = client.WithOperation(ctx, "sequin_sink_messages.read")
+
+	var data SinkMessagesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sinkConsumer := data.SinkConsumer.ValueString()
+	opts := client.SinkMessageListOptions{
+		State: data.State.ValueString(),
+		Limit: defaultSinkMessagesLimit,
+	}
+	if !data.Limit.IsNull() {
+		opts.Limit = int(data.Limit.ValueInt64())
+	}
+
+	messages, err := d.client.ListSinkMessages(ctx, sinkConsumer, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Sink Messages",
+			"Could not list messages for sink consumer "+sinkConsumer+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(sinkConsumer)
+	data.Messages = mapSinkMessages(messages)
+
+	tflog.Debug(ctx, "Read sink messages", map[string]any{"sink_consumer": sinkConsumer, "count": len(messages)})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapSinkMessages converts API messages to the data source model. An empty
+// result is an empty list rather than null so length() checks work.
+func mapSinkMessages(messages []client.SinkMessage) []SinkMessageModel {
+	models := make([]SinkMessageModel, 0, len(messages))
+	for _, message := range messages {
+		models = append(models, SinkMessageModel{
+			ID:              message.ID,
+			State:           message.State,
+			Action:          message.Action,
+			Table:           message.Table,
+			Record:          string(message.Record),
+			DeliverCount:    int64(message.DeliverCount),
+			InsertedAt:      message.InsertedAt,
+			LastDeliveredAt: message.LastDeliveredAt,
+		})
+	}
+	return models
+}
@@ -0,0 +1,97 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestSinkMessagesDataSource_Configure(t *testing.T) {
+	ctx := context.Background()
+	ds := NewSinkMessagesDataSource().(*SinkMessagesDataSource)
+
+	// nil provider data
+	configResp := &datasource.ConfigureResponse{}
+	ds.Configure(ctx, datasource.ConfigureRequest{ProviderData: nil}, configResp)
+	if configResp.Diagnostics.HasError() {
+		t.Errorf("Configure() with nil should not error, got: %v", configResp.Diagnostics.Errors())
+	}
+
+	// correct client type
+	mockClient := &client.Client{}
+	configResp = &datasource.ConfigureResponse{}
+	ds.Configure(ctx, datasource.ConfigureRequest{ProviderData: mockClient}, configResp)
+	if configResp.Diagnostics.HasError() {
+		t.Errorf("Configure() error: %v", configResp.Diagnostics.Errors())
+	}
+	if ds.client != mockClient {
+		t.Error("Configure() did not set client")
+	}
+
+	// invalid type
+	configResp = &datasource.ConfigureResponse{}
+	ds.Configure(ctx, datasource.ConfigureRequest{ProviderData: 42}, configResp)
+	if !configResp.Diagnostics.HasError() {
+		t.Error("Configure() with invalid type should error")
+	}
+}
+
+func TestSinkMessagesDataSource_Metadata(t *testing.T) {
+	ds := NewSinkMessagesDataSource()
+
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_sink_messages" {
+		t.Errorf("TypeName = %q, want sequin_sink_messages", resp.TypeName)
+	}
+}
+
+func TestSinkMessagesDataSource_Schema(t *testing.T) {
+	ds := NewSinkMessagesDataSource()
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "sink_consumer", "state", "limit", "messages"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestMapSinkMessages(t *testing.T) {
+	messages := mapSinkMessages([]client.SinkMessage{
+		{
+			ID:           "msg-001",
+			State:        "delivered",
+			Action:       "insert",
+			Table:        "public.orders",
+			Record:       json.RawMessage(`{"id":1}`),
+			DeliverCount: 1,
+			InsertedAt:   "2024-01-01T00:00:00Z",
+		},
+	})
+
+	if len(messages) != 1 {
+		t.Fatalf("got %d messages, want 1", len(messages))
+	}
+	if messages[0].Record != `{"id":1}` {
+		t.Errorf("Record = %q, want raw JSON", messages[0].Record)
+	}
+	if messages[0].DeliverCount != 1 {
+		t.Errorf("DeliverCount = %d, want 1", messages[0].DeliverCount)
+	}
+}
+
+func TestMapSinkMessages_EmptyIsNotNil(t *testing.T) {
+	if messages := mapSinkMessages(nil); messages == nil {
+		t.Error("empty result should be an empty list, not null")
+	}
+}
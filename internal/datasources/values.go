@@ -0,0 +1,23 @@
+package datasources
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Data sources report values the API omits as null rather than as zero values,
+// so check blocks can tell "no data" apart from "false" or "". Counters are the
+// exception: the API omits them when they are zero.
+
+// optionalString maps an empty API string to null
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// optionalBool maps a missing API boolean to null
+func optionalBool(value *bool) types.Bool {
+	if value == nil {
+		return types.BoolNull()
+	}
+	return types.BoolValue(*value)
+}
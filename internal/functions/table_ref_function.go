@@ -0,0 +1,142 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies expected interfaces
+var _ function.Function = &TableRefFunction{}
+
+// defaultSchema qualifies table names given without a schema
+const defaultSchema = "public"
+
+// maxIdentifierLength is the longest identifier Postgres keeps (NAMEDATALEN - 1)
+const maxIdentifierLength = 63
+
+// unquotedIdentifierPattern matches identifiers that need no double quotes
+var unquotedIdentifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+// TableRefFunction validates a table name and normalizes it to the
+// schema-qualified form the Sequin API expects
+type TableRefFunction struct{}
+
+// NewTableRefFunction creates a new function
+func NewTableRefFunction() function.Function {
+	return &TableRefFunction{}
+}
+
+// Metadata returns the function name
+func (f *TableRefFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "table_ref"
+}
+
+// Definition defines the function parameters and return type
+func (f *TableRefFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate and normalize a table name",
+		Description: "Validates a Postgres table name, optionally schema-qualified, and returns it as schema.table the way Postgres resolves it: " +
+			"unquoted identifiers are lowercased, double-quoted identifiers keep their case, and names without a schema are in public. " +
+			"Use it for sink consumer tables and backfill tables so typos fail at plan time.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: `Table name such as public.users, users or "Sales"."Orders".`,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run normalizes the table name
+func (f *TableRefFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	ref, err := normalizeTableRef(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ref))
+}
+
+// normalizeTableRef returns the schema-qualified form of a table name
+func normalizeTableRef(name string) (string, error) {
+	parts, err := splitIdentifiers(name)
+	if err != nil {
+		return "", fmt.Errorf("invalid table name %q: %w", name, err)
+	}
+
+	switch len(parts) {
+	case 1:
+		return defaultSchema + "." + parts[0], nil
+	case 2:
+		return parts[0] + "." + parts[1], nil
+	default:
+		return "", fmt.Errorf("invalid table name %q: expected table or schema.table", name)
+	}
+}
+
+// splitIdentifiers splits a dotted name into identifiers, lowercasing unquoted
+// ones and unquoting quoted ones as Postgres does
+func splitIdentifiers(name string) ([]string, error) {
+	var parts []string
+	rest := name
+	for {
+		var ident string
+		if strings.HasPrefix(rest, `"`) {
+			var b strings.Builder
+			i := 1
+			for {
+				j := strings.IndexByte(rest[i:], '"')
+				if j < 0 {
+					return nil, fmt.Errorf("unterminated quoted identifier")
+				}
+				b.WriteString(rest[i : i+j])
+				i += j + 1
+				if !strings.HasPrefix(rest[i:], `"`) {
+					break
+				}
+				b.WriteByte('"') // "" escapes a quote
+				i++
+			}
+			ident, rest = b.String(), rest[i:]
+			if ident == "" {
+				return nil, fmt.Errorf("empty quoted identifier")
+			}
+			if strings.Contains(ident, ".") {
+				return nil, fmt.Errorf("identifier %q contains a dot, which the Sequin API cannot tell apart from a schema separator", ident)
+			}
+		} else {
+			end := strings.IndexByte(rest, '.')
+			if end < 0 {
+				end = len(rest)
+			}
+			ident, rest = rest[:end], rest[end:]
+			if !unquotedIdentifierPattern.MatchString(ident) {
+				return nil, fmt.Errorf("%q is not a valid identifier; quote identifiers with other characters", ident)
+			}
+			ident = strings.ToLower(ident)
+		}
+		if len(ident) > maxIdentifierLength {
+			return nil, fmt.Errorf("identifier %q is longer than %d bytes", ident, maxIdentifierLength)
+		}
+		parts = append(parts, ident)
+
+		if rest == "" {
+			return parts, nil
+		}
+		if rest[0] != '.' {
+			return nil, fmt.Errorf("unexpected %q after identifier", rest)
+		}
+		rest = rest[1:]
+	}
+}
@@ -0,0 +1,58 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTableRefFunction_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "public.users", want: "public.users"},
+		{name: "users", want: "public.users"},
+		{name: "Sales.Orders", want: "sales.orders"},
+		{name: `"Sales"."Order Items"`, want: "Sales.Order Items"},
+		{name: `app."say ""hi"""`, want: `app.say "hi"`},
+		{name: "_private.t$1", want: "_private.t$1"},
+		{name: "", wantErr: true},
+		{name: "public.", wantErr: true},
+		{name: "db.public.users", wantErr: true},
+		{name: "public.order items", wantErr: true},
+		{name: "1users", wantErr: true},
+		{name: `"unterminated`, wantErr: true},
+		{name: `"a.b".users`, wantErr: true},
+		{name: `"users"x`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.name)}),
+			}
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+			NewTableRefFunction().Run(context.Background(), req, &resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Errorf("Run(%q) = %v, want an error", tt.name, resp.Result.Value())
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("Run(%q) error: %v", tt.name, resp.Error)
+			}
+			if got := resp.Result.Value(); !got.Equal(types.StringValue(tt.want)) {
+				t.Errorf("Run(%q) = %v, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
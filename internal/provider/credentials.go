@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+)
+
+// defaultProfile is used when no profile is selected
+const defaultProfile = "default"
+
+// credentialsProfile is a named section of the shared credentials file:
+//
+//	[production]
+//	endpoint = https://sequin.example.com
+//	api_key  = ...
+//	platform = self_hosted
+type credentialsProfile struct {
+	Endpoint string
+	APIKey   string
+	Platform string
+}
+
+// credentialsFilePath returns SEQUIN_CREDENTIALS_FILE, or ~/.sequin/credentials
+func credentialsFilePath() (string, error) {
+	if file := os.Getenv("SEQUIN_CREDENTIALS_FILE"); file != "" {
+		return file, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".sequin", "credentials"), nil
+}
+
+// loadProfile reads the named profile from the credentials file. found is
+// false when the file or the profile does not exist.
+func loadProfile(file, name string) (profile credentialsProfile, found bool, err error) {
+	f, err := os.Open(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return profile, false, nil
+	} else if err != nil {
+		return profile, false, err
+	}
+	defer f.Close()
+
+	section := ""
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == name {
+				found = true
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return profile, false, fmt.Errorf("%s:%d: expected key = value", file, lineNo)
+		}
+		if section != name {
+			continue
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		switch key {
+		case "endpoint":
+			profile.Endpoint = value
+		case "api_key":
+			profile.APIKey = value
+		case "platform":
+			if value != client.PlatformCloud && value != client.PlatformSelfHosted {
+				return profile, false, fmt.Errorf("%s:%d: platform must be cloud or self_hosted, got: %s", file, lineNo, value)
+			}
+			profile.Platform = value
+		default:
+			return profile, false, fmt.Errorf("%s:%d: unknown key %q", file, lineNo, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return profile, false, err
+	}
+	return profile, found, nil
+}
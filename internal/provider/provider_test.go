@@ -1,9 +1,18 @@
 package provider
 
 import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -19,3 +28,168 @@ func testAccPreCheck(t *testing.T) {
 	// Add checks for SEQUIN_ENDPOINT and SEQUIN_API_KEY environment variables
 	// when acceptance tests are implemented
 }
+
+func TestDurationSetting(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		want    time.Duration
+		wantErr bool
+	}{
+		{"unset", types.StringNull(), time.Minute, false},
+		{"minutes", types.StringValue("10m"), 10 * time.Minute, false},
+		{"disabled", types.StringValue("0s"), 0, false},
+		{"negative", types.StringValue("-5s"), time.Minute, true},
+		{"no unit", types.StringValue("30"), time.Minute, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := durationSetting(tt.value, time.Minute, path.Root("setting"), &diags)
+			if got != tt.want || diags.HasError() != tt.wantErr {
+				t.Errorf("durationSetting() = %v (error %v), want %v (error %v)", got, diags.HasError(), tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadProfile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "credentials")
+	content := `# Sequin credentials
+[default]
+endpoint = https://sequin.example.com
+api_key = default-key
+
+[cloud]
+api_key  = cloud-key
+platform = cloud
+`
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		profile   string
+		want      credentialsProfile
+		wantFound bool
+	}{
+		{"default", "default", credentialsProfile{Endpoint: "https://sequin.example.com", APIKey: "default-key"}, true},
+		{"named", "cloud", credentialsProfile{APIKey: "cloud-key", Platform: "cloud"}, true},
+		{"missing", "staging", credentialsProfile{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found, err := loadProfile(file, tt.profile)
+			if err != nil {
+				t.Fatalf("loadProfile() error: %v", err)
+			}
+			if found != tt.wantFound || got != tt.want {
+				t.Errorf("loadProfile() = %+v, %v, want %+v, %v", got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+
+	if _, found, err := loadProfile(filepath.Join(t.TempDir(), "absent"), "default"); err != nil || found {
+		t.Errorf("loadProfile() of a missing file = %v, %v, want not found without error", found, err)
+	}
+}
+
+func TestLoadProfile_Invalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"unknown key":      "[default]\nregion = eu\n",
+		"invalid platform": "[default]\nplatform = on_prem\n",
+		"malformed line":   "[default]\napi_key\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "credentials")
+			if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, _, err := loadProfile(file, "default"); err == nil {
+				t.Error("loadProfile() succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestCheckPlatform(t *testing.T) {
+	profileSource := `The platform of the "prod" profile in the shared credentials file /home/me/.sequin/credentials`
+	tests := []struct {
+		name       string
+		platform   string
+		source     string
+		wantDetail string
+		wantPath   bool
+	}{
+		{"valid", client.PlatformSelfHosted, "The SEQUIN_PLATFORM environment variable", "", false},
+		{"unset", "", "", "", false},
+		{"config", "saas", "", "The platform value must be cloud or self_hosted, got: saas", true},
+		{"environment", "saas", "The SEQUIN_PLATFORM environment variable", "The SEQUIN_PLATFORM environment variable must be cloud or self_hosted, got: saas", false},
+		{"profile", "saas", profileSource, profileSource + " must be cloud or self_hosted, got: saas", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkPlatform(&diags, tt.platform, tt.source)
+			if tt.wantDetail == "" {
+				if diags.HasError() {
+					t.Errorf("diagnostics = %v, want none", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Detail() != tt.wantDetail {
+				t.Fatalf("diagnostics = %v, want one error %q", diags, tt.wantDetail)
+			}
+			_, hasPath := diags[0].(diag.DiagnosticWithPath)
+			if hasPath != tt.wantPath {
+				t.Errorf("attached to the platform attribute = %t, want %t", hasPath, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestAddCredentialsError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantSummary string
+	}{
+		{"unauthorized", &client.APIError{StatusCode: http.StatusUnauthorized}, "Invalid Sequin API Key"},
+		{"forbidden", &client.APIError{StatusCode: http.StatusForbidden}, "Insufficient Sequin API Key Permissions"},
+		{"server error", &client.APIError{StatusCode: http.StatusInternalServerError}, "Sequin API Credentials Check Failed"},
+		{"unreachable", errors.New("dial tcp: connection refused"), "Sequin API Unreachable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addCredentialsError(&diags, "https://sequin.example.com", tt.err)
+			if len(diags) != 1 || diags[0].Summary() != tt.wantSummary {
+				t.Errorf("diagnostics = %v, want one %q error", diags, tt.wantSummary)
+			}
+		})
+	}
+}
+
+func TestPlaintextRemoteEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     bool
+	}{
+		{"http://sequin.internal:7376", true},
+		{"http://10.0.0.5", true},
+		{"HTTP://sequin.example.com", true},
+		{"https://sequin.example.com", false},
+		{"http://localhost:7376", false},
+		{"http://sequin.localhost", false},
+		{"http://127.0.0.1:7376", false},
+		{"http://127.1.2.3", false},
+		{"http://[::1]:7376", false},
+	}
+	for _, tt := range tests {
+		if got := plaintextRemoteEndpoint(tt.endpoint); got != tt.want {
+			t.Errorf("plaintextRemoteEndpoint(%q) = %v, want %v", tt.endpoint, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,271 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// accountSettingsID is the fixed ID of the account settings
+const accountSettingsID = "account"
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ resource.Resource                = &AccountSettingsResource{}
+	_ resource.ResourceWithConfigure   = &AccountSettingsResource{}
+	_ resource.ResourceWithImportState = &AccountSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &AccountSettingsResource{}
+)
+
+// AccountSettingsResource manages account-wide defaults. The settings are a
+// singleton: Create adopts and updates them, and Delete leaves them in place.
+type AccountSettingsResource struct {
+	client client.SequinAPI
+}
+
+// AccountSettingsResourceModel describes the resource data model
+type AccountSettingsResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	DefaultTimestampFormat    types.String `tfsdk:"default_timestamp_format"`
+	DefaultLoadSheddingPolicy types.String `tfsdk:"default_load_shedding_policy"`
+	NotificationEmail         types.String `tfsdk:"notification_email"`
+}
+
+// NewAccountSettingsResource creates a new resource
+func NewAccountSettingsResource() resource.Resource {
+	return &AccountSettingsResource{}
+}
+
+// Metadata returns the resource type name
+func (r *AccountSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_settings"
+}
+
+// Schema defines the resource schema
+func (r *AccountSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages account-wide defaults. Only one instance of this resource should exist per account; destroying it leaves the current settings in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Always \"account\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_timestamp_format": schema.StringAttribute{
+				Description: "Timestamp format for new sink consumers that don't set one: iso8601, unix_microsecond.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("iso8601", "unix_microsecond"),
+				},
+			},
+			"default_load_shedding_policy": schema.StringAttribute{
+				Description: "Load shedding policy for new sink consumers that don't set one: pause_on_full, discard_on_full.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("pause_on_full", "discard_on_full"),
+				},
+			},
+			"notification_email": schema.StringAttribute{
+				Description: "Email address for account notifications such as failing sinks.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the resource
+func (r *AccountSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create applies the configured account settings
+func (r *AccountSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_account_settings", "create")
+
+	var data AccountSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateAccountSettings(ctx, buildAccountSettingsRequest(&data, false))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Configuring Account Settings",
+			"Could not update account settings: "+err.Error(),
+		)
+		return
+	}
+
+	mapAccountSettingsToModel(updated, &data)
+
+	privateState := &resourcePrivateState{ETag: updated.ETag}
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Info(ctx, "Configured account settings")
+}
+
+// Read refreshes the Terraform state with the latest data from the API
+func (r *AccountSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = traceOperation(ctx, "sequin_account_settings", "read")
+
+	var data AccountSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetAccountSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account Settings",
+			"Could not read account settings: "+err.Error(),
+		)
+		return
+	}
+
+	mapAccountSettingsToModel(settings, &data)
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	privateState.recordVersion(settings)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update applies changed account settings
+func (r *AccountSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = traceOperation(ctx, "sequin_account_settings", "update")
+
+	var plan, state AccountSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Removing notification_email from configuration clears it remotely
+	clearEmail := plan.NotificationEmail.IsNull() && !state.NotificationEmail.IsNull()
+	updateReq := buildAccountSettingsRequest(&plan, clearEmail)
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	updated, err := updateWithConflictRetry(ctx, accountSettingsID, privateState,
+		func(ctx context.Context) (*client.AccountSettingsResponse, error) {
+			return r.client.GetAccountSettings(ctx)
+		},
+		func(ctx context.Context) (*client.AccountSettingsResponse, error) {
+			return r.client.UpdateAccountSettings(ctx, updateReq)
+		},
+	)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating Account Settings", "Could not update account settings", err)
+		return
+	}
+
+	mapAccountSettingsToModel(updated, &plan)
+
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Updated account settings")
+}
+
+// Delete removes the settings from state. The account keeps its current
+// settings since there is nothing to delete.
+func (r *AccountSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removed account settings from state; remote settings left unchanged")
+}
+
+// ImportState imports the existing account settings.
+// Import format: any value, e.g. "account"
+func (r *AccountSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accountSettingsID)...)
+}
+
+// ModifyPlan checks a newly configured timestamp format against the features
+// the Sequin server supports
+func (r *AccountSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("default_timestamp_format"), &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("default_timestamp_format"), &prior)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plannedChange(planned, prior) && planned.ValueString() == "unix_microsecond" {
+		requireServerFeature(r.client, client.FeatureUnixMicrosecondTimestamps, `default_timestamp_format = "unix_microsecond"`, path.Root("default_timestamp_format"), &resp.Diagnostics)
+	}
+}
+
+// buildAccountSettingsRequest converts the model into an API request. Unset
+// attributes are omitted so the API keeps their current values.
+func buildAccountSettingsRequest(data *AccountSettingsResourceModel, clearEmail bool) *client.AccountSettingsRequest {
+	req := &client.AccountSettingsRequest{}
+	if !data.DefaultTimestampFormat.IsNull() && !data.DefaultTimestampFormat.IsUnknown() {
+		req.DefaultTimestampFormat = data.DefaultTimestampFormat.ValueString()
+	}
+	if !data.DefaultLoadSheddingPolicy.IsNull() && !data.DefaultLoadSheddingPolicy.IsUnknown() {
+		req.DefaultLoadSheddingPolicy = data.DefaultLoadSheddingPolicy.ValueString()
+	}
+	if !data.NotificationEmail.IsNull() && !data.NotificationEmail.IsUnknown() {
+		email := data.NotificationEmail.ValueString()
+		req.NotificationEmail = &email
+	} else if clearEmail {
+		empty := ""
+		req.NotificationEmail = &empty
+	}
+	return req
+}
+
+// mapAccountSettingsToModel maps the API response to the Terraform model
+func mapAccountSettingsToModel(settings *client.AccountSettingsResponse, data *AccountSettingsResourceModel) {
+	data.ID = types.StringValue(accountSettingsID)
+	data.DefaultTimestampFormat = types.StringValue(settings.DefaultTimestampFormat)
+	data.DefaultLoadSheddingPolicy = types.StringValue(settings.DefaultLoadSheddingPolicy)
+	data.NotificationEmail = optionalString(settings.NotificationEmail)
+}
@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAccountSettingsResource_Metadata(t *testing.T) {
+	r := NewAccountSettingsResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_account_settings" {
+		t.Errorf("TypeName = %q, want sequin_account_settings", resp.TypeName)
+	}
+}
+
+func TestAccountSettingsResource_Schema(t *testing.T) {
+	r := NewAccountSettingsResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "default_timestamp_format", "default_load_shedding_policy", "notification_email"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestBuildAccountSettingsRequest(t *testing.T) {
+	data := &AccountSettingsResourceModel{
+		DefaultTimestampFormat:    types.StringValue("unix_microsecond"),
+		DefaultLoadSheddingPolicy: types.StringUnknown(),
+		NotificationEmail:         types.StringNull(),
+	}
+
+	req := buildAccountSettingsRequest(data, false)
+	if req.DefaultTimestampFormat != "unix_microsecond" {
+		t.Errorf("DefaultTimestampFormat = %q, want unix_microsecond", req.DefaultTimestampFormat)
+	}
+	if req.DefaultLoadSheddingPolicy != "" {
+		t.Error("unknown default_load_shedding_policy should be omitted")
+	}
+	if req.NotificationEmail != nil {
+		t.Error("null notification_email should be omitted")
+	}
+
+	req = buildAccountSettingsRequest(data, true)
+	if req.NotificationEmail == nil || *req.NotificationEmail != "" {
+		t.Error("removed notification_email should be cleared with an empty string")
+	}
+}
+
+func TestMapAccountSettingsToModel(t *testing.T) {
+	var data AccountSettingsResourceModel
+
+	mapAccountSettingsToModel(&client.AccountSettingsResponse{
+		DefaultTimestampFormat:    "iso8601",
+		DefaultLoadSheddingPolicy: "pause_on_full",
+	}, &data)
+
+	if data.ID.ValueString() != accountSettingsID {
+		t.Errorf("ID = %q, want %q", data.ID.ValueString(), accountSettingsID)
+	}
+	if data.DefaultLoadSheddingPolicy.ValueString() != "pause_on_full" {
+		t.Errorf("DefaultLoadSheddingPolicy = %q, want pause_on_full", data.DefaultLoadSheddingPolicy.ValueString())
+	}
+	if !data.NotificationEmail.IsNull() {
+		t.Error("empty notification_email should be null")
+	}
+}
@@ -0,0 +1,338 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ resource.Resource                = &AlertResource{}
+	_ resource.ResourceWithConfigure   = &AlertResource{}
+	_ resource.ResourceWithImportState = &AlertResource{}
+)
+
+// AlertResource defines the resource implementation
+type AlertResource struct {
+	client client.SequinAPI
+}
+
+// AlertResourceModel describes the resource data model
+type AlertResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	SinkConsumer         types.String `tfsdk:"sink_consumer"`
+	Condition            types.String `tfsdk:"condition"`
+	Threshold            types.Int64  `tfsdk:"threshold"`
+	DurationSeconds      types.Int64  `tfsdk:"duration_seconds"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	NotificationChannels types.List   `tfsdk:"notification_channels"`
+}
+
+// NewAlertResource creates a new resource
+func NewAlertResource() resource.Resource {
+	return &AlertResource{}
+}
+
+// Metadata returns the resource type name
+func (r *AlertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+// Schema defines the resource schema
+func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Sequin alert that fires when sink consumers have failing messages or fall behind.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the alert.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the alert.",
+				Required:    true,
+			},
+			"sink_consumer": schema.StringAttribute{
+				Description: "Name or ID of the sink consumer to watch. Watches every sink consumer when omitted.",
+				Optional:    true,
+			},
+			"condition": schema.StringAttribute{
+				Description: "What the alert watches: failing_messages (number of messages failing delivery), consumer_lag (age of the oldest undelivered change, in seconds).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("failing_messages", "consumer_lag"),
+				},
+			},
+			"threshold": schema.Int64Attribute{
+				Description: "Value the condition must reach for the alert to fire: a message count for failing_messages, seconds for consumer_lag.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Description: "How long the threshold must be exceeded before the alert fires. Uses the server default when omitted.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the alert is evaluated.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notification_channels": schema.ListAttribute{
+				Description: "IDs of the notification channels the alert notifies, e.g. sequin_notification_channel IDs.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the resource
+func (r *AlertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new alert
+func (r *AlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_alert", "create")
+
+	var data AlertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := buildAlertRequest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateAlert(ctx, createReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Creating Alert", "Could not create alert", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapAlertToModel(ctx, created, &data)...)
+
+	privateState := &resourcePrivateState{ETag: created.ETag}
+	privateState.markCreated()
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	rollbackCreate(ctx, r.client.CreateRollbackSkipped(), "alert", created.ID, &resp.Diagnostics, &resp.State, func(ctx context.Context) error {
+		return r.client.DeleteAlert(ctx, created.ID)
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created alert resource", map[string]any{"id": data.ID.ValueString()})
+}
+
+// Read refreshes the Terraform state with the latest data from the API
+func (r *AlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = traceOperation(ctx, "sequin_alert", "read")
+
+	var data AlertResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	alertID := data.ID.ValueString()
+	alert, err := getWithCreateGrace(ctx, privateState, alertID, func() (*client.AlertResponse, error) {
+		return r.client.GetAlert(ctx, alertID)
+	})
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Alert not found, removing from state", map[string]any{"id": alertID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Alert",
+			"Could not read alert ID "+alertID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapAlertToModel(ctx, alert, &data)...)
+
+	privateState.recordVersion(alert)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates an existing alert
+func (r *AlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = traceOperation(ctx, "sequin_alert", "update")
+
+	var plan, state AlertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := buildAlertRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertID := state.ID.ValueString()
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	updated, err := updateWithConflictRetry(ctx, alertID, privateState,
+		func(ctx context.Context) (*client.AlertResponse, error) {
+			return r.client.GetAlert(ctx, alertID)
+		},
+		func(ctx context.Context) (*client.AlertResponse, error) {
+			return r.client.UpdateAlert(ctx, alertID, updateReq)
+		},
+	)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating Alert", "Could not update alert ID "+alertID, err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapAlertToModel(ctx, updated, &plan)...)
+
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Updated alert resource", map[string]any{"id": alertID})
+}
+
+// Delete deletes an alert
+func (r *AlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = traceOperation(ctx, "sequin_alert", "delete")
+
+	var data AlertResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertID := data.ID.ValueString()
+	if err := r.client.DeleteAlert(ctx, alertID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Alert",
+			"Could not delete alert ID "+alertID+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted alert", map[string]any{"id": alertID})
+}
+
+// ImportState imports an existing alert by ID
+func (r *AlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// buildAlertRequest converts the model into an API request
+func buildAlertRequest(ctx context.Context, data *AlertResourceModel, diags *diag.Diagnostics) *client.AlertRequest {
+	req := &client.AlertRequest{
+		Name:                 data.Name.ValueString(),
+		SinkConsumer:         data.SinkConsumer.ValueString(),
+		Condition:            data.Condition.ValueString(),
+		Threshold:            data.Threshold.ValueInt64(),
+		NotificationChannels: []string{},
+	}
+	if !data.DurationSeconds.IsNull() && !data.DurationSeconds.IsUnknown() {
+		duration := data.DurationSeconds.ValueInt64()
+		req.DurationSeconds = &duration
+	}
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		enabled := data.Enabled.ValueBool()
+		req.Enabled = &enabled
+	}
+	if !data.NotificationChannels.IsNull() && !data.NotificationChannels.IsUnknown() {
+		diags.Append(data.NotificationChannels.ElementsAs(ctx, &req.NotificationChannels, false)...)
+	}
+	return req
+}
+
+// mapAlertToModel maps the API response to the Terraform model
+func mapAlertToModel(ctx context.Context, alert *client.AlertResponse, data *AlertResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(alert.ID)
+	data.Name = types.StringValue(alert.Name)
+	data.Condition = types.StringValue(alert.Condition)
+	data.Threshold = types.Int64Value(alert.Threshold)
+	data.DurationSeconds = types.Int64Value(alert.DurationSeconds)
+	data.Enabled = types.BoolValue(alert.Enabled)
+
+	// Keep the configured name or ID; the API may echo back either form.
+	// Only fill it in when empty, e.g. after import.
+	if data.SinkConsumer.IsNull() || data.SinkConsumer.IsUnknown() || data.SinkConsumer.ValueString() == "" {
+		data.SinkConsumer = optionalString(alert.SinkConsumer)
+	}
+
+	// An explicitly empty list stays empty rather than flipping to null
+	switch {
+	case len(alert.NotificationChannels) > 0:
+		list, d := types.ListValueFrom(ctx, types.StringType, alert.NotificationChannels)
+		diags.Append(d...)
+		data.NotificationChannels = list
+	case data.NotificationChannels.IsNull():
+		data.NotificationChannels = types.ListNull(types.StringType)
+	default:
+		data.NotificationChannels = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	return diags
+}
@@ -0,0 +1,204 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/clintdigital/terraform-provider-sequin/internal/client/clienttest"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAlertResource_Metadata(t *testing.T) {
+	r := NewAlertResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_alert" {
+		t.Errorf("TypeName = %q, want sequin_alert", resp.TypeName)
+	}
+}
+
+func TestAlertResource_Schema(t *testing.T) {
+	r := NewAlertResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "name", "sink_consumer", "condition", "threshold", "duration_seconds", "enabled", "notification_channels"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestBuildAlertRequest(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	req := buildAlertRequest(ctx, &AlertResourceModel{
+		Name:            types.StringValue("consumer-lag"),
+		SinkConsumer:    types.StringNull(),
+		Condition:       types.StringValue("consumer_lag"),
+		Threshold:       types.Int64Value(600),
+		DurationSeconds: types.Int64Unknown(),
+		Enabled:         types.BoolValue(false),
+		NotificationChannels: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("nch-1"),
+		}),
+	}, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if req.SinkConsumer != "" {
+		t.Errorf("SinkConsumer = %q, want empty for an account-wide alert", req.SinkConsumer)
+	}
+	if req.Threshold != 600 || req.Condition != "consumer_lag" {
+		t.Errorf("req = %+v, want consumer_lag threshold 600", req)
+	}
+	if req.DurationSeconds != nil {
+		t.Error("unknown duration_seconds should be omitted")
+	}
+	if req.Enabled == nil || *req.Enabled {
+		t.Error("enabled = false should be sent explicitly")
+	}
+	if len(req.NotificationChannels) != 1 || req.NotificationChannels[0] != "nch-1" {
+		t.Errorf("NotificationChannels = %v, want [nch-1]", req.NotificationChannels)
+	}
+}
+
+func TestBuildAlertRequest_ClearsNotificationChannels(t *testing.T) {
+	var diags diag.Diagnostics
+
+	req := buildAlertRequest(context.Background(), &AlertResourceModel{
+		NotificationChannels: types.ListNull(types.StringType),
+	}, &diags)
+
+	if req.NotificationChannels == nil || len(req.NotificationChannels) != 0 {
+		t.Errorf("NotificationChannels = %#v, want an empty list so removed channels are cleared", req.NotificationChannels)
+	}
+}
+
+func TestMapAlertToModel_SinkConsumer(t *testing.T) {
+	response := &client.AlertResponse{
+		ID:              "alt-1",
+		Name:            "orders-failing",
+		SinkConsumer:    "9f1c7e2a-sink-id",
+		Condition:       "failing_messages",
+		Threshold:       1,
+		DurationSeconds: 300,
+		Enabled:         true,
+	}
+
+	ctx := context.Background()
+	data := AlertResourceModel{SinkConsumer: types.StringValue("orders")}
+	mapAlertToModel(ctx, response, &data)
+	if data.SinkConsumer.ValueString() != "orders" {
+		t.Errorf("SinkConsumer = %q, want configured name kept", data.SinkConsumer.ValueString())
+	}
+	if data.DurationSeconds.ValueInt64() != 300 {
+		t.Errorf("DurationSeconds = %d, want 300", data.DurationSeconds.ValueInt64())
+	}
+
+	var imported AlertResourceModel
+	mapAlertToModel(ctx, response, &imported)
+	if imported.SinkConsumer.ValueString() != "9f1c7e2a-sink-id" {
+		t.Errorf("SinkConsumer = %q, want API value on import", imported.SinkConsumer.ValueString())
+	}
+
+	var accountWide AlertResourceModel
+	mapAlertToModel(ctx, &client.AlertResponse{ID: "alt-2", Condition: "consumer_lag"}, &accountWide)
+	if !accountWide.SinkConsumer.IsNull() {
+		t.Error("SinkConsumer should be null for an account-wide alert")
+	}
+}
+
+func TestMapAlertToModel_NotificationChannels(t *testing.T) {
+	ctx := context.Background()
+
+	data := AlertResourceModel{NotificationChannels: types.ListValueMust(types.StringType, []attr.Value{})}
+	mapAlertToModel(ctx, &client.AlertResponse{ID: "alt-1"}, &data)
+	if data.NotificationChannels.IsNull() || len(data.NotificationChannels.Elements()) != 0 {
+		t.Error("an explicitly empty list should stay empty")
+	}
+
+	var unset AlertResourceModel
+	mapAlertToModel(ctx, &client.AlertResponse{ID: "alt-1", NotificationChannels: []string{"nch-1", "nch-2"}}, &unset)
+	if len(unset.NotificationChannels.Elements()) != 2 {
+		t.Errorf("NotificationChannels = %v, want both channels", unset.NotificationChannels)
+	}
+}
+
+func readAlertWithMock(t *testing.T, mock *clienttest.Mock) *resource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+	r := &AlertResource{client: mock}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	prior := AlertResourceModel{
+		ID:                   types.StringValue("alt-1"),
+		Name:                 types.StringValue("orders-failing"),
+		SinkConsumer:         types.StringNull(),
+		Condition:            types.StringValue("failing_messages"),
+		Threshold:            types.Int64Value(1),
+		DurationSeconds:      types.Int64Value(60),
+		Enabled:              types.BoolValue(true),
+		NotificationChannels: types.ListNull(types.StringType),
+	}
+	if diags := state.Set(ctx, &prior); diags.HasError() {
+		t.Fatalf("State.Set() errors: %v", diags.Errors())
+	}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	return resp
+}
+
+func TestAlertResource_Read(t *testing.T) {
+	mock := &clienttest.Mock{
+		GetAlertFunc: func(ctx context.Context, id string) (*client.AlertResponse, error) {
+			return &client.AlertResponse{ID: id, Name: "orders-failing", Condition: "failing_messages", Threshold: 5, DurationSeconds: 300, Enabled: true}, nil
+		},
+	}
+
+	resp := readAlertWithMock(t, mock)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() errors: %v", resp.Diagnostics.Errors())
+	}
+	var data AlertResourceModel
+	resp.State.Get(context.Background(), &data)
+	if data.Threshold.ValueInt64() != 5 || data.DurationSeconds.ValueInt64() != 300 {
+		t.Errorf("state = %+v, want the API's threshold and duration", data)
+	}
+	if calls := mock.Calls(); len(calls) != 1 || calls[0] != "GetAlert" {
+		t.Errorf("calls = %v, want a single GetAlert", calls)
+	}
+}
+
+func TestAlertResource_Read_NotFound(t *testing.T) {
+	mock := &clienttest.Mock{
+		GetAlertFunc: func(ctx context.Context, id string) (*client.AlertResponse, error) {
+			return nil, &client.APIError{StatusCode: 404, Body: "alert not found"}
+		},
+	}
+
+	resp := readAlertWithMock(t, mock)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() errors: %v", resp.Diagnostics.Errors())
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("an alert deleted outside Terraform should be removed from state")
+	}
+}
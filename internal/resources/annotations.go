@@ -0,0 +1,106 @@
+package resources
+
+import (
+	"context"
+	"maps"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// annotationsAttribute returns the schema of the annotations attribute of
+// resources that carry ownership metadata
+func annotationsAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: "Key-value metadata stored with the resource in Sequin, such as the owning team. Merged over the provider's default_annotations.",
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+}
+
+// annotationsAllAttribute returns the schema of the annotations_all attribute
+// holding the annotations actually sent to Sequin
+func annotationsAllAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: "Annotations of the resource including the provider's default_annotations.",
+		Computed:    true,
+		ElementType: types.StringType,
+	}
+}
+
+// defaultAnnotations returns the provider's default annotations
+func defaultAnnotations(c client.SequinAPI) map[string]string {
+	if c == nil {
+		return nil
+	}
+	return c.DefaultAnnotationValues()
+}
+
+// mergeAnnotations returns the provider defaults overlaid with the configured
+// annotations. The result is never nil, so removing the last annotation
+// clears them in Sequin.
+func mergeAnnotations(defaults map[string]string, configured types.Map) map[string]string {
+	merged := make(map[string]string, len(defaults))
+	maps.Copy(merged, defaults)
+	for key, value := range configured.Elements() {
+		if s, ok := value.(types.String); ok {
+			merged[key] = s.ValueString()
+		}
+	}
+	return merged
+}
+
+// planAnnotations plans annotations_all from the configured annotations and
+// the provider defaults, so changing default_annotations shows up as an
+// update of every resource it applies to
+func planAnnotations(ctx context.Context, c client.SequinAPI, resp *resource.ModifyPlanResponse) {
+	var annotations types.Map
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("annotations"), &annotations)...)
+	if resp.Diagnostics.HasError() || annotations.IsUnknown() {
+		return
+	}
+
+	all, diags := types.MapValueFrom(ctx, types.StringType, mergeAnnotations(defaultAnnotations(c), annotations))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("annotations_all"), all)...)
+}
+
+// mapAnnotations sets annotations_all to the annotations Sequin holds and
+// annotations to those not supplied by the provider defaults. A default key
+// stays in annotations when it was configured on the resource. Servers that
+// do not return annotations leave both as they were.
+func mapAnnotations(ctx context.Context, remote, defaults map[string]string, annotations, all *types.Map, diags *diag.Diagnostics) {
+	if remote == nil {
+		if all.IsUnknown() {
+			*all = types.MapValueMust(types.StringType, map[string]attr.Value{})
+		}
+		return
+	}
+
+	configured := annotations.Elements()
+	own := map[string]attr.Value{}
+	for key, value := range remote {
+		_, isConfigured := configured[key]
+		defaultValue, isDefault := defaults[key]
+		if isConfigured || !isDefault || defaultValue != value {
+			own[key] = types.StringValue(value)
+		}
+	}
+
+	if len(own) == 0 && (annotations.IsNull() || annotations.IsUnknown()) {
+		*annotations = types.MapNull(types.StringType)
+	} else {
+		value, d := types.MapValue(types.StringType, own)
+		diags.Append(d...)
+		*annotations = value
+	}
+
+	value, d := types.MapValueFrom(ctx, types.StringType, remote)
+	diags.Append(d...)
+	*all = value
+}
@@ -0,0 +1,143 @@
+package resources
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client/clienttest"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// annotationsMap returns a map value of the given annotations
+func annotationsMap(values map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func TestMergeAnnotations(t *testing.T) {
+	defaults := map[string]string{"team": "data-eng", "env": "prod"}
+
+	got := mergeAnnotations(defaults, annotationsMap(map[string]string{"team": "payments", "tier": "1"}))
+	want := map[string]string{"team": "payments", "env": "prod", "tier": "1"}
+	if !maps.Equal(got, want) {
+		t.Errorf("mergeAnnotations() = %v, want %v", got, want)
+	}
+	if defaults["team"] != "data-eng" {
+		t.Error("mergeAnnotations() must not modify the provider defaults")
+	}
+
+	if got := mergeAnnotations(nil, types.MapNull(types.StringType)); got == nil || len(got) != 0 {
+		t.Errorf("mergeAnnotations() = %#v, want an empty map so removed annotations are cleared", got)
+	}
+}
+
+func TestMapAnnotations(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"team": "data-eng", "env": "prod"}
+
+	tests := map[string]struct {
+		remote     map[string]string
+		configured types.Map
+		want       types.Map
+	}{
+		"defaults only": {
+			remote:     map[string]string{"team": "data-eng", "env": "prod"},
+			configured: types.MapNull(types.StringType),
+			want:       types.MapNull(types.StringType),
+		},
+		"overridden default": {
+			remote:     map[string]string{"team": "payments", "env": "prod"},
+			configured: annotationsMap(map[string]string{"team": "payments"}),
+			want:       annotationsMap(map[string]string{"team": "payments"}),
+		},
+		"configured with the default value": {
+			remote:     map[string]string{"team": "data-eng", "env": "prod"},
+			configured: annotationsMap(map[string]string{"env": "prod"}),
+			want:       annotationsMap(map[string]string{"env": "prod"}),
+		},
+		"added outside terraform": {
+			remote:     map[string]string{"team": "data-eng", "env": "prod", "oncall": "alice"},
+			configured: types.MapNull(types.StringType),
+			want:       annotationsMap(map[string]string{"oncall": "alice"}),
+		},
+		"default changed outside terraform": {
+			remote:     map[string]string{"team": "data-eng", "env": "staging"},
+			configured: types.MapNull(types.StringType),
+			want:       annotationsMap(map[string]string{"env": "staging"}),
+		},
+		"empty map kept": {
+			remote:     map[string]string{"team": "data-eng", "env": "prod"},
+			configured: annotationsMap(nil),
+			want:       annotationsMap(nil),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			annotations, all := tt.configured, types.MapUnknown(types.StringType)
+			var diags diag.Diagnostics
+			mapAnnotations(ctx, tt.remote, defaults, &annotations, &all, &diags)
+			if diags.HasError() {
+				t.Fatalf("mapAnnotations() errors: %v", diags.Errors())
+			}
+			if !annotations.Equal(tt.want) {
+				t.Errorf("annotations = %s, want %s", annotations, tt.want)
+			}
+			if !all.Equal(annotationsMap(tt.remote)) {
+				t.Errorf("annotations_all = %s, want everything Sequin holds", all)
+			}
+		})
+	}
+}
+
+func TestMapAnnotations_NotReturned(t *testing.T) {
+	annotations := annotationsMap(map[string]string{"team": "payments"})
+	all := annotationsMap(map[string]string{"team": "payments", "env": "prod"})
+	var diags diag.Diagnostics
+	mapAnnotations(context.Background(), nil, map[string]string{"env": "prod"}, &annotations, &all, &diags)
+
+	if !annotations.Equal(annotationsMap(map[string]string{"team": "payments"})) || all.IsUnknown() || len(all.Elements()) != 2 {
+		t.Errorf("annotations = %s, annotations_all = %s, want both kept when the server does not return annotations", annotations, all)
+	}
+}
+
+func TestPlanAnnotations(t *testing.T) {
+	ctx := context.Background()
+	mock := &clienttest.Mock{DefaultAnnotations: map[string]string{"team": "data-eng", "env": "prod"}}
+	r := &SinkConsumerResource{client: mock}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	model := newRoundTripPlan(t, "webhook", map[string]attr.Value{
+		"http_endpoint": types.StringValue("https://api.example.com"),
+	})
+	model.Annotations = annotationsMap(map[string]string{"team": "payments"})
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("building plan: %v", diags.Errors())
+	}
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw}}
+	planAnnotations(ctx, mock, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("planAnnotations() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	var all types.Map
+	resp.Plan.GetAttribute(ctx, path.Root("annotations_all"), &all)
+	if want := annotationsMap(map[string]string{"team": "payments", "env": "prod"}); !all.Equal(want) {
+		t.Errorf("planned annotations_all = %s, want %s", all, want)
+	}
+}
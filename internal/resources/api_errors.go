@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// addAPIError reports a failed API call. When the API rejected individual
+// fields, each problem is reported on the matching attribute so Terraform
+// points at the offending configuration instead of quoting the raw response.
+// Updates rejected because the resource kept changing since it was read are
+// reported as such.
+func addAPIError(diags *diag.Diagnostics, summary, detail string, err error) {
+	var stale *staleUpdateError
+	if client.IsPreconditionFailedError(err) || errors.As(err, &stale) {
+		diags.AddError(
+			"Resource Modified Outside Terraform",
+			detail+": it kept changing, for example in the Sequin console, after Terraform last read it, so the update was "+
+				"not applied to avoid overwriting those changes. Run terraform plan again to review them, then apply.",
+		)
+		return
+	}
+
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.ValidationErrors) == 0 {
+		diags.AddError(summary, detail+": "+err.Error())
+		return
+	}
+
+	requestID := ""
+	if apiErr.RequestID != "" {
+		requestID = " (request ID: " + apiErr.RequestID + ")"
+	}
+	for _, field := range apiErr.Fields() {
+		diags.AddAttributeError(
+			validationErrorPath(field),
+			summary,
+			detail+": "+field+" "+strings.Join(apiErr.ValidationErrors[field], ", ")+requestID,
+		)
+	}
+}
+
+// validationErrorPath converts a dotted API field such as destination.hosts or
+// tables.0.name into an attribute path
+func validationErrorPath(field string) path.Path {
+	parts := strings.Split(field, ".")
+	p := path.Root(parts[0])
+	for _, part := range parts[1:] {
+		if index, err := strconv.Atoi(part); err == nil {
+			p = p.AtListIndex(index)
+		} else {
+			p = p.AtName(part)
+		}
+	}
+	return p
+}
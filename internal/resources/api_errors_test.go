@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestAddAPIError_ValidationErrors(t *testing.T) {
+	err := fmt.Errorf("failed to create sink consumer: %w", &client.APIError{
+		StatusCode: 422,
+		RequestID:  "req-7",
+		Summary:    "Validation failed",
+		ValidationErrors: map[string][]string{
+			"name":              {"has already been taken"},
+			"destination.hosts": {"can't be blank"},
+			"tables.1.name":     {"does not exist"},
+		},
+	})
+
+	var diags diag.Diagnostics
+	addAPIError(&diags, "Error Creating Sink Consumer", "Could not create sink consumer", err)
+
+	want := map[string]string{
+		path.Root("destination").AtName("hosts").String():          "destination.hosts can't be blank",
+		path.Root("name").String():                                 "name has already been taken",
+		path.Root("tables").AtListIndex(1).AtName("name").String(): "tables.1.name does not exist",
+	}
+	if len(diags) != len(want) {
+		t.Fatalf("diagnostics = %d, want one per field: %v", len(diags), diags)
+	}
+	for _, d := range diags {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok {
+			t.Fatalf("diagnostic %q has no attribute path", d.Detail())
+		}
+		message, ok := want[withPath.Path().String()]
+		if !ok {
+			t.Errorf("unexpected diagnostic path %s", withPath.Path())
+			continue
+		}
+		if !strings.Contains(d.Detail(), message) || !strings.Contains(d.Detail(), "req-7") {
+			t.Errorf("detail = %q, want it to contain %q and the request ID", d.Detail(), message)
+		}
+	}
+}
+
+func TestAddAPIError_Plain(t *testing.T) {
+	var diags diag.Diagnostics
+	addAPIError(&diags, "Error Creating Database", "Could not create database", errors.New("request failed: connection refused"))
+
+	if len(diags) != 1 || diags[0].Detail() != "Could not create database: request failed: connection refused" {
+		t.Errorf("diagnostics = %v, want the error appended to the detail", diags)
+	}
+	if _, ok := diags[0].(diag.DiagnosticWithPath); ok {
+		t.Error("errors without validation fields should not point at an attribute")
+	}
+}
+
+func TestAddAPIError_PreconditionFailed(t *testing.T) {
+	err := fmt.Errorf("failed to update alert: %w", &client.APIError{StatusCode: 412, Body: "etag mismatch"})
+
+	var diags diag.Diagnostics
+	addAPIError(&diags, "Error Updating Alert", "Could not update alert ID alt-1", err)
+
+	if len(diags) != 1 || diags[0].Summary() != "Resource Modified Outside Terraform" {
+		t.Fatalf("diagnostics = %v, want a single conflict diagnostic", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), "alt-1") {
+		t.Errorf("detail = %q, want the resource named", diags[0].Detail())
+	}
+}
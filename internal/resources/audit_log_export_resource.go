@@ -0,0 +1,417 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// httpURLPattern matches http and https URLs
+var httpURLPattern = regexp.MustCompile(`^https?://\S+$`)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ resource.Resource                = &AuditLogExportResource{}
+	_ resource.ResourceWithConfigure   = &AuditLogExportResource{}
+	_ resource.ResourceWithImportState = &AuditLogExportResource{}
+)
+
+// AuditLogExportResource defines the resource implementation
+type AuditLogExportResource struct {
+	client client.SequinAPI
+}
+
+// AuditLogExportResourceModel describes the resource data model
+type AuditLogExportResourceModel struct {
+	ID          types.String                    `tfsdk:"id"`
+	Name        types.String                    `tfsdk:"name"`
+	Enabled     types.Bool                      `tfsdk:"enabled"`
+	Destination *AuditLogExportDestinationModel `tfsdk:"destination"`
+}
+
+// AuditLogExportDestinationModel describes where audit logs are shipped
+type AuditLogExportDestinationModel struct {
+	Type            types.String `tfsdk:"type"`
+	Bucket          types.String `tfsdk:"bucket"`
+	Region          types.String `tfsdk:"region"`
+	Prefix          types.String `tfsdk:"prefix"`
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	EndpointURL     types.String `tfsdk:"endpoint_url"`
+	ForcePathStyle  types.Bool   `tfsdk:"force_path_style"`
+	URL             types.String `tfsdk:"url"`
+	Headers         types.Map    `tfsdk:"headers"`
+}
+
+// NewAuditLogExportResource creates a new resource
+func NewAuditLogExportResource() resource.Resource {
+	return &AuditLogExportResource{}
+}
+
+// Metadata returns the resource type name
+func (r *AuditLogExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log_export"
+}
+
+// Schema defines the resource schema
+func (r *AuditLogExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configures where Sequin ships its audit logs, such as an S3 bucket or an HTTP endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the audit log export.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the audit log export.",
+				Required:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether audit logs are shipped to the destination.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destination": schema.SingleNestedAttribute{
+				Description: "Where audit logs are shipped.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Destination type: s3, http. Forces replacement on change.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("s3", "http"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"bucket": schema.StringAttribute{
+						Description: "S3 bucket name (s3).",
+						Optional:    true,
+					},
+					"region": schema.StringAttribute{
+						Description: "AWS region of the bucket (s3).",
+						Optional:    true,
+					},
+					"prefix": schema.StringAttribute{
+						Description: "Key prefix for audit log objects (s3).",
+						Optional:    true,
+					},
+					"access_key_id": schema.StringAttribute{
+						Description: "AWS access key ID (s3).",
+						Optional:    true,
+					},
+					"secret_access_key": schema.StringAttribute{
+						Description: "AWS secret access key (s3).",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"endpoint_url": schema.StringAttribute{
+						Description: "Endpoint of an S3-compatible service such as MinIO or Cloudflare R2, e.g. https://<account>.r2.cloudflarestorage.com. Uses AWS S3 when omitted (s3).",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(httpURLPattern, "must be an http:// or https:// URL"),
+						},
+					},
+					"force_path_style": schema.BoolAttribute{
+						Description: "Address the bucket in the URL path (https://endpoint/bucket/key) rather than as a subdomain, as most MinIO deployments require (s3).",
+						Optional:    true,
+					},
+					"url": schema.StringAttribute{
+						Description: "URL audit log batches are POSTed to (http).",
+						Optional:    true,
+					},
+					"headers": schema.MapAttribute{
+						Description: "Headers sent with every request, e.g. Authorization (http).",
+						Optional:    true,
+						Sensitive:   true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the resource
+func (r *AuditLogExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new audit log export
+func (r *AuditLogExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_audit_log_export", "create")
+
+	var data AuditLogExportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := buildAuditLogExportRequest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateAuditLogExport(ctx, createReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Creating Audit Log Export", "Could not create audit log export", err)
+		return
+	}
+
+	mapAuditLogExportToModel(created, &data)
+
+	privateState := &resourcePrivateState{ETag: created.ETag}
+	privateState.markCreated()
+	privateState.recordSecrets(auditLogExportSecrets(&data), created.CredentialFingerprint)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	rollbackCreate(ctx, r.client.CreateRollbackSkipped(), "audit log export", created.ID, &resp.Diagnostics, &resp.State, func(ctx context.Context) error {
+		return r.client.DeleteAuditLogExport(ctx, created.ID)
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created audit log export resource", map[string]any{"id": data.ID.ValueString()})
+}
+
+// Read refreshes the Terraform state with the latest data from the API
+func (r *AuditLogExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = traceOperation(ctx, "sequin_audit_log_export", "read")
+
+	var data AuditLogExportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	exportID := data.ID.ValueString()
+	export, err := getWithCreateGrace(ctx, privateState, exportID, func() (*client.AuditLogExportResponse, error) {
+		return r.client.GetAuditLogExport(ctx, exportID)
+	})
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Audit log export not found, removing from state", map[string]any{"id": exportID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Audit Log Export",
+			"Could not read audit log export ID "+exportID+": "+err.Error(),
+		)
+		return
+	}
+
+	mapAuditLogExportToModel(export, &data)
+
+	privateState.recordVersion(export)
+
+	// Credentials are write-only, so out-of-band rotation is only visible
+	// through the server fingerprint
+	if privateState.secretsDrifted(auditLogExportSecrets(&data), export.CredentialFingerprint) {
+		resp.Diagnostics.AddWarning(
+			"Audit Log Export Credential Drift Detected",
+			"The credentials for audit log export ID "+exportID+" were changed outside of Terraform. "+
+				"The configured credentials will be re-applied on the next apply.",
+		)
+		data.Destination.SecretAccessKey = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates an existing audit log export
+func (r *AuditLogExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = traceOperation(ctx, "sequin_audit_log_export", "update")
+
+	var plan, state AuditLogExportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := buildAuditLogExportRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exportID := state.ID.ValueString()
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	updated, err := updateWithConflictRetry(ctx, exportID, privateState,
+		func(ctx context.Context) (*client.AuditLogExportResponse, error) {
+			return r.client.GetAuditLogExport(ctx, exportID)
+		},
+		func(ctx context.Context) (*client.AuditLogExportResponse, error) {
+			return r.client.UpdateAuditLogExport(ctx, exportID, updateReq)
+		},
+	)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating Audit Log Export", "Could not update audit log export ID "+exportID, err)
+		return
+	}
+
+	mapAuditLogExportToModel(updated, &plan)
+
+	privateState.recordSecrets(auditLogExportSecrets(&plan), updated.CredentialFingerprint)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Updated audit log export resource", map[string]any{"id": exportID})
+}
+
+// Delete deletes an audit log export
+func (r *AuditLogExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = traceOperation(ctx, "sequin_audit_log_export", "delete")
+
+	var data AuditLogExportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exportID := data.ID.ValueString()
+	if err := r.client.DeleteAuditLogExport(ctx, exportID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Audit Log Export",
+			"Could not delete audit log export ID "+exportID+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted audit log export", map[string]any{"id": exportID})
+}
+
+// ImportState imports an existing audit log export by ID
+func (r *AuditLogExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// buildAuditLogExportRequest converts the model into an API request
+func buildAuditLogExportRequest(ctx context.Context, data *AuditLogExportResourceModel, diags *diag.Diagnostics) *client.AuditLogExportRequest {
+	req := &client.AuditLogExportRequest{
+		Name: data.Name.ValueString(),
+	}
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		enabled := data.Enabled.ValueBool()
+		req.Enabled = &enabled
+	}
+
+	dest := data.Destination
+	if dest == nil {
+		return req
+	}
+	req.Destination = client.AuditLogExportDestination{
+		Type:            dest.Type.ValueString(),
+		Bucket:          dest.Bucket.ValueString(),
+		Region:          dest.Region.ValueString(),
+		Prefix:          dest.Prefix.ValueString(),
+		AccessKeyID:     dest.AccessKeyID.ValueString(),
+		SecretAccessKey: dest.SecretAccessKey.ValueString(),
+		EndpointURL:     dest.EndpointURL.ValueString(),
+		URL:             dest.URL.ValueString(),
+	}
+	if !dest.ForcePathStyle.IsNull() && !dest.ForcePathStyle.IsUnknown() {
+		forcePathStyle := dest.ForcePathStyle.ValueBool()
+		req.Destination.ForcePathStyle = &forcePathStyle
+	}
+	if !dest.Headers.IsNull() && !dest.Headers.IsUnknown() {
+		diags.Append(dest.Headers.ElementsAs(ctx, &req.Destination.Headers, false)...)
+	}
+
+	return req
+}
+
+// mapAuditLogExportToModel maps the API response to the Terraform model.
+// Secret access key and headers are write-only and kept from plan/state.
+func mapAuditLogExportToModel(export *client.AuditLogExportResponse, data *AuditLogExportResourceModel) {
+	data.ID = types.StringValue(export.ID)
+	data.Name = types.StringValue(export.Name)
+	data.Enabled = types.BoolValue(export.Enabled)
+
+	if data.Destination == nil {
+		data.Destination = &AuditLogExportDestinationModel{
+			SecretAccessKey: types.StringNull(),
+			Headers:         types.MapNull(types.StringType),
+			ForcePathStyle:  types.BoolNull(),
+		}
+	}
+	dest := data.Destination
+	apiDest := export.Destination
+	dest.Type = types.StringValue(apiDest.Type)
+	dest.Bucket = optionalString(apiDest.Bucket)
+	dest.Region = optionalString(apiDest.Region)
+	dest.Prefix = optionalString(apiDest.Prefix)
+	dest.AccessKeyID = optionalString(apiDest.AccessKeyID)
+	dest.EndpointURL = optionalString(apiDest.EndpointURL)
+	// The API may report the false default; only keep it if configured
+	if apiDest.ForcePathStyle != nil && (*apiDest.ForcePathStyle || !dest.ForcePathStyle.IsNull()) {
+		dest.ForcePathStyle = types.BoolValue(*apiDest.ForcePathStyle)
+	} else {
+		dest.ForcePathStyle = types.BoolNull()
+	}
+	dest.URL = optionalString(apiDest.URL)
+}
+
+// optionalString maps an empty API string to null so unset optional
+// attributes don't show up as drift
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// auditLogExportSecrets returns the secret values tracked for drift detection
+func auditLogExportSecrets(model *AuditLogExportResourceModel) map[string]string {
+	if model.Destination == nil {
+		return map[string]string{}
+	}
+	return stringSecrets(map[string]types.String{
+		"secret_access_key": model.Destination.SecretAccessKey,
+	})
+}
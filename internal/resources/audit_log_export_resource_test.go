@@ -0,0 +1,195 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAuditLogExportResource_Metadata(t *testing.T) {
+	r := NewAuditLogExportResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_audit_log_export" {
+		t.Errorf("TypeName = %q, want sequin_audit_log_export", resp.TypeName)
+	}
+}
+
+func TestAuditLogExportResource_Schema(t *testing.T) {
+	r := NewAuditLogExportResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "name", "enabled", "destination"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+
+	dest := resp.Schema.Attributes["destination"].(schema.SingleNestedAttribute)
+	if !dest.Attributes["secret_access_key"].(schema.StringAttribute).Sensitive {
+		t.Error("destination.secret_access_key should be sensitive")
+	}
+	if !dest.Attributes["headers"].(schema.MapAttribute).Sensitive {
+		t.Error("destination.headers should be sensitive")
+	}
+}
+
+func TestBuildAuditLogExportRequest(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	req := buildAuditLogExportRequest(ctx, &AuditLogExportResourceModel{
+		Name:    types.StringValue("siem"),
+		Enabled: types.BoolUnknown(),
+		Destination: &AuditLogExportDestinationModel{
+			Type: types.StringValue("http"),
+			URL:  types.StringValue("https://siem.example.com/ingest"),
+			Headers: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Authorization": types.StringValue("Bearer token"),
+			}),
+		},
+	}, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.Enabled != nil {
+		t.Error("unknown enabled should be omitted")
+	}
+	if req.Destination.Type != "http" || req.Destination.URL != "https://siem.example.com/ingest" {
+		t.Errorf("Destination = %+v, want http destination", req.Destination)
+	}
+	if req.Destination.Headers["Authorization"] != "Bearer token" {
+		t.Errorf("Headers = %v, want Authorization header", req.Destination.Headers)
+	}
+}
+
+func TestMapAuditLogExportToModel_PreservesSecrets(t *testing.T) {
+	headers := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Authorization": types.StringValue("Bearer token"),
+	})
+	data := AuditLogExportResourceModel{
+		Destination: &AuditLogExportDestinationModel{
+			SecretAccessKey: types.StringValue("secret"),
+			Headers:         headers,
+		},
+	}
+
+	mapAuditLogExportToModel(&client.AuditLogExportResponse{
+		ID:      "ale-1",
+		Name:    "compliance",
+		Enabled: true,
+		Destination: client.AuditLogExportDestination{
+			Type:   "s3",
+			Bucket: "audit",
+			Region: "us-east-1",
+		},
+	}, &data)
+
+	if data.Destination.SecretAccessKey.ValueString() != "secret" {
+		t.Error("secret_access_key should be kept from plan/state")
+	}
+	if !data.Destination.Headers.Equal(headers) {
+		t.Error("headers should be kept from plan/state")
+	}
+	if data.Destination.Bucket.ValueString() != "audit" {
+		t.Errorf("Bucket = %q, want audit", data.Destination.Bucket.ValueString())
+	}
+	if !data.Destination.Prefix.IsNull() || !data.Destination.URL.IsNull() {
+		t.Error("fields the API leaves empty should be null")
+	}
+}
+
+func TestMapAuditLogExportToModel_Import(t *testing.T) {
+	var data AuditLogExportResourceModel
+
+	mapAuditLogExportToModel(&client.AuditLogExportResponse{
+		ID:          "ale-1",
+		Destination: client.AuditLogExportDestination{Type: "http", URL: "https://siem.example.com"},
+	}, &data)
+
+	if data.Destination == nil {
+		t.Fatal("destination should be populated on import")
+	}
+	if !data.Destination.SecretAccessKey.IsNull() || !data.Destination.Headers.IsNull() {
+		t.Error("write-only fields should be null on import")
+	}
+}
+
+func TestAuditLogExport_S3CompatibleEndpoint(t *testing.T) {
+	var diags diag.Diagnostics
+	data := AuditLogExportResourceModel{
+		Name: types.StringValue("compliance"),
+		Destination: &AuditLogExportDestinationModel{
+			Type:           types.StringValue("s3"),
+			Bucket:         types.StringValue("audit"),
+			Region:         types.StringValue("auto"),
+			EndpointURL:    types.StringValue("https://account.r2.cloudflarestorage.com"),
+			ForcePathStyle: types.BoolValue(false),
+			Headers:        types.MapNull(types.StringType),
+		},
+	}
+
+	req := buildAuditLogExportRequest(context.Background(), &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.Destination.EndpointURL != "https://account.r2.cloudflarestorage.com" {
+		t.Errorf("EndpointURL = %q, want R2 endpoint", req.Destination.EndpointURL)
+	}
+	if req.Destination.ForcePathStyle == nil || *req.Destination.ForcePathStyle {
+		t.Error("force_path_style = false should be sent explicitly")
+	}
+
+	// A configured false survives the round trip; an unset one stays null
+	// when the API reports the default
+	notForced := false
+	response := &client.AuditLogExportResponse{
+		ID: "ale-1",
+		Destination: client.AuditLogExportDestination{
+			Type:           "s3",
+			Bucket:         "audit",
+			EndpointURL:    "https://account.r2.cloudflarestorage.com",
+			ForcePathStyle: &notForced,
+		},
+	}
+	mapAuditLogExportToModel(response, &data)
+	if data.Destination.ForcePathStyle.IsNull() || data.Destination.ForcePathStyle.ValueBool() {
+		t.Error("configured force_path_style = false should be kept")
+	}
+
+	var imported AuditLogExportResourceModel
+	mapAuditLogExportToModel(response, &imported)
+	if !imported.Destination.ForcePathStyle.IsNull() {
+		t.Error("force_path_style should be null when the API reports the default")
+	}
+	if imported.Destination.EndpointURL.ValueString() != "https://account.r2.cloudflarestorage.com" {
+		t.Errorf("EndpointURL = %q, want R2 endpoint", imported.Destination.EndpointURL.ValueString())
+	}
+}
+
+func TestHTTPURLPattern(t *testing.T) {
+	for value, want := range map[string]bool{
+		"https://minio.internal:9000": true,
+		"http://localhost:9000":       true,
+		"minio.internal:9000":         false,
+		"https://":                    false,
+	} {
+		if got := httpURLPattern.MatchString(value); got != want {
+			t.Errorf("httpURLPattern.MatchString(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
@@ -27,7 +27,7 @@ var (
 
 // BackfillResource defines the resource implementation
 type BackfillResource struct {
-	client *client.Client
+	client client.SequinAPI
 }
 
 // BackfillResourceModel describes the resource data model
@@ -37,6 +37,7 @@ type BackfillResourceModel struct {
 	Table        types.String    `tfsdk:"table"`
 	State        types.String    `tfsdk:"state"`
 	Status       *BackfillStatus `tfsdk:"status"`
+	Timeouts     types.Object    `tfsdk:"timeouts"`
 }
 
 // NewBackfillResource creates a new resource
@@ -82,7 +83,7 @@ func (r *BackfillResource) Schema(ctx context.Context, req resource.SchemaReques
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("active", "cancelled"),
+					stringvalidator.OneOf(client.BackfillStates...),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -139,6 +140,7 @@ func (r *BackfillResource) Schema(ctx context.Context, req resource.SchemaReques
 					},
 				},
 			},
+			"timeouts": timeoutsAttribute(),
 		},
 	}
 }
@@ -149,11 +151,11 @@ func (r *BackfillResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.SequinAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -163,6 +165,8 @@ func (r *BackfillResource) Configure(ctx context.Context, req resource.Configure
 
 // Create creates a new backfill resource
 func (r *BackfillResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_backfill", "create")
+
 	var data BackfillResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -170,6 +174,9 @@ func (r *BackfillResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts, operationCreate)
+	defer cancel()
+
 	createReq := &client.BackfillCreateRequest{}
 	if !data.Table.IsNull() && !data.Table.IsUnknown() {
 		createReq.Table = data.Table.ValueString()
@@ -178,21 +185,35 @@ func (r *BackfillResource) Create(ctx context.Context, req resource.CreateReques
 	sinkConsumer := data.SinkConsumer.ValueString()
 	created, err := r.client.CreateBackfill(ctx, sinkConsumer, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Backfill",
-			"Could not create backfill: "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error Creating Backfill", "Could not create backfill", err)
 		return
 	}
 
 	mapBackfillResponseToModel(created, &data)
 
+	privateState := &resourcePrivateState{
+		ETag:      created.ETag,
+		UpdatedAt: created.UpdatedAt,
+	}
+	privateState.markCreated()
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	rollbackCreate(ctx, r.client.CreateRollbackSkipped(), "backfill", created.ID, &resp.Diagnostics, &resp.State, func(ctx context.Context) error {
+		return r.client.DeleteBackfill(ctx, sinkConsumer, created.ID)
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, "Created backfill resource", map[string]any{"id": data.ID.ValueString()})
 }
 
 // Read refreshes the Terraform state with the latest data from the API
 func (r *BackfillResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = traceOperation(ctx, "sequin_backfill", "read")
+
 	var data BackfillResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -200,10 +221,18 @@ func (r *BackfillResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts, operationRead)
+	defer cancel()
+
 	backfillID := data.ID.ValueString()
 	sinkConsumer := data.SinkConsumer.ValueString()
 
-	backfill, err := r.client.GetBackfill(ctx, sinkConsumer, backfillID)
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	backfill, err := getWithCreateGrace(ctx, privateState, backfillID, func() (*client.BackfillResponse, error) {
+		return r.client.GetBackfill(ctx, sinkConsumer, backfillID)
+	})
 	if err != nil {
 		if client.IsNotFoundError(err) {
 			tflog.Warn(ctx, "Backfill not found, removing from state", map[string]any{"id": backfillID})
@@ -219,11 +248,17 @@ func (r *BackfillResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	mapBackfillResponseToModel(backfill, &data)
 
+	privateState.recordVersion(backfill)
+	privateState.UpdatedAt = backfill.UpdatedAt
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Update updates the backfill state (e.g. cancel)
 func (r *BackfillResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = traceOperation(ctx, "sequin_backfill", "update")
+
 	var plan BackfillResourceModel
 	var state BackfillResourceModel
 
@@ -233,6 +268,9 @@ func (r *BackfillResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts, operationUpdate)
+	defer cancel()
+
 	backfillID := state.ID.ValueString()
 	sinkConsumer := state.SinkConsumer.ValueString()
 
@@ -241,23 +279,34 @@ func (r *BackfillResource) Update(ctx context.Context, req resource.UpdateReques
 		updateReq.State = plan.State.ValueString()
 	}
 
-	updated, err := r.client.UpdateBackfill(ctx, sinkConsumer, backfillID, updateReq)
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	updated, err := updateWithConflictRetry(ctx, backfillID, privateState,
+		func(ctx context.Context) (*client.BackfillResponse, error) {
+			return r.client.GetBackfill(ctx, sinkConsumer, backfillID)
+		},
+		func(ctx context.Context) (*client.BackfillResponse, error) {
+			return r.client.UpdateBackfill(ctx, sinkConsumer, backfillID, updateReq)
+		},
+	)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Backfill",
-			"Could not update backfill ID "+backfillID+": "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error Updating Backfill", "Could not update backfill ID "+backfillID, err)
 		return
 	}
 
 	mapBackfillResponseToModel(updated, &plan)
 
+	privateState.UpdatedAt = updated.UpdatedAt
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	tflog.Info(ctx, "Updated backfill resource", map[string]any{"id": backfillID})
 }
 
 // Delete deletes a backfill
 func (r *BackfillResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = traceOperation(ctx, "sequin_backfill", "delete")
+
 	var data BackfillResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -265,6 +314,9 @@ func (r *BackfillResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts, operationDelete)
+	defer cancel()
+
 	backfillID := data.ID.ValueString()
 	sinkConsumer := data.SinkConsumer.ValueString()
 
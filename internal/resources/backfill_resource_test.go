@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/clintdigital/terraform-provider-sequin/internal/client/clienttest"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -257,3 +259,183 @@ func TestMapBackfillResponseToModel_CancelledBackfill(t *testing.T) {
 		t.Errorf("CompletedAt = %q, want empty", model.Status.CompletedAt)
 	}
 }
+
+// sampleBackfillResponse is the API's view of the backfill used by the mock tests
+func sampleBackfillResponse() *client.BackfillResponse {
+	return &client.BackfillResponse{
+		ID:               "bf-001",
+		State:            client.BackfillStateActive,
+		Table:            "public.users",
+		SinkConsumer:     "my-consumer",
+		InsertedAt:       "2025-01-15T10:00:00Z",
+		UpdatedAt:        "2025-01-15T10:05:00Z",
+		RowsInitialCount: 1000,
+	}
+}
+
+// sampleBackfillModel returns the state of the sample backfill
+func sampleBackfillModel() BackfillResourceModel {
+	model := BackfillResourceModel{
+		SinkConsumer: types.StringValue("my-consumer"),
+		Timeouts:     types.ObjectNull(timeoutsAttrTypes),
+	}
+	mapBackfillResponseToModel(sampleBackfillResponse(), &model)
+	return model
+}
+
+func TestBackfillResource_CreateWithMock(t *testing.T) {
+	ctx := context.Background()
+	var sink string
+	var sent *client.BackfillCreateRequest
+	mock := &clienttest.Mock{
+		CreateBackfillFunc: func(ctx context.Context, sinkIDOrName string, req *client.BackfillCreateRequest) (*client.BackfillResponse, error) {
+			sink, sent = sinkIDOrName, req
+			return sampleBackfillResponse(), nil
+		},
+	}
+	r := &BackfillResource{client: mock}
+
+	plan := BackfillResourceModel{
+		ID:           types.StringUnknown(),
+		SinkConsumer: types.StringValue("my-consumer"),
+		Table:        types.StringValue("public.users"),
+		State:        types.StringUnknown(),
+		Timeouts:     types.ObjectNull(timeoutsAttrTypes),
+	}
+	planned := newResourceState(t, r, &plan)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: planned.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	if sink != "my-consumer" || sent == nil || sent.Table != "public.users" {
+		t.Errorf("created on %q with %+v, want my-consumer and public.users", sink, sent)
+	}
+
+	var data BackfillResourceModel
+	resp.State.Get(ctx, &data)
+	if data.ID.ValueString() != "bf-001" || data.State.ValueString() != client.BackfillStateActive {
+		t.Errorf("state = %+v, want the created backfill", data)
+	}
+	if data.Status == nil || data.Status.RowsInitialCount != 1000 {
+		t.Errorf("status = %+v, want the API's progress", data.Status)
+	}
+	if calls := mock.Calls(); len(calls) != 1 || calls[0] != "CreateBackfill" {
+		t.Errorf("calls = %v, want a single CreateBackfill", calls)
+	}
+}
+
+func TestBackfillResource_ReadWithMock(t *testing.T) {
+	ctx := context.Background()
+	mock := &clienttest.Mock{
+		GetBackfillFunc: func(ctx context.Context, sinkIDOrName, backfillID string) (*client.BackfillResponse, error) {
+			backfill := sampleBackfillResponse()
+			backfill.State = client.BackfillStateCompleted
+			backfill.RowsProcessedCount = 1000
+			return backfill, nil
+		},
+	}
+	r := &BackfillResource{client: mock}
+
+	prior := sampleBackfillModel()
+	state := newResourceState(t, r, &prior)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	var data BackfillResourceModel
+	resp.State.Get(ctx, &data)
+	if data.State.ValueString() != client.BackfillStateCompleted || data.Status.RowsProcessedCount != 1000 {
+		t.Errorf("state = %+v, want the completed backfill", data)
+	}
+	if calls := mock.Calls(); len(calls) != 1 || calls[0] != "GetBackfill" {
+		t.Errorf("calls = %v, want a single GetBackfill", calls)
+	}
+}
+
+func TestBackfillResource_ReadWithMock_NotFound(t *testing.T) {
+	ctx := context.Background()
+	mock := &clienttest.Mock{
+		GetBackfillFunc: func(ctx context.Context, sinkIDOrName, backfillID string) (*client.BackfillResponse, error) {
+			return nil, &client.NotFoundError{Resource: "backfill", ID: backfillID}
+		},
+	}
+	r := &BackfillResource{client: mock}
+
+	prior := sampleBackfillModel()
+	state := newResourceState(t, r, &prior)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() errors: %v", resp.Diagnostics.Errors())
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("a backfill deleted outside Terraform should be removed from state")
+	}
+}
+
+func TestBackfillResource_UpdateWithMock(t *testing.T) {
+	ctx := context.Background()
+	var sent *client.BackfillUpdateRequest
+	mock := &clienttest.Mock{
+		UpdateBackfillFunc: func(ctx context.Context, sinkIDOrName, backfillID string, req *client.BackfillUpdateRequest) (*client.BackfillResponse, error) {
+			sent = req
+			backfill := sampleBackfillResponse()
+			backfill.State = req.State
+			backfill.CanceledAt = "2025-01-15T10:10:00Z"
+			return backfill, nil
+		},
+	}
+	r := &BackfillResource{client: mock}
+
+	prior := sampleBackfillModel()
+	state := newResourceState(t, r, &prior)
+	plan := sampleBackfillModel()
+	plan.State = types.StringValue(client.BackfillStateCancelled)
+	planned := newResourceState(t, r, &plan)
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	if sent == nil || sent.State != client.BackfillStateCancelled {
+		t.Errorf("request = %+v, want the backfill cancelled", sent)
+	}
+	var data BackfillResourceModel
+	resp.State.Get(ctx, &data)
+	if data.State.ValueString() != client.BackfillStateCancelled || data.Status.CanceledAt == "" {
+		t.Errorf("state = %+v, want the cancelled backfill", data)
+	}
+	if calls := mock.Calls(); len(calls) != 1 || calls[0] != "UpdateBackfill" {
+		t.Errorf("calls = %v, want a single UpdateBackfill", calls)
+	}
+}
+
+func TestBackfillResource_DeleteWithMock(t *testing.T) {
+	ctx := context.Background()
+	var sink, deletedID string
+	mock := &clienttest.Mock{
+		DeleteBackfillFunc: func(ctx context.Context, sinkIDOrName, backfillID string) error {
+			sink, deletedID = sinkIDOrName, backfillID
+			return nil
+		},
+	}
+	r := &BackfillResource{client: mock}
+
+	prior := sampleBackfillModel()
+	state := newResourceState(t, r, &prior)
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Delete() errors: %v", resp.Diagnostics.Errors())
+	}
+	if sink != "my-consumer" || deletedID != "bf-001" {
+		t.Errorf("deleted %s/%s, want my-consumer/bf-001", sink, deletedID)
+	}
+}
@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// staleUpdateError reports an update the API rejected because the resource
+// kept changing since the version Terraform last read, even after re-reading
+// it once
+type staleUpdateError struct {
+	err error
+}
+
+// Error returns the API error the update was rejected with
+func (e *staleUpdateError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the API error the update was rejected with
+func (e *staleUpdateError) Unwrap() error {
+	return e.err
+}
+
+// updateWithConflictRetry calls update conditional on the version recorded in
+// the resource's private state, and records the updated version there. A 409
+// or 412 means the resource changed since that version, whether by a
+// concurrent writer or outside Terraform: the resource is re-read and the
+// planned changes applied once more, conditional on the version just read.
+// Requests only carry the configured fields, so anything changed outside the
+// plan is left as the other writer set it. If the retry conflicts as well, the
+// resource is still changing; with a recorded version that is reported as a
+// stale update rather than retried again. A nil state makes the first update
+// unconditional.
+func updateWithConflictRetry[T client.EntityTagged](ctx context.Context, id string, privateState *resourcePrivateState, refresh func(ctx context.Context) (T, error), update func(ctx context.Context) (T, error)) (T, error) {
+	result, err := update(privateState.conditional(ctx))
+
+	if isVersionConflict(err) {
+		tflog.Info(ctx, "Update conflicted with a newer version, re-reading and retrying", map[string]any{"id": id})
+
+		latest, refreshErr := refresh(client.WithoutReadCache(ctx))
+		if refreshErr != nil {
+			return result, fmt.Errorf("%w (re-read after conflict failed: %v)", err, refreshErr)
+		}
+		result, err = update(client.WithIfMatch(ctx, latest.EntityTag()))
+		if isVersionConflict(err) && privateState != nil && privateState.ETag != "" {
+			return result, &staleUpdateError{err: err}
+		}
+	}
+
+	if err == nil && privateState != nil {
+		privateState.recordVersion(result)
+	}
+	return result, err
+}
+
+// isVersionConflict reports whether an update was rejected because the
+// resource is no longer at the version it was conditional on
+func isVersionConflict(err error) bool {
+	return client.IsConflictError(err) || client.IsPreconditionFailedError(err)
+}
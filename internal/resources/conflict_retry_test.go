@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestUpdateWithConflictRetry_RetriesOnceAfterRefresh(t *testing.T) {
+	calls := 0
+	var retryETag string
+	got, err := updateWithConflictRetry(context.Background(), "sink-001", nil,
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			return &client.SinkConsumerResponse{ID: "sink-001", ETag: `"v2"`}, nil
+		},
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			calls++
+			if calls == 1 {
+				return nil, &client.APIError{StatusCode: 409, Body: "concurrent modification"}
+			}
+			retryETag = client.IfMatchFromContext(ctx)
+			return &client.SinkConsumerResponse{ID: "sink-001", ETag: `"v3"`}, nil
+		},
+	)
+
+	if err != nil {
+		t.Fatalf("updateWithConflictRetry() error: %v", err)
+	}
+	if got.ETag != `"v3"` || calls != 2 {
+		t.Errorf("got %+v after %d calls, want the retried update after 2", got, calls)
+	}
+	if retryETag != `"v2"` {
+		t.Errorf("retry If-Match = %q, want the entity tag of the re-read version", retryETag)
+	}
+}
+
+func TestUpdateWithConflictRetry_FailsAfterSecondConflict(t *testing.T) {
+	calls := 0
+	_, err := updateWithConflictRetry(context.Background(), "sink-001", nil,
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			return &client.SinkConsumerResponse{ID: "sink-001"}, nil
+		},
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			calls++
+			return nil, &client.APIError{StatusCode: 409, Body: "concurrent modification"}
+		},
+	)
+
+	if !client.IsConflictError(err) {
+		t.Errorf("expected conflict error, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
+
+func TestUpdateWithConflictRetry_RefreshFailure(t *testing.T) {
+	calls := 0
+	_, err := updateWithConflictRetry(context.Background(), "sink-001", nil,
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			return nil, errors.New("connection refused")
+		},
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			calls++
+			return nil, &client.APIError{StatusCode: 409, Body: "concurrent modification"}
+		},
+	)
+
+	if err == nil {
+		t.Fatal("expected error when re-read fails")
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestUpdateWithConflictRetry_OtherErrorsNotRetried(t *testing.T) {
+	calls := 0
+	_, err := updateWithConflictRetry(context.Background(), "sink-001", nil,
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			return &client.SinkConsumerResponse{ID: "sink-001"}, nil
+		},
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			calls++
+			return nil, &client.APIError{StatusCode: 422, Body: "validation failed"}
+		},
+	)
+
+	if err == nil || calls != 1 {
+		t.Errorf("expected single failed call, got %d calls and err %v", calls, err)
+	}
+}
+
+func TestUpdateWithConflictRetry_RecordsVersion(t *testing.T) {
+	privateState := &resourcePrivateState{ETag: `"v1"`}
+	var sent string
+	_, err := updateWithConflictRetry(context.Background(), "sink-001", privateState,
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			t.Error("resource should not be re-read after a successful update")
+			return nil, nil
+		},
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			sent = client.IfMatchFromContext(ctx)
+			return &client.SinkConsumerResponse{ID: "sink-001", ETag: `"v2"`}, nil
+		},
+	)
+
+	if err != nil {
+		t.Fatalf("updateWithConflictRetry() error: %v", err)
+	}
+	if sent != `"v1"` {
+		t.Errorf("If-Match = %q, want the recorded version", sent)
+	}
+	if privateState.ETag != `"v2"` {
+		t.Errorf("recorded ETag = %q, want the updated version", privateState.ETag)
+	}
+}
+
+func TestUpdateWithConflictRetry_ChangedOutsideTerraformRetried(t *testing.T) {
+	for _, status := range []int{409, 412} {
+		var sent []string
+		privateState := &resourcePrivateState{ETag: `"v1"`}
+		got, err := updateWithConflictRetry(context.Background(), "sink-001", privateState,
+			func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+				return &client.SinkConsumerResponse{ID: "sink-001", ETag: `"v2"`}, nil
+			},
+			func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+				sent = append(sent, client.IfMatchFromContext(ctx))
+				if len(sent) == 1 {
+					return nil, &client.APIError{StatusCode: status, Body: "modified"}
+				}
+				return &client.SinkConsumerResponse{ID: "sink-001", ETag: `"v3"`}, nil
+			},
+		)
+
+		if err != nil {
+			t.Fatalf("status %d: updateWithConflictRetry() error: %v", status, err)
+		}
+		if got.ETag != `"v3"` || len(sent) != 2 || sent[1] != `"v2"` {
+			t.Errorf("status %d: got %+v with If-Match %q, want the update retried on the re-read version", status, got, sent)
+		}
+		if privateState.ETag != `"v3"` {
+			t.Errorf("status %d: recorded ETag = %q, want the retried version", status, privateState.ETag)
+		}
+	}
+}
+
+func TestUpdateWithConflictRetry_StaleAfterSecondConflict(t *testing.T) {
+	for _, status := range []int{409, 412} {
+		calls := 0
+		privateState := &resourcePrivateState{ETag: `"v1"`}
+		_, err := updateWithConflictRetry(context.Background(), "sink-001", privateState,
+			func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+				return &client.SinkConsumerResponse{ID: "sink-001", ETag: `"v2"`}, nil
+			},
+			func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+				calls++
+				return nil, &client.APIError{StatusCode: status, Body: "modified"}
+			},
+		)
+
+		if calls != 2 {
+			t.Errorf("status %d: calls = %d, want 2", status, calls)
+		}
+		var diags diag.Diagnostics
+		addAPIError(&diags, "Error Updating Sink Consumer", "Could not update sink consumer ID sink-001", err)
+		if len(diags) != 1 || diags[0].Summary() != "Resource Modified Outside Terraform" {
+			t.Errorf("status %d: diagnostics = %v, want resource modified outside Terraform", status, diags)
+		}
+		if privateState.ETag != `"v1"` {
+			t.Errorf("status %d: recorded ETag = %q, want it unchanged", status, privateState.ETag)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// createRollbackTimeout bounds the cleanup delete, which runs detached from
+// the (possibly cancelled) apply context
+var createRollbackTimeout = 30 * time.Second
+
+// rollbackCreate deletes a resource that was created remotely but could not be
+// recorded cleanly, either because mapping the response failed or because the
+// apply was cancelled before state could be persisted. Without it the resource
+// would be orphaned outside Terraform. Rollback is best effort and can be
+// disabled with the provider's disable_create_rollback setting, in which case
+// the resource is kept in state (tainted) instead.
+func rollbackCreate(ctx context.Context, skip bool, resourceName, id string, diags *diag.Diagnostics, state *tfsdk.State, deleteFn func(context.Context) error) {
+	cancelled := ctx.Err() != nil
+	if !cancelled && !diags.HasError() {
+		return
+	}
+	if id == "" {
+		return
+	}
+
+	if cancelled {
+		diags.AddError(
+			"Create Cancelled",
+			"The apply was cancelled after "+resourceName+" ID "+id+" was created.",
+		)
+	}
+
+	if skip {
+		tflog.Warn(ctx, "Create rollback disabled, keeping partially created resource", map[string]any{"id": id})
+		return
+	}
+
+	tflog.Warn(ctx, "Rolling back partially created resource", map[string]any{"id": id})
+
+	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), createRollbackTimeout)
+	defer cancel()
+
+	if err := deleteFn(cleanupCtx); err != nil {
+		diags.AddError(
+			"Create Rollback Failed",
+			"Could not delete partially created "+resourceName+" ID "+id+"; it is kept in state so it can be destroyed later: "+err.Error(),
+		)
+		return
+	}
+
+	// Nothing is left to track once the remote object is gone
+	state.RemoveResource(ctx)
+	diags.AddWarning(
+		"Create Rolled Back",
+		"The partially created "+resourceName+" ID "+id+" was deleted.",
+	)
+}
@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func newDatabaseState(t *testing.T) *tfsdk.State {
+	t.Helper()
+	schemaResp := &resource.SchemaResponse{}
+	NewDatabaseResource().Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return &tfsdk.State{Schema: schemaResp.Schema}
+}
+
+func TestRollbackCreate_NoopOnSuccess(t *testing.T) {
+	var diags diag.Diagnostics
+	called := false
+
+	rollbackCreate(context.Background(), false, "database", "db-001", &diags, newDatabaseState(t), func(context.Context) error {
+		called = true
+		return nil
+	})
+
+	if called {
+		t.Error("rollback should not run when create succeeded")
+	}
+	if diags.HasError() {
+		t.Errorf("unexpected errors: %v", diags.Errors())
+	}
+}
+
+func TestRollbackCreate_DeletesOnMappingFailure(t *testing.T) {
+	var diags diag.Diagnostics
+	diags.AddError("Mapping Failed", "bad response")
+	state := newDatabaseState(t)
+	var deletedWith context.Context
+
+	rollbackCreate(context.Background(), false, "database", "db-001", &diags, state, func(ctx context.Context) error {
+		deletedWith = ctx
+		return nil
+	})
+
+	if deletedWith == nil {
+		t.Fatal("rollback should delete the created resource")
+	}
+	if !state.Raw.IsNull() {
+		t.Error("state should be removed after a successful rollback")
+	}
+}
+
+func TestRollbackCreate_DeletesOnCancellation(t *testing.T) {
+	var diags diag.Diagnostics
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rollbackCreate(ctx, false, "sink consumer", "sink-001", &diags, newDatabaseState(t), func(cleanupCtx context.Context) error {
+		if cleanupCtx.Err() != nil {
+			t.Error("cleanup should not use the cancelled apply context")
+		}
+		return nil
+	})
+
+	if !diags.HasError() {
+		t.Error("a cancelled create should be reported as an error")
+	}
+}
+
+func TestRollbackCreate_OptOut(t *testing.T) {
+	var diags diag.Diagnostics
+	diags.AddError("Mapping Failed", "bad response")
+	called := false
+
+	rollbackCreate(context.Background(), true, "database", "db-001", &diags, newDatabaseState(t), func(context.Context) error {
+		called = true
+		return nil
+	})
+
+	if called {
+		t.Error("rollback should not run when disabled")
+	}
+}
+
+func TestRollbackCreate_DeleteFailureReported(t *testing.T) {
+	var diags diag.Diagnostics
+	diags.AddError("Mapping Failed", "bad response")
+	state := newDatabaseState(t)
+
+	rollbackCreate(context.Background(), false, "database", "db-001", &diags, state, func(context.Context) error {
+		return errors.New("connection refused")
+	})
+
+	if got := diags.ErrorsCount(); got != 2 {
+		t.Errorf("errors = %d, want 2 (original and rollback failure)", got)
+	}
+}
@@ -0,0 +1,309 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// credentialSecretKeys lists the destination secret fields a stored
+// credential can provide
+var credentialSecretKeys = []string{
+	"username",
+	"password",
+	"access_key_id",
+	"secret_access_key",
+	"gcp_credentials",
+	"shared_access_key_name",
+	"shared_access_key",
+	"creds",
+	"nkey_seed",
+	"jwt",
+	"api_key",
+}
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ resource.Resource                = &CredentialResource{}
+	_ resource.ResourceWithConfigure   = &CredentialResource{}
+	_ resource.ResourceWithImportState = &CredentialResource{}
+)
+
+// CredentialResource defines the resource implementation
+type CredentialResource struct {
+	client client.SequinAPI
+}
+
+// CredentialResourceModel describes the resource data model
+type CredentialResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Secrets types.Map    `tfsdk:"secrets"`
+}
+
+// NewCredentialResource creates a new resource
+func NewCredentialResource() resource.Resource {
+	return &CredentialResource{}
+}
+
+// Metadata returns the resource type name
+func (r *CredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+// Schema defines the resource schema
+func (r *CredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a named credential stored in Sequin. Sink consumer destinations reference it through credential_ref instead of holding secrets inline, so rotating it touches one resource rather than every sink.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the credential.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Unique name of the credential, used as the destination credential_ref.",
+				Required:    true,
+			},
+			"secrets": schema.MapAttribute{
+				Description: "Secret values keyed by the destination field they fill: username, password, access_key_id, secret_access_key, gcp_credentials, shared_access_key_name, shared_access_key, creds, nkey_seed, jwt, api_key. For Kafka with AWS_MSK_IAM the access key fields fill aws_access_key_id and aws_secret_access_key. Never returned by the API.",
+				Required:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.OneOf(credentialSecretKeys...)),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the resource
+func (r *CredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new credential
+func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_credential", "create")
+
+	var data CredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := buildCredentialRequest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateCredential(ctx, createReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Creating Credential", "Could not create credential", err)
+		return
+	}
+
+	mapCredentialToModel(created, &data)
+
+	privateState := &resourcePrivateState{ETag: created.ETag}
+	privateState.markCreated()
+	privateState.recordSecrets(createReq.Secrets, created.SecretFingerprint)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	rollbackCreate(ctx, r.client.CreateRollbackSkipped(), "credential", created.ID, &resp.Diagnostics, &resp.State, func(ctx context.Context) error {
+		return r.client.DeleteCredential(ctx, created.ID)
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created credential resource", map[string]any{"id": data.ID.ValueString()})
+}
+
+// Read refreshes the Terraform state with the latest data from the API
+func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = traceOperation(ctx, "sequin_credential", "read")
+
+	var data CredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	credentialID := data.ID.ValueString()
+	credential, err := getWithCreateGrace(ctx, privateState, credentialID, func() (*client.CredentialResponse, error) {
+		return r.client.GetCredential(ctx, credentialID)
+	})
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Credential not found, removing from state", map[string]any{"id": credentialID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Credential",
+			"Could not read credential ID "+credentialID+": "+err.Error(),
+		)
+		return
+	}
+
+	mapCredentialToModel(credential, &data)
+
+	privateState.recordVersion(credential)
+
+	// Secrets are never returned, so out-of-band rotation is only visible
+	// through the server fingerprint. Clearing them in state makes the next
+	// plan re-apply the configured values.
+	secrets, d := credentialSecrets(ctx, data.Secrets)
+	resp.Diagnostics.Append(d...)
+	if privateState.secretsDrifted(secrets, credential.SecretFingerprint) {
+		resp.Diagnostics.AddWarning(
+			"Credential Drift Detected",
+			"The secrets of credential ID "+credentialID+" were changed outside of Terraform. "+
+				"The configured secrets will be re-applied on the next apply.",
+		)
+		data.Secrets = types.MapNull(types.StringType)
+	}
+
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates an existing credential
+func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = traceOperation(ctx, "sequin_credential", "update")
+
+	var plan, state CredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := buildCredentialRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentialID := state.ID.ValueString()
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	updated, err := updateWithConflictRetry(ctx, credentialID, privateState,
+		func(ctx context.Context) (*client.CredentialResponse, error) {
+			return r.client.GetCredential(ctx, credentialID)
+		},
+		func(ctx context.Context) (*client.CredentialResponse, error) {
+			return r.client.UpdateCredential(ctx, credentialID, updateReq)
+		},
+	)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating Credential", "Could not update credential ID "+credentialID, err)
+		return
+	}
+
+	mapCredentialToModel(updated, &plan)
+
+	privateState.recordSecrets(updateReq.Secrets, updated.SecretFingerprint)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Updated credential resource", map[string]any{"id": credentialID})
+}
+
+// Delete deletes a credential
+func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = traceOperation(ctx, "sequin_credential", "delete")
+
+	var data CredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for sink consumers destroyed in the same apply to release the
+	// credential first
+	credentialID := data.ID.ValueString()
+	err := deleteWithDependencyRetry(ctx, credentialID, defaultDependencyWait, func() error {
+		return r.client.DeleteCredential(ctx, credentialID)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Credential",
+			"Could not delete credential ID "+credentialID+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted credential", map[string]any{"id": credentialID})
+}
+
+// ImportState imports an existing credential by ID. Secrets cannot be read
+// back and must be set in configuration.
+func (r *CredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// buildCredentialRequest converts the model into an API request
+func buildCredentialRequest(ctx context.Context, data *CredentialResourceModel, diags *diag.Diagnostics) *client.CredentialRequest {
+	secrets, d := credentialSecrets(ctx, data.Secrets)
+	diags.Append(d...)
+	return &client.CredentialRequest{
+		Name:    data.Name.ValueString(),
+		Secrets: secrets,
+	}
+}
+
+// mapCredentialToModel maps the API response to the Terraform model. Secrets
+// are kept from the plan or prior state.
+func mapCredentialToModel(credential *client.CredentialResponse, data *CredentialResourceModel) {
+	data.ID = types.StringValue(credential.ID)
+	data.Name = types.StringValue(credential.Name)
+}
+
+// credentialSecrets returns the configured secrets, tracked for drift detection
+func credentialSecrets(ctx context.Context, secrets types.Map) (map[string]string, diag.Diagnostics) {
+	values := map[string]string{}
+	if secrets.IsNull() || secrets.IsUnknown() {
+		return values, nil
+	}
+	diags := secrets.ElementsAs(ctx, &values, false)
+	return values, diags
+}
@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCredentialResource_Metadata(t *testing.T) {
+	r := NewCredentialResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_credential" {
+		t.Errorf("TypeName = %q, want sequin_credential", resp.TypeName)
+	}
+}
+
+func TestCredentialResource_Schema(t *testing.T) {
+	r := NewCredentialResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "name", "secrets"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+	if !resp.Schema.Attributes["secrets"].(schema.MapAttribute).Sensitive {
+		t.Error("secrets should be sensitive")
+	}
+}
+
+func TestBuildCredentialRequest(t *testing.T) {
+	var diags diag.Diagnostics
+
+	req := buildCredentialRequest(context.Background(), &CredentialResourceModel{
+		Name: types.StringValue("kafka-prod"),
+		Secrets: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"username": types.StringValue("sequin"),
+			"password": types.StringValue("hunter2"),
+		}),
+	}, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.Name != "kafka-prod" || len(req.Secrets) != 2 || req.Secrets["password"] != "hunter2" {
+		t.Errorf("req = %+v, want kafka-prod with username and password", req)
+	}
+}
+
+func TestCredentialSecrets_NullAfterDrift(t *testing.T) {
+	secrets, diags := credentialSecrets(context.Background(), types.MapNull(types.StringType))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(secrets) != 0 {
+		t.Errorf("secrets = %v, want none for null secrets", secrets)
+	}
+}
+
+func TestMapCredentialToModel_PreservesSecrets(t *testing.T) {
+	secrets := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"password": types.StringValue("hunter2"),
+	})
+	data := CredentialResourceModel{Secrets: secrets}
+
+	mapCredentialToModel(&client.CredentialResponse{ID: "cred-1", Name: "kafka-prod", SecretFingerprint: "fp-1"}, &data)
+
+	if data.ID.ValueString() != "cred-1" || data.Name.ValueString() != "kafka-prod" {
+		t.Errorf("data = %+v, want cred-1 kafka-prod", data)
+	}
+	if !data.Secrets.Equal(secrets) {
+		t.Error("secrets should be kept from the plan")
+	}
+}
@@ -0,0 +1,323 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// dataClassifications lists the sensitivity classes a table or column can be
+// tagged with
+var dataClassifications = []string{"pii", "phi", "pci", "confidential"}
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ resource.Resource                = &DataClassificationResource{}
+	_ resource.ResourceWithConfigure   = &DataClassificationResource{}
+	_ resource.ResourceWithImportState = &DataClassificationResource{}
+)
+
+// DataClassificationResource defines the resource implementation
+type DataClassificationResource struct {
+	client client.SequinAPI
+}
+
+// DataClassificationResourceModel describes the resource data model
+type DataClassificationResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Database       types.String `tfsdk:"database"`
+	Table          types.String `tfsdk:"table"`
+	Columns        types.List   `tfsdk:"columns"`
+	Classification types.String `tfsdk:"classification"`
+	Note           types.String `tfsdk:"note"`
+}
+
+// NewDataClassificationResource creates a new resource
+func NewDataClassificationResource() resource.Resource {
+	return &DataClassificationResource{}
+}
+
+// Metadata returns the resource type name
+func (r *DataClassificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_classification"
+}
+
+// Schema defines the resource schema
+func (r *DataClassificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Tags a table or some of its columns as sensitive. The classification is stored as an annotation in Sequin and read by the sequin_sensitive_data_exposures data source to find sinks that stream sensitive data without redacting it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the classification.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Name or ID of the database connection the table belongs to. Changing this forces a new classification.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table": schema.StringAttribute{
+				Description: "Schema-qualified table name, e.g. public.users. Changing this forces a new classification.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(qualifiedTablePattern, "must be a schema-qualified table name such as public.users"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"columns": schema.ListAttribute{
+				Description: "Sensitive columns of the table. The whole table is classified when omitted.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"classification": schema.StringAttribute{
+				Description: "Sensitivity class: pii, phi, pci, confidential. Uses the server default (pii) when omitted.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dataClassifications...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"note": schema.StringAttribute{
+				Description: "Free-form note, e.g. the regulation or ticket behind the classification.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the resource
+func (r *DataClassificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new data classification
+func (r *DataClassificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_data_classification", "create")
+
+	var data DataClassificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := buildDataClassificationRequest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateDataClassification(ctx, createReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Creating Data Classification", "Could not create data classification for "+createReq.Table, err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapDataClassificationToModel(ctx, created, &data)...)
+
+	privateState := &resourcePrivateState{ETag: created.ETag}
+	privateState.markCreated()
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	rollbackCreate(ctx, r.client.CreateRollbackSkipped(), "data classification", created.ID, &resp.Diagnostics, &resp.State, func(ctx context.Context) error {
+		return r.client.DeleteDataClassification(ctx, created.ID)
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created data classification resource", map[string]any{"id": data.ID.ValueString()})
+}
+
+// Read refreshes the Terraform state with the latest data from the API
+func (r *DataClassificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = traceOperation(ctx, "sequin_data_classification", "read")
+
+	var data DataClassificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	classificationID := data.ID.ValueString()
+	classification, err := getWithCreateGrace(ctx, privateState, classificationID, func() (*client.DataClassificationResponse, error) {
+		return r.client.GetDataClassification(ctx, classificationID)
+	})
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Data classification not found, removing from state", map[string]any{"id": classificationID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Data Classification",
+			"Could not read data classification ID "+classificationID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapDataClassificationToModel(ctx, classification, &data)...)
+
+	privateState.recordVersion(classification)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates an existing data classification
+func (r *DataClassificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = traceOperation(ctx, "sequin_data_classification", "update")
+
+	var plan, state DataClassificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := buildDataClassificationRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	classificationID := state.ID.ValueString()
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	updated, err := updateWithConflictRetry(ctx, classificationID, privateState,
+		func(ctx context.Context) (*client.DataClassificationResponse, error) {
+			return r.client.GetDataClassification(ctx, classificationID)
+		},
+		func(ctx context.Context) (*client.DataClassificationResponse, error) {
+			return r.client.UpdateDataClassification(ctx, classificationID, updateReq)
+		},
+	)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating Data Classification", "Could not update data classification ID "+classificationID, err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapDataClassificationToModel(ctx, updated, &plan)...)
+
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Updated data classification resource", map[string]any{"id": classificationID})
+}
+
+// Delete deletes a data classification
+func (r *DataClassificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = traceOperation(ctx, "sequin_data_classification", "delete")
+
+	var data DataClassificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	classificationID := data.ID.ValueString()
+	if err := r.client.DeleteDataClassification(ctx, classificationID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Data Classification",
+			"Could not delete data classification ID "+classificationID+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted data classification", map[string]any{"id": classificationID})
+}
+
+// ImportState imports an existing data classification by ID
+func (r *DataClassificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// buildDataClassificationRequest converts the model into an API request
+func buildDataClassificationRequest(ctx context.Context, data *DataClassificationResourceModel, diags *diag.Diagnostics) *client.DataClassificationRequest {
+	req := &client.DataClassificationRequest{
+		Database: data.Database.ValueString(),
+		Table:    data.Table.ValueString(),
+	}
+	if !data.Columns.IsNull() && !data.Columns.IsUnknown() {
+		diags.Append(data.Columns.ElementsAs(ctx, &req.Columns, false)...)
+	}
+	if !data.Classification.IsNull() && !data.Classification.IsUnknown() {
+		req.Classification = data.Classification.ValueString()
+	}
+	if !data.Note.IsNull() && !data.Note.IsUnknown() {
+		req.Note = data.Note.ValueString()
+	}
+	return req
+}
+
+// mapDataClassificationToModel maps the API response to the Terraform model
+func mapDataClassificationToModel(ctx context.Context, classification *client.DataClassificationResponse, data *DataClassificationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(classification.ID)
+	data.Table = types.StringValue(classification.Table)
+	data.Classification = types.StringValue(classification.Classification)
+	data.Note = optionalString(classification.Note)
+
+	// Keep the configured name or ID unless the classification now points at
+	// a different database, e.g. after import
+	configured := data.Database.ValueString()
+	if data.Database.IsNull() || data.Database.IsUnknown() ||
+		(configured != classification.Database && configured != classification.DatabaseID) {
+		data.Database = types.StringValue(classification.Database)
+	}
+
+	if len(classification.Columns) > 0 {
+		columns, d := types.ListValueFrom(ctx, types.StringType, classification.Columns)
+		diags.Append(d...)
+		data.Columns = columns
+	} else {
+		data.Columns = types.ListNull(types.StringType)
+	}
+
+	return diags
+}
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataClassificationResource_Metadata(t *testing.T) {
+	r := NewDataClassificationResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_data_classification" {
+		t.Errorf("TypeName = %q, want sequin_data_classification", resp.TypeName)
+	}
+}
+
+func TestDataClassificationResource_Schema(t *testing.T) {
+	r := NewDataClassificationResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "database", "table", "columns", "classification", "note"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestBuildDataClassificationRequest(t *testing.T) {
+	var diags diag.Diagnostics
+	columns, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"email", "phone"})
+
+	req := buildDataClassificationRequest(context.Background(), &DataClassificationResourceModel{
+		Database:       types.StringValue("production"),
+		Table:          types.StringValue("public.users"),
+		Columns:        columns,
+		Classification: types.StringUnknown(),
+		Note:           types.StringNull(),
+	}, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.Database != "production" || req.Table != "public.users" || len(req.Columns) != 2 {
+		t.Errorf("req = %+v, want production public.users with two columns", req)
+	}
+	if req.Classification != "" {
+		t.Error("unknown classification should be omitted so the server default applies")
+	}
+}
+
+func TestMapDataClassificationToModel(t *testing.T) {
+	ctx := context.Background()
+	response := &client.DataClassificationResponse{
+		ID:             "dc-1",
+		Database:       "production",
+		DatabaseID:     "9f1c7e2a-db-id",
+		Table:          "public.users",
+		Classification: "pii",
+	}
+
+	data := DataClassificationResourceModel{Database: types.StringValue("9f1c7e2a-db-id")}
+	if diags := mapDataClassificationToModel(ctx, response, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Database.ValueString() != "9f1c7e2a-db-id" {
+		t.Errorf("Database = %q, want configured ID kept", data.Database.ValueString())
+	}
+	if !data.Columns.IsNull() {
+		t.Error("Columns should be null when the whole table is classified")
+	}
+	if !data.Note.IsNull() {
+		t.Error("Note should be null when the API omits it")
+	}
+
+	var imported DataClassificationResourceModel
+	mapDataClassificationToModel(ctx, response, &imported)
+	if imported.Database.ValueString() != "production" {
+		t.Errorf("Database = %q, want API name on import", imported.Database.ValueString())
+	}
+}
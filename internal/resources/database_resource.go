@@ -3,6 +3,8 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/clintdigital/terraform-provider-sequin/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -21,11 +23,13 @@ var (
 	_ resource.Resource                = &DatabaseResource{}
 	_ resource.ResourceWithConfigure   = &DatabaseResource{}
 	_ resource.ResourceWithImportState = &DatabaseResource{}
+	_ resource.ResourceWithIdentity    = &DatabaseResource{}
+	_ resource.ResourceWithModifyPlan  = &DatabaseResource{}
 )
 
 // DatabaseResource defines the resource implementation
 type DatabaseResource struct {
-	client *client.Client
+	client client.SequinAPI
 }
 
 // DatabaseResourceModel describes the resource data model
@@ -47,6 +51,11 @@ type DatabaseResourceModel struct {
 	PoolSize       types.Int64 `tfsdk:"pool_size"`
 	QueueInterval  types.Int64 `tfsdk:"queue_interval"`
 	QueueTarget    types.Int64 `tfsdk:"queue_target"`
+
+	Annotations    types.Map `tfsdk:"annotations"`
+	AnnotationsAll types.Map `tfsdk:"annotations_all"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
 }
 
 // NewDatabaseResource creates a new resource
@@ -185,21 +194,30 @@ func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 				Description: "Queue processing target.",
 				Computed:    true,
 			},
+			"annotations":     annotationsAttribute(),
+			"annotations_all": annotationsAllAttribute(),
+			"timeouts":        timeoutsAttribute(),
 		},
 	}
 }
 
+// IdentitySchema defines the resource identity used by import blocks and
+// config generation
+func (r *DatabaseResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Unique identifier for the database connection.")
+}
+
 // Configure adds the provider-configured client to the resource
 func (r *DatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.SequinAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -209,6 +227,8 @@ func (r *DatabaseResource) Configure(ctx context.Context, req resource.Configure
 
 // Create creates a new database resource
 func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_database", "create")
+
 	var data DatabaseResourceModel
 
 	// Read Terraform plan data into the model
@@ -217,9 +237,13 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts, operationCreate)
+	defer cancel()
+
 	// Build API request
 	createReq := &client.DatabaseRequest{
-		Name: data.Name.ValueString(),
+		Name:        data.Name.ValueString(),
+		Annotations: mergeAnnotations(defaultAnnotations(r.client), data.Annotations),
 	}
 
 	// Connection details (URL or individual params)
@@ -298,24 +322,37 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 	// Call API
 	created, err := r.client.CreateDatabase(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Database",
-			"Could not create database: "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error Creating Database", "Could not create database", err)
 		return
 	}
 
 	// Map response to model
 	r.mapResponseToModel(ctx, created, &data, &resp.Diagnostics)
 
+	// Track server-side metadata outside the public schema
+	privateState := &resourcePrivateState{ETag: created.ETag}
+	privateState.markCreated()
+	privateState.recordSecrets(databaseSecrets(&data), created.PasswordFingerprint)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID)...)
+
+	rollbackCreate(ctx, r.client.CreateRollbackSkipped(), "database", created.ID, &resp.Diagnostics, &resp.State, func(ctx context.Context) error {
+		return r.client.DeleteDatabase(ctx, created.ID)
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Info(ctx, "Created database resource", map[string]any{"id": data.ID.ValueString()})
 }
 
 // Read refreshes the Terraform state with the latest data from the API
 func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = traceOperation(ctx, "sequin_database", "read")
+
 	var data DatabaseResourceModel
 
 	// Read Terraform current state into the model
@@ -324,9 +361,17 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts, operationRead)
+	defer cancel()
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
 	// Get current state from API
 	dbID := data.ID.ValueString()
-	database, err := r.client.GetDatabase(ctx, dbID)
+	database, err := getWithCreateGrace(ctx, privateState, dbID, func() (*client.DatabaseResponse, error) {
+		return r.client.GetDatabase(ctx, dbID)
+	})
 	if err != nil {
 		if client.IsNotFoundError(err) {
 			// Resource was deleted outside Terraform
@@ -344,12 +389,36 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Update model with latest values from API (drift detection)
 	r.mapResponseToModel(ctx, database, &data, &resp.Diagnostics)
 
+	privateState.recordVersion(database)
+
+	// The API obfuscates passwords, so out-of-band rotation is only visible
+	// through the server fingerprint. Clearing the secret in state makes the
+	// next plan re-apply the configured value.
+	if privateState.secretsDrifted(databaseSecrets(&data), database.PasswordFingerprint) {
+		resp.Diagnostics.AddWarning(
+			"Database Credential Drift Detected",
+			"The credentials for database ID "+dbID+" were changed outside of Terraform. "+
+				"The configured credentials will be re-applied on the next apply.",
+		)
+		if !data.Password.IsNull() {
+			data.Password = types.StringNull()
+		}
+		if !data.URL.IsNull() {
+			data.URL = types.StringNull()
+		}
+	}
+
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
 	// Save updated state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 // Update updates an existing database resource
 func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = traceOperation(ctx, "sequin_database", "update")
+
 	var plan, state DatabaseResourceModel
 
 	// Read Terraform plan and current state
@@ -359,9 +428,13 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts, operationUpdate)
+	defer cancel()
+
 	// Build update request (same structure as create)
 	updateReq := &client.DatabaseRequest{
-		Name: plan.Name.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Annotations: mergeAnnotations(defaultAnnotations(r.client), plan.Annotations),
 	}
 
 	// Connection details
@@ -442,26 +515,38 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Call API
 	dbID := state.ID.ValueString()
-	updated, err := r.client.UpdateDatabase(ctx, dbID, updateReq)
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	updated, err := updateWithConflictRetry(ctx, dbID, privateState,
+		func(ctx context.Context) (*client.DatabaseResponse, error) {
+			return r.client.GetDatabase(ctx, dbID)
+		},
+		func(ctx context.Context) (*client.DatabaseResponse, error) {
+			return r.client.UpdateDatabase(ctx, dbID, updateReq)
+		},
+	)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Database",
-			"Could not update database ID "+dbID+": "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error Updating Database", "Could not update database ID "+dbID, err)
 		return
 	}
 
 	// Update model with response
 	r.mapResponseToModel(ctx, updated, &plan, &resp.Diagnostics)
 
+	privateState.recordSecrets(databaseSecrets(&plan), updated.PasswordFingerprint)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
 	// Save updated state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, plan.ID)...)
 
 	tflog.Info(ctx, "Updated database resource", map[string]any{"id": dbID})
 }
 
 // Delete deletes a database resource
 func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = traceOperation(ctx, "sequin_database", "delete")
+
 	var data DatabaseResourceModel
 
 	// Read Terraform current state
@@ -470,9 +555,23 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	// Call API to delete
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts, operationDelete)
+	defer cancel()
+
+	// Call API to delete, waiting for sink consumers destroyed in the same
+	// apply to go away first
 	dbID := data.ID.ValueString()
-	err := r.client.DeleteDatabase(ctx, dbID)
+	wait := dependencyWait(data.Timeouts)
+	err := deleteWithDependencyRetry(ctx, dbID, wait, func() error {
+		return r.client.DeleteDatabase(ctx, dbID)
+	})
+	if client.IsDependencyError(err) {
+		resp.Diagnostics.AddError(
+			"Database Has Dependent Sink Consumers",
+			r.dependentSinksDetail(ctx, data.Name.ValueString(), dbID, wait, err),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Database",
@@ -485,10 +584,61 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 	// State is automatically removed by Terraform after successful Delete
 }
 
+// dependentSinksDetail describes which sink consumers are blocking deletion of
+// a database. Listing is best effort; the API error is always included. The
+// sinks are listed with a context of their own, as the wait for them to go
+// away usually runs until ctx expires.
+func (r *DatabaseResource) dependentSinksDetail(ctx context.Context, dbName, dbID string, wait time.Duration, err error) string {
+	detail := fmt.Sprintf("Could not delete database ID %s after waiting %s for dependent sink consumers to be removed", dbID, wait)
+
+	listCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), dependentsListTimeout)
+	defer cancel()
+	sinks, listErr := r.client.ListSinkConsumers(listCtx, client.SinkConsumerListOptions{})
+	if listErr != nil {
+		tflog.Warn(ctx, "Could not list sink consumers blocking database deletion", map[string]any{"id": dbID, "error": listErr.Error()})
+	} else if names := sinksUsingDatabase(sinks, dbName, dbID); len(names) > 0 {
+		detail += fmt.Sprintf(". Blocking sink consumers: %s. Delete them first or add depends_on so they are destroyed before the database", strings.Join(names, ", "))
+	}
+
+	return detail + ": " + err.Error()
+}
+
 // ImportState imports an existing database resource by ID
 func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import by ID: terraform import sequin_database.example <database-id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// or an import block with id or identity = { id = ... }, which also
+	// supports terraform plan -generate-config-out
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// ModifyPlan plans the annotations sent to Sequin and reports database hosts
+// the configured platform cannot connect to
+func (r *DatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planAnnotations(ctx, r.client, resp)
+
+	var plan DatabaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sequin's local tunnel reaches databases on private networks
+	if plan.UseLocalTunnel.ValueBool() {
+		return
+	}
+
+	requireReachableFromCloud(r.client, plan.Hostname, path.Root("hostname"), &resp.Diagnostics)
+	requireReachableFromCloud(r.client, urlHost(plan.URL), path.Root("url"), &resp.Diagnostics)
+	if !plan.Primary.IsNull() && !plan.Primary.IsUnknown() {
+		if hostname, ok := plan.Primary.Attributes()["hostname"].(types.String); ok {
+			requireReachableFromCloud(r.client, hostname, path.Root("primary").AtName("hostname"), &resp.Diagnostics)
+		}
+	}
 }
 
 // mapResponseToModel maps API response to Terraform model
@@ -508,6 +658,7 @@ func (r *DatabaseResource) mapResponseToModel(ctx context.Context, response *cli
 	model.PoolSize = types.Int64Value(int64(response.PoolSize))
 	model.QueueInterval = types.Int64Value(int64(response.QueueInterval))
 	model.QueueTarget = types.Int64Value(int64(response.QueueTarget))
+	mapAnnotations(ctx, response.Annotations, defaultAnnotations(r.client), &model.Annotations, &model.AnnotationsAll, diags)
 
 	// Map replication slots
 	slotsList := make([]attr.Value, len(response.ReplicationSlots))
@@ -587,3 +738,11 @@ func (r *DatabaseResource) mapResponseToModel(ctx context.Context, response *cli
 	}
 
 }
+
+// databaseSecrets returns the configured secrets tracked for drift detection
+func databaseSecrets(model *DatabaseResourceModel) map[string]string {
+	return stringSecrets(map[string]types.String{
+		"password": model.Password,
+		"url":      model.URL,
+	})
+}
@@ -2,11 +2,16 @@ package resources
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/clintdigital/terraform-provider-sequin/internal/client/clienttest"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -297,3 +302,284 @@ func TestDatabaseMapResponseToModel_PrimaryNilPort(t *testing.T) {
 		t.Errorf("primary ssl should be null when nil, got %v", primaryAttrs["ssl"])
 	}
 }
+
+// newResourceState returns state for r holding model, for driving CRUD
+// methods against a clienttest.Mock
+func newResourceState(t *testing.T, r resource.Resource, model any) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("State.Set() errors: %v", diags.Errors())
+	}
+	return state
+}
+
+// sampleDatabaseResponse is the API's view of the database used by the mock tests
+func sampleDatabaseResponse() *client.DatabaseResponse {
+	return &client.DatabaseResponse{
+		ID:            "db-001",
+		Name:          "production",
+		Hostname:      "db.example.com",
+		Port:          5432,
+		Database:      "myapp",
+		Username:      "admin",
+		Password:      "***obfuscated***",
+		SSL:           true,
+		PoolSize:      10,
+		QueueInterval: 1000,
+		QueueTarget:   500,
+		ReplicationSlots: []client.ReplicationSlot{
+			{ID: "slot-001", PublicationName: "sequin_pub", SlotName: "sequin_slot", Status: "active"},
+		},
+	}
+}
+
+// sampleDatabaseModel returns the state of the sample database
+func sampleDatabaseModel(t *testing.T) DatabaseResourceModel {
+	t.Helper()
+	model := DatabaseResourceModel{
+		URL:            types.StringNull(),
+		Password:       types.StringValue("real-password"),
+		Annotations:    types.MapNull(types.StringType),
+		AnnotationsAll: types.MapUnknown(types.StringType),
+		Timeouts:       types.ObjectNull(timeoutsAttrTypes),
+	}
+	diags := diag.Diagnostics{}
+	(&DatabaseResource{}).mapResponseToModel(context.Background(), sampleDatabaseResponse(), &model, &diags)
+	if diags.HasError() {
+		t.Fatalf("mapResponseToModel() errors: %v", diags.Errors())
+	}
+	return model
+}
+
+func TestDatabaseResource_CreateWithMock(t *testing.T) {
+	ctx := context.Background()
+	var sent *client.DatabaseRequest
+	mock := &clienttest.Mock{
+		CreateDatabaseFunc: func(ctx context.Context, req *client.DatabaseRequest) (*client.DatabaseResponse, error) {
+			sent = req
+			return sampleDatabaseResponse(), nil
+		},
+	}
+	r := &DatabaseResource{client: mock}
+
+	// Computed attributes are unknown until the API answers
+	plan := sampleDatabaseModel(t)
+	plan.ID = types.StringUnknown()
+	plan.UseLocalTunnel = types.BoolUnknown()
+	plan.PoolSize = types.Int64Unknown()
+	plan.QueueInterval = types.Int64Unknown()
+	plan.QueueTarget = types.Int64Unknown()
+	planned := newResourceState(t, r, &plan)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: planned.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	if sent == nil || sent.Name != "production" || sent.Hostname != "db.example.com" || sent.Password != "real-password" {
+		t.Errorf("request = %+v, want the planned connection settings", sent)
+	}
+	if len(sent.ReplicationSlots) != 1 || sent.ReplicationSlots[0].SlotName != "sequin_slot" {
+		t.Errorf("replication slots = %+v, want the planned slot", sent.ReplicationSlots)
+	}
+
+	var data DatabaseResourceModel
+	resp.State.Get(ctx, &data)
+	if data.ID.ValueString() != "db-001" || data.PoolSize.ValueInt64() != 10 {
+		t.Errorf("state = %+v, want the created database", data)
+	}
+	if data.Password.ValueString() != "real-password" {
+		t.Errorf("password = %s, want the configured value kept", data.Password)
+	}
+	if calls := mock.Calls(); len(calls) != 1 || calls[0] != "CreateDatabase" {
+		t.Errorf("calls = %v, want a single CreateDatabase", calls)
+	}
+}
+
+func TestDatabaseResource_CreateWithMock_Error(t *testing.T) {
+	ctx := context.Background()
+	mock := &clienttest.Mock{
+		CreateDatabaseFunc: func(ctx context.Context, req *client.DatabaseRequest) (*client.DatabaseResponse, error) {
+			return nil, &client.APIError{StatusCode: 422, Body: "hostname is invalid"}
+		},
+	}
+	r := &DatabaseResource{client: mock}
+
+	plan := sampleDatabaseModel(t)
+	plan.ID = types.StringUnknown()
+	planned := newResourceState(t, r, &plan)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: planned.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected Create() to fail")
+	}
+	if calls := mock.Calls(); len(calls) != 1 {
+		t.Errorf("calls = %v, want only the failed CreateDatabase", calls)
+	}
+}
+
+func TestDatabaseResource_ReadWithMock(t *testing.T) {
+	ctx := context.Background()
+	mock := &clienttest.Mock{
+		GetDatabaseFunc: func(ctx context.Context, id string) (*client.DatabaseResponse, error) {
+			database := sampleDatabaseResponse()
+			database.PoolSize = 20
+			return database, nil
+		},
+	}
+	r := &DatabaseResource{client: mock}
+
+	prior := sampleDatabaseModel(t)
+	state := newResourceState(t, r, &prior)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	var data DatabaseResourceModel
+	resp.State.Get(ctx, &data)
+	if data.PoolSize.ValueInt64() != 20 {
+		t.Errorf("pool_size = %d, want the API's 20", data.PoolSize.ValueInt64())
+	}
+	if calls := mock.Calls(); len(calls) != 1 || calls[0] != "GetDatabase" {
+		t.Errorf("calls = %v, want a single GetDatabase", calls)
+	}
+}
+
+func TestDatabaseResource_ReadWithMock_NotFound(t *testing.T) {
+	ctx := context.Background()
+	mock := &clienttest.Mock{
+		GetDatabaseFunc: func(ctx context.Context, id string) (*client.DatabaseResponse, error) {
+			return nil, &client.NotFoundError{Resource: "database", ID: id}
+		},
+	}
+	r := &DatabaseResource{client: mock}
+
+	prior := sampleDatabaseModel(t)
+	state := newResourceState(t, r, &prior)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() errors: %v", resp.Diagnostics.Errors())
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("a database deleted outside Terraform should be removed from state")
+	}
+}
+
+func TestDatabaseResource_UpdateWithMock(t *testing.T) {
+	ctx := context.Background()
+	var updatedID string
+	var sent *client.DatabaseRequest
+	mock := &clienttest.Mock{
+		UpdateDatabaseFunc: func(ctx context.Context, id string, req *client.DatabaseRequest) (*client.DatabaseResponse, error) {
+			updatedID, sent = id, req
+			database := sampleDatabaseResponse()
+			database.Name = req.Name
+			return database, nil
+		},
+	}
+	r := &DatabaseResource{client: mock}
+
+	prior := sampleDatabaseModel(t)
+	state := newResourceState(t, r, &prior)
+	plan := sampleDatabaseModel(t)
+	plan.Name = types.StringValue("production-renamed")
+	planned := newResourceState(t, r, &plan)
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	if updatedID != "db-001" || sent.Name != "production-renamed" {
+		t.Errorf("updated %q with %+v, want db-001 renamed", updatedID, sent)
+	}
+	if len(sent.ReplicationSlots) != 1 || sent.ReplicationSlots[0].ID != "slot-001" {
+		t.Errorf("replication slots = %+v, want the existing slot ID sent", sent.ReplicationSlots)
+	}
+
+	var data DatabaseResourceModel
+	resp.State.Get(ctx, &data)
+	if data.Name.ValueString() != "production-renamed" {
+		t.Errorf("name = %s, want the updated name", data.Name)
+	}
+	if calls := mock.Calls(); len(calls) != 1 || calls[0] != "UpdateDatabase" {
+		t.Errorf("calls = %v, want a single UpdateDatabase", calls)
+	}
+}
+
+func TestDatabaseResource_DeleteWithMock(t *testing.T) {
+	ctx := context.Background()
+	var deletedID string
+	mock := &clienttest.Mock{
+		DeleteDatabaseFunc: func(ctx context.Context, id string) error {
+			deletedID = id
+			return nil
+		},
+	}
+	r := &DatabaseResource{client: mock}
+
+	prior := sampleDatabaseModel(t)
+	state := newResourceState(t, r, &prior)
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Delete() errors: %v", resp.Diagnostics.Errors())
+	}
+	if deletedID != "db-001" {
+		t.Errorf("deleted %q, want db-001", deletedID)
+	}
+	if calls := mock.Calls(); len(calls) != 1 || calls[0] != "DeleteDatabase" {
+		t.Errorf("calls = %v, want a single DeleteDatabase", calls)
+	}
+}
+
+func TestDatabaseResource_DeleteNamesBlockingSinksAfterTimeout(t *testing.T) {
+	ctx := context.Background()
+	mock := &clienttest.Mock{
+		DeleteDatabaseFunc: func(ctx context.Context, id string) error {
+			// Slow answers run the last attempt past the delete timeout
+			time.Sleep(30 * time.Millisecond)
+			return &client.DependencyError{Err: &client.APIError{StatusCode: 409, Body: "database still has sinks"}}
+		},
+		ListSinkConsumersFunc: func(ctx context.Context, opts client.SinkConsumerListOptions) ([]client.SinkConsumerResponse, error) {
+			// Like a real request, listing fails once the context is done
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return []client.SinkConsumerResponse{
+				{Name: "orders", Database: "db-001"},
+				{Name: "other", Database: "staging"},
+			}, nil
+		},
+	}
+	r := &DatabaseResource{client: mock}
+
+	prior := sampleDatabaseModel(t)
+	prior.Timeouts = types.ObjectValueMust(timeoutsAttrTypes, map[string]attr.Value{
+		operationCreate: types.StringNull(),
+		operationRead:   types.StringNull(),
+		operationUpdate: types.StringNull(),
+		operationDelete: types.StringValue("50ms"),
+	})
+	state := newResourceState(t, r, &prior)
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Delete() should fail while sinks depend on the database")
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "Blocking sink consumers: orders.") {
+		t.Errorf("detail = %q, want the blocking sink named after the delete timeout expired", detail)
+	}
+}
@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// When a whole stack is destroyed, Terraform may delete a database while its
+// sink consumers are still being torn down in parallel. Delete keeps retrying
+// while the API reports dependent sinks, for the resource's delete timeout or
+// defaultDependencyWait when none is configured. The blocking sinks are then
+// listed within dependentsListTimeout, since the delete context has usually
+// expired by then.
+const (
+	defaultDependencyWait  = 5 * time.Minute
+	dependencyPollInterval = 5 * time.Second
+	dependentsListTimeout  = 30 * time.Second
+)
+
+// dependencyWait returns how long a delete waits for dependents to go away
+func dependencyWait(timeouts types.Object) time.Duration {
+	if d := operationTimeout(timeouts, operationDelete); d > 0 {
+		return d
+	}
+	return defaultDependencyWait
+}
+
+// deleteWithDependencyRetry calls deleteFn until it succeeds, fails with an
+// error other than a dependency error, or the wait elapses. Polls are spaced
+// by dependencyPollInterval, or a tenth of a shorter wait. The last error is
+// returned.
+func deleteWithDependencyRetry(ctx context.Context, id string, wait time.Duration, deleteFn func() error) error {
+	deadline := time.Now().Add(wait)
+	interval := min(dependencyPollInterval, wait/10)
+
+	for {
+		err := deleteFn()
+		if err == nil || !client.IsDependencyError(err) {
+			return err
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return err
+		}
+
+		tflog.Info(ctx, "Delete blocked by dependent sink consumers, retrying", map[string]any{
+			"id":       id,
+			"interval": interval.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(interval):
+		}
+	}
+}
+
+// sinksUsingDatabase returns the sorted names of the sink consumers attached to
+// the database identified by name or ID
+func sinksUsingDatabase(sinks []client.SinkConsumerResponse, dbName, dbID string) []string {
+	var names []string
+	for _, sink := range sinks {
+		if sink.Database == "" {
+			continue
+		}
+		if sink.Database == dbName || sink.Database == dbID {
+			names = append(names, sink.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDeleteWithDependencyRetry_RetriesUntilDependentsGone(t *testing.T) {
+	calls := 0
+	err := deleteWithDependencyRetry(context.Background(), "db-001", time.Second, func() error {
+		calls++
+		if calls < 3 {
+			return &client.DependencyError{Err: &client.APIError{StatusCode: 409, Body: "database still has sinks"}}
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("deleteWithDependencyRetry() error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+func TestDeleteWithDependencyRetry_GivesUpAfterTimeout(t *testing.T) {
+	err := deleteWithDependencyRetry(context.Background(), "db-001", 10*time.Millisecond, func() error {
+		return &client.DependencyError{Err: &client.APIError{StatusCode: 409, Body: "database still has sinks"}}
+	})
+
+	if !client.IsDependencyError(err) {
+		t.Errorf("expected dependency error after timeout, got %v", err)
+	}
+}
+
+func TestDeleteWithDependencyRetry_OtherErrorsNotRetried(t *testing.T) {
+	calls := 0
+	err := deleteWithDependencyRetry(context.Background(), "db-001", time.Minute, func() error {
+		calls++
+		return errors.New("connection refused")
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestDependencyWait(t *testing.T) {
+	if got := dependencyWait(types.ObjectNull(timeoutsAttrTypes)); got != defaultDependencyWait {
+		t.Errorf("dependencyWait() without timeouts = %s, want %s", got, defaultDependencyWait)
+	}
+
+	timeouts, diags := types.ObjectValue(timeoutsAttrTypes, map[string]attr.Value{
+		operationCreate: types.StringNull(),
+		operationRead:   types.StringNull(),
+		operationUpdate: types.StringNull(),
+		operationDelete: types.StringValue("20m"),
+	})
+	if diags.HasError() {
+		t.Fatalf("timeouts: %v", diags.Errors())
+	}
+	if got := dependencyWait(timeouts); got != 20*time.Minute {
+		t.Errorf("dependencyWait() = %s, want the 20m delete timeout", got)
+	}
+}
+
+func TestSinksUsingDatabase(t *testing.T) {
+	sinks := []client.SinkConsumerResponse{
+		{Name: "users", Database: "production"},
+		{Name: "audit", Database: "db-001"},
+		{Name: "orders", Database: "production"},
+		{Name: "other", Database: "staging"},
+	}
+
+	got := sinksUsingDatabase(sinks, "production", "db-001")
+
+	want := []string{"audit", "orders", "users"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// On busy Sequin instances a GET issued right after a POST can briefly return
+// 404. Within createConsistencyWindow of a create, Read retries not-found
+// responses instead of removing the resource from state.
+var (
+	createConsistencyWindow   = 2 * time.Minute
+	createConsistencyAttempts = 5
+	createConsistencyDelay    = 2 * time.Second
+)
+
+// markCreated records the time this provider created the resource
+func (s *resourcePrivateState) markCreated() {
+	s.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+}
+
+// recentlyCreated reports whether the resource was created by this provider
+// within the eventual consistency window
+func (s *resourcePrivateState) recentlyCreated() bool {
+	if s.CreatedAt == "" {
+		return false
+	}
+	created, err := time.Parse(time.RFC3339, s.CreatedAt)
+	if err != nil {
+		return false
+	}
+	return time.Since(created) < createConsistencyWindow
+}
+
+// getWithCreateGrace calls get, retrying not-found errors for a bounded number
+// of attempts when the resource was only just created
+func getWithCreateGrace[T any](ctx context.Context, privateState *resourcePrivateState, id string, get func() (T, error)) (T, error) {
+	result, err := get()
+	if !client.IsNotFoundError(err) || !privateState.recentlyCreated() {
+		return result, err
+	}
+
+	for attempt := 2; attempt <= createConsistencyAttempts; attempt++ {
+		tflog.Debug(ctx, "Recently created resource not found yet, retrying", map[string]any{
+			"id":      id,
+			"attempt": attempt,
+		})
+
+		select {
+		case <-ctx.Done():
+			return result, err
+		case <-time.After(createConsistencyDelay):
+		}
+
+		result, err = get()
+		if !client.IsNotFoundError(err) {
+			return result, err
+		}
+	}
+
+	return result, err
+}
@@ -0,0 +1,93 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+)
+
+func TestGetWithCreateGrace_RetriesNotFoundAfterCreate(t *testing.T) {
+	defer func(delay time.Duration) { createConsistencyDelay = delay }(createConsistencyDelay)
+	createConsistencyDelay = time.Millisecond
+
+	state := &resourcePrivateState{}
+	state.markCreated()
+
+	calls := 0
+	got, err := getWithCreateGrace(context.Background(), state, "db-001", func() (string, error) {
+		calls++
+		if calls < 3 {
+			return "", &client.NotFoundError{Resource: "database", ID: "db-001"}
+		}
+		return "db-001", nil
+	})
+
+	if err != nil {
+		t.Fatalf("getWithCreateGrace() error: %v", err)
+	}
+	if got != "db-001" || calls != 3 {
+		t.Errorf("got %q after %d calls, want db-001 after 3", got, calls)
+	}
+}
+
+func TestGetWithCreateGrace_BoundedAttempts(t *testing.T) {
+	defer func(delay time.Duration) { createConsistencyDelay = delay }(createConsistencyDelay)
+	createConsistencyDelay = time.Millisecond
+
+	state := &resourcePrivateState{}
+	state.markCreated()
+
+	calls := 0
+	_, err := getWithCreateGrace(context.Background(), state, "db-001", func() (string, error) {
+		calls++
+		return "", &client.NotFoundError{Resource: "database", ID: "db-001"}
+	})
+
+	if err == nil {
+		t.Fatal("expected not found error once attempts are exhausted")
+	}
+	if calls != createConsistencyAttempts {
+		t.Errorf("calls = %d, want %d", calls, createConsistencyAttempts)
+	}
+}
+
+func TestGetWithCreateGrace_NoRetryForOlderResources(t *testing.T) {
+	state := &resourcePrivateState{CreatedAt: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)}
+
+	calls := 0
+	_, err := getWithCreateGrace(context.Background(), state, "db-001", func() (string, error) {
+		calls++
+		return "", &client.NotFoundError{Resource: "database", ID: "db-001"}
+	})
+
+	if err == nil {
+		t.Fatal("expected not found error")
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1 (deleted outside Terraform should not be retried)", calls)
+	}
+}
+
+func TestRecentlyCreated(t *testing.T) {
+	tests := []struct {
+		name      string
+		createdAt string
+		want      bool
+	}{
+		{"never recorded", "", false},
+		{"just now", time.Now().UTC().Format(time.RFC3339), true},
+		{"long ago", "2020-01-01T00:00:00Z", false},
+		{"invalid", "yesterday", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &resourcePrivateState{CreatedAt: tt.createdAt}
+			if got := state.recentlyCreated(); got != tt.want {
+				t.Errorf("recentlyCreated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Supported failed message remediation operations
+const (
+	failedMessagesRedeliver = "redeliver"
+	failedMessagesPurge     = "purge"
+)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ resource.Resource              = &FailedMessagesRemediationResource{}
+	_ resource.ResourceWithConfigure = &FailedMessagesRemediationResource{}
+)
+
+// FailedMessagesRemediationResource runs a one-shot redeliver or purge of a
+// sink consumer's failed messages. Every argument forces replacement, so
+// changing triggers (or the message selection) runs the operation again.
+type FailedMessagesRemediationResource struct {
+	client client.SequinAPI
+}
+
+// FailedMessagesRemediationResourceModel describes the resource data model
+type FailedMessagesRemediationResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	SinkConsumer  types.String `tfsdk:"sink_consumer"`
+	Operation     types.String `tfsdk:"operation"`
+	MessageIDs    types.List   `tfsdk:"message_ids"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	AffectedCount types.Int64  `tfsdk:"affected_count"`
+}
+
+// NewFailedMessagesRemediationResource creates a new resource
+func NewFailedMessagesRemediationResource() resource.Resource {
+	return &FailedMessagesRemediationResource{}
+}
+
+// Metadata returns the resource type name
+func (r *FailedMessagesRemediationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_failed_messages_remediation"
+}
+
+// Schema defines the resource schema
+func (r *FailedMessagesRemediationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Re-delivers or purges messages in a sink consumer's failed set. The operation runs when the resource is created; change triggers to run it again. Destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this remediation run.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sink_consumer": schema.StringAttribute{
+				Description: "Name or ID of the sink consumer.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation": schema.StringAttribute{
+				Description: "Operation to run: redeliver, purge.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(failedMessagesRedeliver, failedMessagesPurge),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message_ids": schema.ListAttribute{
+				Description: "IDs of the messages to act on. Acts on every failed message when omitted.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that, when changed, run the operation again.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"affected_count": schema.Int64Attribute{
+				Description: "Number of messages the operation affected.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the resource
+func (r *FailedMessagesRemediationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create runs the remediation operation
+func (r *FailedMessagesRemediationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_failed_messages_remediation", "create")
+
+	var data FailedMessagesRemediationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actionReq := buildFailedMessageActionRequest(ctx, data.MessageIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sinkConsumer := data.SinkConsumer.ValueString()
+	operation := data.Operation.ValueString()
+
+	var result *client.FailedMessageActionResponse
+	var err error
+	switch operation {
+	case failedMessagesRedeliver:
+		result, err = r.client.RedeliverFailedMessages(ctx, sinkConsumer, actionReq)
+	case failedMessagesPurge:
+		result, err = r.client.PurgeFailedMessages(ctx, sinkConsumer, actionReq)
+	default:
+		err = fmt.Errorf("unsupported operation %q", operation)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Remediating Failed Messages",
+			fmt.Sprintf("Could not %s failed messages for sink consumer %s: %s", operation, sinkConsumer, err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", sinkConsumer, operation, time.Now().UTC().Format(time.RFC3339Nano)))
+	data.AffectedCount = types.Int64Value(int64(result.Count))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Info(ctx, "Remediated failed messages", map[string]any{
+		"sink_consumer": sinkConsumer,
+		"operation":     operation,
+		"count":         result.Count,
+	})
+}
+
+// Read keeps the recorded run as-is; there is nothing remote to refresh
+func (r *FailedMessagesRemediationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update is never called because every argument requires replacement
+func (r *FailedMessagesRemediationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Unexpected Update",
+		"sequin_failed_messages_remediation does not support in-place updates. Please report this issue to the provider developers.",
+	)
+}
+
+// Delete removes the run from state without calling the API
+func (r *FailedMessagesRemediationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing failed message remediation from state")
+}
+
+// buildFailedMessageActionRequest converts the configured message IDs into an
+// API request. A null or empty list selects every failed message.
+func buildFailedMessageActionRequest(ctx context.Context, messageIDs types.List, diags *diag.Diagnostics) *client.FailedMessageActionRequest {
+	req := &client.FailedMessageActionRequest{}
+	if messageIDs.IsNull() || messageIDs.IsUnknown() {
+		return req
+	}
+	diags.Append(messageIDs.ElementsAs(ctx, &req.MessageIDs, false)...)
+	return req
+}
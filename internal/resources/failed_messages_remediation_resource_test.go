@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFailedMessagesRemediationResource_Metadata(t *testing.T) {
+	r := NewFailedMessagesRemediationResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_failed_messages_remediation" {
+		t.Errorf("TypeName = %q, want sequin_failed_messages_remediation", resp.TypeName)
+	}
+}
+
+func TestFailedMessagesRemediationResource_Schema(t *testing.T) {
+	r := NewFailedMessagesRemediationResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+
+	for _, attr := range []string{"id", "sink_consumer", "operation", "message_ids", "triggers", "affected_count"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+
+	// Every argument must force replacement so that Update is never planned
+	for _, field := range []string{"sink_consumer", "operation"} {
+		if len(resp.Schema.Attributes[field].(schema.StringAttribute).PlanModifiers) == 0 {
+			t.Errorf("field %s should have plan modifiers", field)
+		}
+	}
+	if len(resp.Schema.Attributes["message_ids"].(schema.ListAttribute).PlanModifiers) == 0 {
+		t.Error("field message_ids should have plan modifiers")
+	}
+	if len(resp.Schema.Attributes["triggers"].(schema.MapAttribute).PlanModifiers) == 0 {
+		t.Error("field triggers should have plan modifiers")
+	}
+}
+
+func TestBuildFailedMessageActionRequest(t *testing.T) {
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	req := buildFailedMessageActionRequest(ctx, types.ListNull(types.StringType), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(req.MessageIDs) != 0 {
+		t.Errorf("null list should select every message, got %v", req.MessageIDs)
+	}
+
+	ids := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("msg-001"),
+		types.StringValue("msg-002"),
+	})
+	req = buildFailedMessageActionRequest(ctx, ids, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(req.MessageIDs) != 2 || req.MessageIDs[0] != "msg-001" {
+		t.Errorf("MessageIDs = %v, want [msg-001 msg-002]", req.MessageIDs)
+	}
+}
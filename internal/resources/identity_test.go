@@ -0,0 +1,125 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// identityResources lists the resources that support import by identity
+var identityResources = map[string]func() resource.Resource{
+	"sequin_database":      NewDatabaseResource,
+	"sequin_sink_consumer": NewSinkConsumerResource,
+}
+
+func TestResourceIdentitySchema(t *testing.T) {
+	ctx := context.Background()
+
+	for name, newResource := range identityResources {
+		t.Run(name, func(t *testing.T) {
+			r, ok := newResource().(resource.ResourceWithIdentity)
+			if !ok {
+				t.Fatalf("%s does not implement ResourceWithIdentity", name)
+			}
+
+			resp := &resource.IdentitySchemaResponse{}
+			r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("IdentitySchema() error: %v", resp.Diagnostics.Errors())
+			}
+
+			attr, ok := resp.IdentitySchema.Attributes["id"].(identityschema.StringAttribute)
+			if !ok {
+				t.Fatal("identity schema missing string attribute id")
+			}
+			if !attr.RequiredForImport {
+				t.Error("identity id should be required for import")
+			}
+		})
+	}
+}
+
+func TestSetResourceIdentity(t *testing.T) {
+	ctx := context.Background()
+	identitySchema := idIdentitySchema("ID.")
+
+	// Terraform versions without identity support
+	if diags := setResourceIdentity(ctx, nil, types.StringValue("abc")); diags.HasError() {
+		t.Errorf("setResourceIdentity() with nil identity error: %v", diags.Errors())
+	}
+
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchema,
+		Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil),
+	}
+
+	// Unknown IDs leave the identity untouched
+	if diags := setResourceIdentity(ctx, identity, types.StringUnknown()); diags.HasError() {
+		t.Fatalf("setResourceIdentity() error: %v", diags.Errors())
+	}
+	if !identity.Raw.IsNull() {
+		t.Error("identity should stay null for an unknown ID")
+	}
+
+	if diags := setResourceIdentity(ctx, identity, types.StringValue("abc")); diags.HasError() {
+		t.Fatalf("setResourceIdentity() error: %v", diags.Errors())
+	}
+	var got types.String
+	identity.GetAttribute(ctx, path.Root("id"), &got)
+	if got.ValueString() != "abc" {
+		t.Errorf("identity id = %q, want abc", got.ValueString())
+	}
+}
+
+func TestImportStateByIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	for name, newResource := range identityResources {
+		t.Run(name, func(t *testing.T) {
+			r := newResource().(resource.ResourceWithIdentity)
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			identityResp := &resource.IdentitySchemaResponse{}
+			r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+
+			identitySchema := identityResp.IdentitySchema
+			identityType := identitySchema.Type().TerraformType(ctx)
+			req := resource.ImportStateRequest{
+				Identity: &tfsdk.ResourceIdentity{
+					Schema: identitySchema,
+					Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "abc"),
+					}),
+				},
+			}
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+				Identity: &tfsdk.ResourceIdentity{
+					Schema: identitySchema,
+					Raw:    req.Identity.Raw.Copy(),
+				},
+			}
+
+			r.(resource.ResourceWithImportState).ImportState(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ImportState() error: %v", resp.Diagnostics.Errors())
+			}
+
+			var id types.String
+			resp.State.GetAttribute(ctx, path.Root("id"), &id)
+			if id.ValueString() != "abc" {
+				t.Errorf("imported id = %q, want abc", id.ValueString())
+			}
+		})
+	}
+}
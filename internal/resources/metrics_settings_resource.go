@@ -0,0 +1,269 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// metricsSettingsID is the fixed ID of the account-wide metrics settings
+const metricsSettingsID = "metrics"
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ resource.Resource                = &MetricsSettingsResource{}
+	_ resource.ResourceWithConfigure   = &MetricsSettingsResource{}
+	_ resource.ResourceWithImportState = &MetricsSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &MetricsSettingsResource{}
+)
+
+// MetricsSettingsResource manages the metrics endpoint of a self-hosted Sequin
+// instance. The settings are a singleton: Create adopts and updates them, and
+// Delete leaves them in place.
+type MetricsSettingsResource struct {
+	client client.SequinAPI
+}
+
+// MetricsSettingsResourceModel describes the resource data model
+type MetricsSettingsResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	AuthToken    types.String `tfsdk:"auth_token"`
+	AuthRequired types.Bool   `tfsdk:"auth_required"`
+	Endpoint     types.String `tfsdk:"endpoint"`
+}
+
+// NewMetricsSettingsResource creates a new resource
+func NewMetricsSettingsResource() resource.Resource {
+	return &MetricsSettingsResource{}
+}
+
+// Metadata returns the resource type name
+func (r *MetricsSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics_settings"
+}
+
+// Schema defines the resource schema
+func (r *MetricsSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages exposure and authentication of the Prometheus metrics endpoint on a self-hosted Sequin instance. Only one instance of this resource should exist per Sequin instance; destroying it leaves the current settings in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Always \"metrics\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the metrics endpoint is exposed.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auth_token": schema.StringAttribute{
+				Description: "Bearer token scrapers must present. Omit to leave the current token unchanged; set to an empty string to remove it.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"auth_required": schema.BoolAttribute{
+				Description: "Whether the metrics endpoint requires a bearer token.",
+				Computed:    true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "URL the metrics are served from.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the resource
+func (r *MetricsSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create applies the configured metrics settings
+func (r *MetricsSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_metrics_settings", "create")
+
+	var data MetricsSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateMetricsSettings(ctx, buildMetricsSettingsRequest(&data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Configuring Metrics Settings",
+			"Could not update metrics settings: "+err.Error(),
+		)
+		return
+	}
+
+	mapMetricsSettingsToModel(updated, &data)
+
+	privateState := &resourcePrivateState{ETag: updated.ETag}
+	privateState.recordSecrets(metricsSettingsSecrets(&data), updated.AuthTokenFingerprint)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Info(ctx, "Configured metrics settings", map[string]any{"enabled": updated.Enabled})
+}
+
+// Read refreshes the Terraform state with the latest data from the API
+func (r *MetricsSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = traceOperation(ctx, "sequin_metrics_settings", "read")
+
+	var data MetricsSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetMetricsSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Metrics Settings",
+			"Could not read metrics settings: "+err.Error(),
+		)
+		return
+	}
+
+	mapMetricsSettingsToModel(settings, &data)
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	privateState.recordVersion(settings)
+
+	// The token is write-only, so out-of-band rotation is only visible through
+	// the server fingerprint
+	if privateState.secretsDrifted(metricsSettingsSecrets(&data), settings.AuthTokenFingerprint) {
+		resp.Diagnostics.AddWarning(
+			"Metrics Auth Token Drift Detected",
+			"The metrics auth token was changed outside of Terraform. "+
+				"The configured token will be re-applied on the next apply.",
+		)
+		data.AuthToken = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update applies changed metrics settings
+func (r *MetricsSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = traceOperation(ctx, "sequin_metrics_settings", "update")
+
+	var plan MetricsSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	updated, err := updateWithConflictRetry(ctx, metricsSettingsID, privateState,
+		func(ctx context.Context) (*client.MetricsSettingsResponse, error) {
+			return r.client.GetMetricsSettings(ctx)
+		},
+		func(ctx context.Context) (*client.MetricsSettingsResponse, error) {
+			return r.client.UpdateMetricsSettings(ctx, buildMetricsSettingsRequest(&plan))
+		},
+	)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating Metrics Settings", "Could not update metrics settings", err)
+		return
+	}
+
+	mapMetricsSettingsToModel(updated, &plan)
+
+	privateState.recordSecrets(metricsSettingsSecrets(&plan), updated.AuthTokenFingerprint)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Updated metrics settings", map[string]any{"enabled": updated.Enabled})
+}
+
+// Delete removes the settings from state. The metrics endpoint keeps its
+// current configuration so destroying a stack never silently drops auth.
+func (r *MetricsSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removed metrics settings from state; remote settings left unchanged")
+}
+
+// ImportState imports the existing metrics settings.
+// Import format: any value, e.g. "metrics"
+func (r *MetricsSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), metricsSettingsID)...)
+}
+
+// ModifyPlan rejects the settings when the provider targets Sequin Cloud,
+// which does not expose a metrics endpoint
+func (r *MetricsSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	requireSelfHosted(r.client, "sequin_metrics_settings", path.Root("enabled"), &resp.Diagnostics)
+}
+
+// buildMetricsSettingsRequest converts the model into an API request. A null
+// auth_token leaves the current token untouched.
+func buildMetricsSettingsRequest(data *MetricsSettingsResourceModel) *client.MetricsSettingsRequest {
+	req := &client.MetricsSettingsRequest{}
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		enabled := data.Enabled.ValueBool()
+		req.Enabled = &enabled
+	}
+	if !data.AuthToken.IsNull() && !data.AuthToken.IsUnknown() {
+		token := data.AuthToken.ValueString()
+		req.AuthToken = &token
+	}
+	return req
+}
+
+// mapMetricsSettingsToModel maps the API response to the Terraform model. The
+// auth token is write-only and kept from plan/state.
+func mapMetricsSettingsToModel(settings *client.MetricsSettingsResponse, data *MetricsSettingsResourceModel) {
+	data.ID = types.StringValue(metricsSettingsID)
+	data.Enabled = types.BoolValue(settings.Enabled)
+	data.AuthRequired = types.BoolValue(settings.AuthRequired)
+	data.Endpoint = types.StringValue(settings.Endpoint)
+}
+
+// metricsSettingsSecrets returns the secret values tracked for drift detection
+func metricsSettingsSecrets(model *MetricsSettingsResourceModel) map[string]string {
+	return stringSecrets(map[string]types.String{
+		"auth_token": model.AuthToken,
+	})
+}
@@ -0,0 +1,82 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMetricsSettingsResource_Metadata(t *testing.T) {
+	r := NewMetricsSettingsResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_metrics_settings" {
+		t.Errorf("TypeName = %q, want sequin_metrics_settings", resp.TypeName)
+	}
+}
+
+func TestMetricsSettingsResource_Schema(t *testing.T) {
+	r := NewMetricsSettingsResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "enabled", "auth_token", "auth_required", "endpoint"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+	if !resp.Schema.Attributes["auth_token"].(schema.StringAttribute).Sensitive {
+		t.Error("auth_token should be sensitive")
+	}
+}
+
+func TestBuildMetricsSettingsRequest(t *testing.T) {
+	req := buildMetricsSettingsRequest(&MetricsSettingsResourceModel{
+		Enabled:   types.BoolUnknown(),
+		AuthToken: types.StringNull(),
+	})
+	if req.Enabled != nil || req.AuthToken != nil {
+		t.Errorf("unset attributes should be omitted, got %+v", req)
+	}
+
+	req = buildMetricsSettingsRequest(&MetricsSettingsResourceModel{
+		Enabled:   types.BoolValue(false),
+		AuthToken: types.StringValue(""),
+	})
+	if req.Enabled == nil || *req.Enabled {
+		t.Error("Enabled should be sent as false")
+	}
+	if req.AuthToken == nil || *req.AuthToken != "" {
+		t.Error("empty auth_token should be sent to remove the token")
+	}
+}
+
+func TestMapMetricsSettingsToModel_KeepsAuthToken(t *testing.T) {
+	data := MetricsSettingsResourceModel{AuthToken: types.StringValue("s3cret")}
+
+	mapMetricsSettingsToModel(&client.MetricsSettingsResponse{
+		Enabled:      true,
+		Endpoint:     "http://sequin:8376/metrics",
+		AuthRequired: true,
+	}, &data)
+
+	if data.ID.ValueString() != metricsSettingsID {
+		t.Errorf("ID = %q, want %q", data.ID.ValueString(), metricsSettingsID)
+	}
+	if data.AuthToken.ValueString() != "s3cret" {
+		t.Error("auth_token should be kept from plan/state")
+	}
+	if !data.AuthRequired.ValueBool() || data.Endpoint.ValueString() != "http://sequin:8376/metrics" {
+		t.Errorf("unexpected mapping: %+v", data)
+	}
+}
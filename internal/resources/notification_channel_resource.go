@@ -0,0 +1,330 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ resource.Resource                = &NotificationChannelResource{}
+	_ resource.ResourceWithConfigure   = &NotificationChannelResource{}
+	_ resource.ResourceWithImportState = &NotificationChannelResource{}
+)
+
+// NotificationChannelResource defines the resource implementation
+type NotificationChannelResource struct {
+	client client.SequinAPI
+}
+
+// NotificationChannelResourceModel describes the resource data model
+type NotificationChannelResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Type            types.String `tfsdk:"type"`
+	SlackWebhookURL types.String `tfsdk:"slack_webhook_url"`
+	WebhookURL      types.String `tfsdk:"webhook_url"`
+	WebhookToken    types.String `tfsdk:"webhook_token"`
+	EmailAddresses  types.List   `tfsdk:"email_addresses"`
+}
+
+// NewNotificationChannelResource creates a new resource
+func NewNotificationChannelResource() resource.Resource {
+	return &NotificationChannelResource{}
+}
+
+// Metadata returns the resource type name
+func (r *NotificationChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+// Schema defines the resource schema
+func (r *NotificationChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a notification channel that Sequin alerts are delivered to: a Slack incoming webhook, an HTTP webhook, or email.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the notification channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the notification channel.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Channel type: slack, webhook, email. Forces replacement on change.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("slack", "webhook", "email"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"slack_webhook_url": schema.StringAttribute{
+				Description: "Slack incoming webhook URL (slack).",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"webhook_url": schema.StringAttribute{
+				Description: "URL alert notifications are POSTed to (webhook).",
+				Optional:    true,
+			},
+			"webhook_token": schema.StringAttribute{
+				Description: "Bearer token sent with every notification (webhook).",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"email_addresses": schema.ListAttribute{
+				Description: "Email addresses notified (email).",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the resource
+func (r *NotificationChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new notification channel
+func (r *NotificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_notification_channel", "create")
+
+	var data NotificationChannelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := buildNotificationChannelRequest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateNotificationChannel(ctx, createReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Creating Notification Channel", "Could not create notification channel", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapNotificationChannelToModel(ctx, created, &data)...)
+
+	privateState := &resourcePrivateState{ETag: created.ETag}
+	privateState.markCreated()
+	privateState.recordSecrets(notificationChannelSecrets(&data), created.SecretFingerprint)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	rollbackCreate(ctx, r.client.CreateRollbackSkipped(), "notification channel", created.ID, &resp.Diagnostics, &resp.State, func(ctx context.Context) error {
+		return r.client.DeleteNotificationChannel(ctx, created.ID)
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created notification channel resource", map[string]any{"id": data.ID.ValueString()})
+}
+
+// Read refreshes the Terraform state with the latest data from the API
+func (r *NotificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = traceOperation(ctx, "sequin_notification_channel", "read")
+
+	var data NotificationChannelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	channelID := data.ID.ValueString()
+	channel, err := getWithCreateGrace(ctx, privateState, channelID, func() (*client.NotificationChannelResponse, error) {
+		return r.client.GetNotificationChannel(ctx, channelID)
+	})
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Notification channel not found, removing from state", map[string]any{"id": channelID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Notification Channel",
+			"Could not read notification channel ID "+channelID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapNotificationChannelToModel(ctx, channel, &data)...)
+
+	privateState.recordVersion(channel)
+
+	// Secrets are write-only, so out-of-band rotation is only visible
+	// through the server fingerprint
+	if privateState.secretsDrifted(notificationChannelSecrets(&data), channel.SecretFingerprint) {
+		resp.Diagnostics.AddWarning(
+			"Notification Channel Secret Drift Detected",
+			"The secrets for notification channel ID "+channelID+" were changed outside of Terraform. "+
+				"The configured secrets will be re-applied on the next apply.",
+		)
+		data.SlackWebhookURL = types.StringNull()
+		data.WebhookToken = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates an existing notification channel
+func (r *NotificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = traceOperation(ctx, "sequin_notification_channel", "update")
+
+	var plan, state NotificationChannelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := buildNotificationChannelRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := state.ID.ValueString()
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	updated, err := updateWithConflictRetry(ctx, channelID, privateState,
+		func(ctx context.Context) (*client.NotificationChannelResponse, error) {
+			return r.client.GetNotificationChannel(ctx, channelID)
+		},
+		func(ctx context.Context) (*client.NotificationChannelResponse, error) {
+			return r.client.UpdateNotificationChannel(ctx, channelID, updateReq)
+		},
+	)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating Notification Channel", "Could not update notification channel ID "+channelID, err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapNotificationChannelToModel(ctx, updated, &plan)...)
+
+	privateState.recordSecrets(notificationChannelSecrets(&plan), updated.SecretFingerprint)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Updated notification channel resource", map[string]any{"id": channelID})
+}
+
+// Delete deletes a notification channel
+func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = traceOperation(ctx, "sequin_notification_channel", "delete")
+
+	var data NotificationChannelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := data.ID.ValueString()
+	if err := r.client.DeleteNotificationChannel(ctx, channelID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Notification Channel",
+			"Could not delete notification channel ID "+channelID+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted notification channel", map[string]any{"id": channelID})
+}
+
+// ImportState imports an existing notification channel by ID
+func (r *NotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// buildNotificationChannelRequest converts the model into an API request
+func buildNotificationChannelRequest(ctx context.Context, data *NotificationChannelResourceModel, diags *diag.Diagnostics) *client.NotificationChannelRequest {
+	req := &client.NotificationChannelRequest{
+		Name:            data.Name.ValueString(),
+		Type:            data.Type.ValueString(),
+		SlackWebhookURL: data.SlackWebhookURL.ValueString(),
+		WebhookURL:      data.WebhookURL.ValueString(),
+		WebhookToken:    data.WebhookToken.ValueString(),
+	}
+	if !data.EmailAddresses.IsNull() && !data.EmailAddresses.IsUnknown() {
+		diags.Append(data.EmailAddresses.ElementsAs(ctx, &req.EmailAddresses, false)...)
+	}
+	return req
+}
+
+// mapNotificationChannelToModel maps the API response to the Terraform model.
+// The Slack webhook URL and webhook token are write-only and kept from
+// plan/state.
+func mapNotificationChannelToModel(ctx context.Context, channel *client.NotificationChannelResponse, data *NotificationChannelResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(channel.ID)
+	data.Name = types.StringValue(channel.Name)
+	data.Type = types.StringValue(channel.Type)
+	data.WebhookURL = optionalString(channel.WebhookURL)
+
+	if len(channel.EmailAddresses) == 0 {
+		data.EmailAddresses = types.ListNull(types.StringType)
+	} else {
+		list, d := types.ListValueFrom(ctx, types.StringType, channel.EmailAddresses)
+		diags.Append(d...)
+		data.EmailAddresses = list
+	}
+
+	return diags
+}
+
+// notificationChannelSecrets returns the secret values tracked for drift
+// detection
+func notificationChannelSecrets(model *NotificationChannelResourceModel) map[string]string {
+	return stringSecrets(map[string]types.String{
+		"slack_webhook_url": model.SlackWebhookURL,
+		"webhook_token":     model.WebhookToken,
+	})
+}
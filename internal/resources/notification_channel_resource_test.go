@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNotificationChannelResource_Metadata(t *testing.T) {
+	r := NewNotificationChannelResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_notification_channel" {
+		t.Errorf("TypeName = %q, want sequin_notification_channel", resp.TypeName)
+	}
+}
+
+func TestNotificationChannelResource_Schema(t *testing.T) {
+	r := NewNotificationChannelResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "name", "type", "slack_webhook_url", "webhook_url", "webhook_token", "email_addresses"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+	for _, secret := range []string{"slack_webhook_url", "webhook_token"} {
+		if !resp.Schema.Attributes[secret].(schema.StringAttribute).Sensitive {
+			t.Errorf("%s should be sensitive", secret)
+		}
+	}
+}
+
+func TestBuildNotificationChannelRequest(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	req := buildNotificationChannelRequest(ctx, &NotificationChannelResourceModel{
+		Name: types.StringValue("on-call"),
+		Type: types.StringValue("email"),
+		EmailAddresses: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("oncall@example.com"),
+		}),
+	}, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.Type != "email" || len(req.EmailAddresses) != 1 || req.EmailAddresses[0] != "oncall@example.com" {
+		t.Errorf("req = %+v, want email channel", req)
+	}
+	if req.SlackWebhookURL != "" || req.WebhookToken != "" {
+		t.Error("unset secrets should be empty")
+	}
+}
+
+func TestMapNotificationChannelToModel_PreservesSecrets(t *testing.T) {
+	ctx := context.Background()
+	data := NotificationChannelResourceModel{
+		WebhookToken:   types.StringValue("token"),
+		EmailAddresses: types.ListNull(types.StringType),
+	}
+
+	diags := mapNotificationChannelToModel(ctx, &client.NotificationChannelResponse{
+		ID:         "nch-1",
+		Name:       "pager",
+		Type:       "webhook",
+		WebhookURL: "https://hooks.example.com/sequin",
+	}, &data)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.WebhookToken.ValueString() != "token" {
+		t.Error("webhook_token should be kept from plan/state")
+	}
+	if !data.SlackWebhookURL.IsNull() {
+		t.Error("slack_webhook_url should stay null for a webhook channel")
+	}
+	if data.WebhookURL.ValueString() != "https://hooks.example.com/sequin" {
+		t.Errorf("WebhookURL = %q, want API value", data.WebhookURL.ValueString())
+	}
+	if !data.EmailAddresses.IsNull() {
+		t.Error("email_addresses should be null when the API returns none")
+	}
+}
+
+func TestNotificationChannelSecrets(t *testing.T) {
+	secrets := notificationChannelSecrets(&NotificationChannelResourceModel{
+		SlackWebhookURL: types.StringValue("https://hooks.slack.com/services/T000/B000/XXX"),
+		WebhookToken:    types.StringNull(),
+	})
+
+	if len(secrets) != 1 || secrets["slack_webhook_url"] == "" {
+		t.Errorf("secrets = %v, want only slack_webhook_url", secrets)
+	}
+}
@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// requireSelfHosted reports a feature used against Sequin Cloud that only
+// self-hosted instances offer
+func requireSelfHosted(c client.SequinAPI, feature string, at path.Path, diags *diag.Diagnostics) {
+	if c == nil || !c.IsCloud() {
+		return
+	}
+	diags.AddAttributeError(
+		at,
+		"Feature Not Available on Sequin Cloud",
+		fmt.Sprintf("%s is only available on self-hosted Sequin, but the provider is configured for Sequin Cloud (platform = %q).",
+			feature, client.PlatformCloud),
+	)
+}
+
+// requireReachableFromCloud reports hosts Sequin Cloud cannot connect to:
+// loopback, private and link-local addresses and local-only names. Unknown and
+// empty values are skipped.
+func requireReachableFromCloud(c client.SequinAPI, host types.String, at path.Path, diags *diag.Diagnostics) {
+	if c == nil || !c.IsCloud() || host.IsNull() || host.IsUnknown() {
+		return
+	}
+	if name := host.ValueString(); name != "" && privateNetworkHost(name) {
+		diags.AddAttributeError(
+			at,
+			"Host Not Reachable from Sequin Cloud",
+			fmt.Sprintf("%s is a local or private network address, which Sequin Cloud cannot connect to. "+
+				"Expose it publicly or through a tunnel, or use a self-hosted Sequin (platform = %q).",
+				name, client.PlatformSelfHosted),
+		)
+	}
+}
+
+// urlHost returns the host of a URL value, or an empty string when it is
+// unset, unknown or cannot be parsed
+func urlHost(value types.String) types.String {
+	if value.IsNull() || value.IsUnknown() {
+		return value
+	}
+	u, err := url.Parse(value.ValueString())
+	if err != nil {
+		return types.StringValue("")
+	}
+	return types.StringValue(u.Hostname())
+}
+
+// privateNetworkHost reports whether a host name or IP address (optionally
+// with a port) only resolves inside a local or private network
+func privateNetworkHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.Trim(host, "[]"))
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsUnspecified()
+	}
+	if host == "localhost" {
+		return true
+	}
+	for _, suffix := range []string{".localhost", ".local", ".internal"} {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+	return false
+}
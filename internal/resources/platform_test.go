@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPrivateNetworkHost(t *testing.T) {
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{"localhost", true},
+		{"localhost:5432", true},
+		{"127.0.0.1", true},
+		{"10.0.4.2:9092", true},
+		{"192.168.1.10", true},
+		{"169.254.169.254", true},
+		{"[::1]:5432", true},
+		{"db.internal", true},
+		{"kafka.local", true},
+		{"db.example.com", false},
+		{"db.example.com:5432", false},
+		{"34.120.1.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			if got := privateNetworkHost(tt.host); got != tt.want {
+				t.Errorf("privateNetworkHost(%q) = %v, want %v", tt.host, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDestinationPlatform(t *testing.T) {
+	plan := newRoundTripPlan(t, "kafka", map[string]attr.Value{
+		"hosts": types.StringValue("broker.example.com:9092, 10.0.0.5:9092"),
+	})
+	sqsPlan := newRoundTripPlan(t, "sqs", map[string]attr.Value{
+		"aws_credentials_source": types.StringValue("instance_profile"),
+	})
+	pubsubPlan := newRoundTripPlan(t, "gcp_pubsub", map[string]attr.Value{
+		"use_workload_identity": types.BoolValue(true),
+	})
+	eventHubPlan := newRoundTripPlan(t, "azure_event_hub", map[string]attr.Value{
+		"managed_identity_client_id": types.StringValue("00000000-0000-0000-0000-000000000000"),
+	})
+
+	tests := []struct {
+		name        string
+		platform    string
+		destination types.Object
+		wantErrors  int
+	}{
+		{"cloud private host", client.PlatformCloud, plan.Destination, 1},
+		{"self-hosted private host", client.PlatformSelfHosted, plan.Destination, 0},
+		{"cloud ambient credentials", client.PlatformCloud, sqsPlan.Destination, 1},
+		{"self-hosted ambient credentials", client.PlatformSelfHosted, sqsPlan.Destination, 0},
+		{"cloud workload identity", client.PlatformCloud, pubsubPlan.Destination, 1},
+		{"self-hosted workload identity", client.PlatformSelfHosted, pubsubPlan.Destination, 0},
+		{"cloud managed identity", client.PlatformCloud, eventHubPlan.Destination, 1},
+		{"self-hosted managed identity", client.PlatformSelfHosted, eventHubPlan.Destination, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			c := &client.Client{Platform: tt.platform}
+			validateDestinationPlatform(c, tt.destination, path.Root("destination"), &diags)
+			if got := diags.ErrorsCount(); got != tt.wantErrors {
+				t.Errorf("errors = %d, want %d: %v", got, tt.wantErrors, diags.Errors())
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// privateStateKey is the key under which API-only metadata is stored in the
+// framework's private state for each resource instance.
+const privateStateKey = "sequin"
+
+// privateStateReader is satisfied by the framework's private state on
+// requests (e.g. ReadRequest.Private, UpdateRequest.Private).
+type privateStateReader interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateWriter is satisfied by the framework's private state on
+// responses (e.g. CreateResponse.Private, ReadResponse.Private).
+type privateStateWriter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// resourcePrivateState holds server-side-only metadata tracked between
+// operations. It lives in private state so it never shows up in plans or
+// pollutes the public schema.
+type resourcePrivateState struct {
+	ETag      string `json:"etag,omitempty"`       // Entity tag from the last API response
+	UpdatedAt string `json:"updated_at,omitempty"` // Server-side update timestamp from the last API response
+	CreatedAt string `json:"created_at,omitempty"` // When this provider created the resource (see eventual_consistency.go)
+
+	// IgnoreRemoteStatus is set when status was omitted from the configuration
+	// at the last apply, so remote active/paused transitions are not drift.
+	IgnoreRemoteStatus bool `json:"ignore_remote_status,omitempty"`
+
+	// FunctionRefs maps function IDs used in configuration to the function
+	// names the API reports, so ID references don't show up as drift
+	FunctionRefs map[string]string `json:"function_refs,omitempty"`
+
+	// EndpointRefs maps HTTP endpoint names used in configuration to the
+	// endpoint IDs the API reports, so name references don't show up as drift
+	EndpointRefs map[string]string `json:"endpoint_refs,omitempty"`
+
+	// Credential drift detection (see secret_digest.go)
+	Salt              string            `json:"salt,omitempty"`               // Per-resource salt for secret digests
+	SecretDigests     map[string]string `json:"secret_digests,omitempty"`     // Attribute name to salted digest of the applied secret
+	SecretFingerprint string            `json:"secret_fingerprint,omitempty"` // Server credential fingerprint when secrets were last applied
+}
+
+// readPrivateState loads the resource's private state. A missing key yields
+// an empty value so callers never have to nil-check.
+func readPrivateState(ctx context.Context, reader privateStateReader) (*resourcePrivateState, diag.Diagnostics) {
+	state := &resourcePrivateState{}
+	if isNilPrivateState(reader) {
+		return state, nil
+	}
+
+	data, diags := reader.GetKey(ctx, privateStateKey)
+	if diags.HasError() || len(data) == 0 {
+		return state, diags
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		// Corrupt private state should never block an operation; start fresh.
+		return &resourcePrivateState{}, diags
+	}
+
+	return state, diags
+}
+
+// writePrivateState persists the resource's private state.
+func writePrivateState(ctx context.Context, writer privateStateWriter, state *resourcePrivateState) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if isNilPrivateState(writer) || state == nil {
+		return diags
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		diags.AddError(
+			"Error Saving Private State",
+			fmt.Sprintf("Could not encode private state: %s", err),
+		)
+		return diags
+	}
+
+	return writer.SetKey(ctx, privateStateKey, data)
+}
+
+// recordVersion stores the entity tag of the resource version a response
+// describes, so the next update is conditional on it
+func (s *resourcePrivateState) recordVersion(v client.EntityTagged) {
+	s.ETag = v.EntityTag()
+}
+
+// conditional returns a context whose updates only apply if the resource is
+// still at the version last recorded. A nil state or one without an entity
+// tag leaves updates unconditional.
+func (s *resourcePrivateState) conditional(ctx context.Context) context.Context {
+	if s == nil {
+		return ctx
+	}
+	return client.WithIfMatch(ctx, s.ETag)
+}
+
+// isNilPrivateState reports whether the framework handed us an uninitialized
+// private state container, which happens when CRUD methods are invoked
+// directly rather than through the provider server.
+func isNilPrivateState(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// fakePrivateState is an in-memory stand-in for the framework's private state.
+type fakePrivateState struct {
+	data map[string][]byte
+}
+
+func (f *fakePrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return f.data[key], nil
+}
+
+func (f *fakePrivateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	if f.data == nil {
+		f.data = make(map[string][]byte)
+	}
+	f.data[key] = value
+	return nil
+}
+
+func TestPrivateState_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store := &fakePrivateState{}
+
+	diags := writePrivateState(ctx, store, &resourcePrivateState{ETag: `"v1"`, UpdatedAt: "2024-01-01T00:00:00Z"})
+	if diags.HasError() {
+		t.Fatalf("writePrivateState() errors: %v", diags.Errors())
+	}
+
+	got, diags := readPrivateState(ctx, store)
+	if diags.HasError() {
+		t.Fatalf("readPrivateState() errors: %v", diags.Errors())
+	}
+	if got.ETag != `"v1"` {
+		t.Errorf("ETag = %q, want %q", got.ETag, `"v1"`)
+	}
+	if got.UpdatedAt != "2024-01-01T00:00:00Z" {
+		t.Errorf("UpdatedAt = %q, want 2024-01-01T00:00:00Z", got.UpdatedAt)
+	}
+}
+
+func TestPrivateState_MissingKeyIsEmpty(t *testing.T) {
+	got, diags := readPrivateState(context.Background(), &fakePrivateState{})
+	if diags.HasError() {
+		t.Fatalf("readPrivateState() errors: %v", diags.Errors())
+	}
+	if got == nil || got.ETag != "" {
+		t.Errorf("expected empty private state, got %+v", got)
+	}
+}
+
+func TestPrivateState_CorruptDataIsIgnored(t *testing.T) {
+	store := &fakePrivateState{data: map[string][]byte{privateStateKey: []byte(`"not an object"`)}}
+
+	got, diags := readPrivateState(context.Background(), store)
+	if diags.HasError() {
+		t.Fatalf("readPrivateState() errors: %v", diags.Errors())
+	}
+	if got.ETag != "" {
+		t.Errorf("expected empty private state for corrupt data, got %+v", got)
+	}
+}
+
+func TestPrivateState_NilContainer(t *testing.T) {
+	ctx := context.Background()
+	var store *fakePrivateState
+
+	if diags := writePrivateState(ctx, store, &resourcePrivateState{ETag: "x"}); diags.HasError() {
+		t.Errorf("writePrivateState() with nil container should be a no-op, got: %v", diags.Errors())
+	}
+	if _, diags := readPrivateState(ctx, store); diags.HasError() {
+		t.Errorf("readPrivateState() with nil container should be a no-op, got: %v", diags.Errors())
+	}
+}
@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+)
+
+// uuidPattern matches the UUIDs Sequin uses as resource IDs
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// isUUID reports whether a reference is already a resource ID rather than a name
+func isUUID(ref string) bool {
+	return uuidPattern.MatchString(ref)
+}
+
+// resolveDatabaseID normalizes a database reference, which may be either the
+// connection's name or its ID, to the ID. IDs are returned without an API call.
+func resolveDatabaseID(ctx context.Context, c client.SequinAPI, ref string) (string, error) {
+	if ref == "" || isUUID(ref) {
+		return ref, nil
+	}
+
+	database, err := c.GetDatabase(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve database %q: %w", ref, err)
+	}
+	return database.ID, nil
+}
+
+// resolveFunctionName normalizes a function reference, which may be either the
+// function's name or its ID, to the name the sink API expects
+func resolveFunctionName(ctx context.Context, c client.SequinAPI, ref string) (string, error) {
+	if ref == "" || !isUUID(ref) {
+		return ref, nil
+	}
+
+	function, err := c.GetFunction(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve function %q: %w", ref, err)
+	}
+	return function.Name, nil
+}
+
+// resolveHTTPEndpointID normalizes an HTTP endpoint reference, which may be
+// either the endpoint's name or its ID, to the ID the sink API expects. IDs
+// are returned without an API call.
+func resolveHTTPEndpointID(ctx context.Context, c client.SequinAPI, ref string) (string, error) {
+	if ref == "" || isUUID(ref) {
+		return ref, nil
+	}
+
+	endpoint, err := c.GetHTTPEndpoint(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve HTTP endpoint %q: %w", ref, err)
+	}
+	return endpoint.ID, nil
+}
+
+// referenceMatches reports whether an API value refers to the same object as a
+// configured reference, given the reference's resolved ID
+func referenceMatches(apiValue, configured, resolvedID string) bool {
+	return apiValue == configured || (resolvedID != "" && apiValue == resolvedID)
+}
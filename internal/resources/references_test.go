@@ -0,0 +1,209 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/clintdigital/terraform-provider-sequin/internal/client/clienttest"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsUUID(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want bool
+	}{
+		{"b3f1c2d4-5e6f-4a7b-8c9d-0e1f2a3b4c5d", true},
+		{"B3F1C2D4-5E6F-4A7B-8C9D-0E1F2A3B4C5D", true},
+		{"production", false},
+		{"db-001", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := isUUID(tt.ref); got != tt.want {
+			t.Errorf("isUUID(%q) = %v, want %v", tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestResolveDatabaseID_IDNeedsNoLookup(t *testing.T) {
+	id := "b3f1c2d4-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+	// A nil client would panic if an API call were attempted
+	got, err := resolveDatabaseID(context.Background(), nil, id)
+	if err != nil {
+		t.Fatalf("resolveDatabaseID() error: %v", err)
+	}
+	if got != id {
+		t.Errorf("resolveDatabaseID() = %q, want %q", got, id)
+	}
+}
+
+func TestSinkMapResponseToModel_KeepsDatabaseName(t *testing.T) {
+	ctx := context.Background()
+	r := &SinkConsumerResource{}
+	diags := diag.Diagnostics{}
+	dbID := "b3f1c2d4-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+	model := &SinkConsumerResourceModel{
+		Database:    types.StringValue("production"),
+		DatabaseID:  types.StringValue(dbID),
+		Destination: newNullDestModel(),
+	}
+	r.mapResponseToModel(ctx, &client.SinkConsumerResponse{
+		ID:          "sink-001",
+		Database:    dbID,
+		Destination: client.SinkConsumerDestination{Type: "webhook"},
+	}, model, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("errors: %v", diags.Errors())
+	}
+	if model.Database.ValueString() != "production" {
+		t.Errorf("database = %q, want configured name production", model.Database.ValueString())
+	}
+	if model.DatabaseID.ValueString() != dbID {
+		t.Errorf("database_id = %q, want %q", model.DatabaseID.ValueString(), dbID)
+	}
+}
+
+func TestSinkMapResponseToModel_DatabaseDrift(t *testing.T) {
+	ctx := context.Background()
+	r := &SinkConsumerResource{}
+	diags := diag.Diagnostics{}
+	otherID := "0e1f2a3b-4c5d-4a7b-8c9d-b3f1c2d45e6f"
+
+	model := &SinkConsumerResourceModel{
+		Database:    types.StringValue("production"),
+		DatabaseID:  types.StringValue("b3f1c2d4-5e6f-4a7b-8c9d-0e1f2a3b4c5d"),
+		Destination: newNullDestModel(),
+	}
+	r.mapResponseToModel(ctx, &client.SinkConsumerResponse{
+		ID:          "sink-001",
+		Database:    otherID,
+		Destination: client.SinkConsumerDestination{Type: "webhook"},
+	}, model, &diags)
+
+	if model.Database.ValueString() != otherID {
+		t.Errorf("database = %q, want %q when the sink points at another database", model.Database.ValueString(), otherID)
+	}
+}
+
+func TestRestoreFunctionRefs(t *testing.T) {
+	fnID := "6a1f2c3d-4e5f-4a6b-9c7d-8e9f0a1b2c3d"
+
+	model := &SinkConsumerResourceModel{
+		Filter:    types.StringValue("only_paid"),
+		Transform: types.StringValue("new_shape"),
+		Routing:   types.StringNull(),
+	}
+	configured := map[string]types.String{
+		"filter":    types.StringValue(fnID),
+		"transform": types.StringValue(fnID),
+		"routing":   types.StringNull(),
+	}
+	refs := map[string]string{fnID: "only_paid"}
+
+	restoreFunctionRefs(model, configured, refs)
+
+	if model.Filter.ValueString() != fnID {
+		t.Errorf("filter = %q, want configured ID %q", model.Filter.ValueString(), fnID)
+	}
+	if model.Transform.ValueString() != "new_shape" {
+		t.Errorf("transform = %q, want drifted name new_shape to be kept", model.Transform.ValueString())
+	}
+	if !model.Routing.IsNull() {
+		t.Error("routing should stay null")
+	}
+}
+
+func TestResolveFunctionRefs_NamesPassThrough(t *testing.T) {
+	r := &SinkConsumerResource{}
+	diags := diag.Diagnostics{}
+	model := &SinkConsumerResourceModel{
+		Filter:     types.StringValue("only_paid"),
+		Transform:  types.StringNull(),
+		Enrichment: types.StringNull(),
+		Routing:    types.StringValue("by_region"),
+	}
+	apiReq := &client.SinkConsumerRequest{}
+
+	refs := r.resolveFunctionRefs(context.Background(), model, apiReq, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("errors: %v", diags.Errors())
+	}
+	if len(refs) != 0 {
+		t.Errorf("name references should not be recorded, got %v", refs)
+	}
+	if apiReq.Filter != "only_paid" || apiReq.Routing != "by_region" || apiReq.Transform != "" {
+		t.Errorf("unexpected request functions: %+v", apiReq)
+	}
+}
+
+func TestResolveEndpointRefs(t *testing.T) {
+	endpointID := "9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	mock := &clienttest.Mock{
+		GetHTTPEndpointFunc: func(ctx context.Context, idOrName string) (*client.HTTPEndpointResponse, error) {
+			if idOrName != "billing" {
+				t.Errorf("looked up %q, want only the name reference", idOrName)
+			}
+			return &client.HTTPEndpointResponse{ID: endpointID, Name: "billing"}, nil
+		},
+	}
+	r := &SinkConsumerResource{client: mock}
+	diags := diag.Diagnostics{}
+	otherID := "0e1f2a3b-4c5d-4a7b-8c9d-b3f1c2d45e6f"
+	apiReq := &client.SinkConsumerRequest{
+		Destination: client.SinkConsumerDestination{Type: "webhook", HTTPEndpointID: "billing"},
+		FailoverDestination: &client.SinkConsumerFailoverDestination{
+			SinkConsumerDestination: client.SinkConsumerDestination{Type: "webhook", HTTPEndpointID: otherID},
+		},
+	}
+
+	refs := r.resolveEndpointRefs(context.Background(), apiReq, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("errors: %v", diags.Errors())
+	}
+	if apiReq.Destination.HTTPEndpointID != endpointID || apiReq.FailoverDestination.HTTPEndpointID != otherID {
+		t.Errorf("request endpoints = %q and %q, want IDs", apiReq.Destination.HTTPEndpointID, apiReq.FailoverDestination.HTTPEndpointID)
+	}
+	if len(refs) != 1 || refs["billing"] != endpointID {
+		t.Errorf("refs = %v, want only the name reference recorded", refs)
+	}
+}
+
+func TestRestoreEndpointRefs(t *testing.T) {
+	ctx := context.Background()
+	endpointID := "9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	refs := map[string]string{"billing": endpointID}
+	configured := map[string]types.String{"destination": types.StringValue("billing")}
+
+	for _, tt := range []struct {
+		name     string
+		reported string
+		want     string
+	}{
+		{"same endpoint keeps the name", endpointID, "billing"},
+		{"other endpoint is drift", "0e1f2a3b-4c5d-4a7b-8c9d-b3f1c2d45e6f", "0e1f2a3b-4c5d-4a7b-8c9d-b3f1c2d45e6f"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newRoundTripPlan(t, "webhook", map[string]attr.Value{"http_endpoint_id": types.StringValue(tt.reported)})
+			diags := diag.Diagnostics{}
+
+			restoreEndpointRefs(ctx, &model, configured, refs, &diags)
+
+			if diags.HasError() {
+				t.Fatalf("errors: %v", diags.Errors())
+			}
+			if got := httpEndpointID(model.Destination).ValueString(); got != tt.want {
+				t.Errorf("http_endpoint_id = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// lsnPattern matches a Postgres LSN such as 0/16B3748
+var lsnPattern = regexp.MustCompile(`^[0-9A-Fa-f]{1,8}/[0-9A-Fa-f]{1,8}$`)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ resource.Resource              = &ReplayResource{}
+	_ resource.ResourceWithConfigure = &ReplayResource{}
+)
+
+// ReplayResource re-delivers a sink consumer's messages from an LSN or a
+// timestamp onwards. Like sequin_failed_messages_remediation it is a one-shot
+// operation: every argument forces replacement, so changing triggers runs the
+// replay again.
+type ReplayResource struct {
+	client client.SequinAPI
+}
+
+// ReplayResourceModel describes the resource data model
+type ReplayResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	SinkConsumer  types.String `tfsdk:"sink_consumer"`
+	FromLSN       types.String `tfsdk:"from_lsn"`
+	FromTimestamp types.String `tfsdk:"from_timestamp"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	MessageCount  types.Int64  `tfsdk:"message_count"`
+	StartedAt     types.String `tfsdk:"started_at"`
+}
+
+// NewReplayResource creates a new resource
+func NewReplayResource() resource.Resource {
+	return &ReplayResource{}
+}
+
+// Metadata returns the resource type name
+func (r *ReplayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replay"
+}
+
+// Schema defines the resource schema
+func (r *ReplayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Re-delivers a sink consumer's messages from a replication LSN or a timestamp onwards, e.g. as a disaster-recovery step. The replay runs when the resource is created; change triggers to run it again. Destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the replay.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sink_consumer": schema.StringAttribute{
+				Description: "Name or ID of the sink consumer.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"from_lsn": schema.StringAttribute{
+				Description: "Replication LSN to replay from, e.g. 0/16B3748. Exactly one of from_lsn and from_timestamp is required.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(lsnPattern, "must be a Postgres LSN such as 0/16B3748"),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("from_lsn"), path.MatchRoot("from_timestamp")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"from_timestamp": schema.StringAttribute{
+				Description: "ISO 8601 timestamp to replay from, e.g. 2024-05-01T12:00:00Z.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that, when changed, run the replay again.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"message_count": schema.Int64Attribute{
+				Description: "Number of messages queued for re-delivery.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				Description: "ISO 8601 timestamp when the replay started.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the resource
+func (r *ReplayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create starts the replay
+func (r *ReplayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_replay", "create")
+
+	var data ReplayResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sinkConsumer := data.SinkConsumer.ValueString()
+	result, err := r.client.ReplaySinkConsumer(ctx, sinkConsumer, &client.ReplayRequest{
+		FromLSN:       data.FromLSN.ValueString(),
+		FromTimestamp: data.FromTimestamp.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Replaying Sink Consumer",
+			"Could not replay messages for sink consumer "+sinkConsumer+": "+err.Error(),
+		)
+		return
+	}
+
+	mapReplayToModel(result, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Info(ctx, "Replayed sink consumer", map[string]any{
+		"sink_consumer": sinkConsumer,
+		"id":            result.ID,
+		"count":         result.MessageCount,
+	})
+}
+
+// Read keeps the recorded replay as-is; there is nothing remote to refresh
+func (r *ReplayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update is never called because every argument requires replacement
+func (r *ReplayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Unexpected Update",
+		"sequin_replay does not support in-place updates. Please report this issue to the provider developers.",
+	)
+}
+
+// Delete removes the replay from state without calling the API
+func (r *ReplayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing replay from state")
+}
+
+// mapReplayToModel maps the API response to the Terraform model
+func mapReplayToModel(replay *client.ReplayResponse, data *ReplayResourceModel) {
+	data.ID = types.StringValue(replay.ID)
+	data.MessageCount = types.Int64Value(replay.MessageCount)
+	data.StartedAt = optionalString(replay.StartedAt)
+}
@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReplayResource_Metadata(t *testing.T) {
+	r := NewReplayResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_replay" {
+		t.Errorf("TypeName = %q, want sequin_replay", resp.TypeName)
+	}
+}
+
+func TestReplayResource_Schema(t *testing.T) {
+	r := NewReplayResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+
+	for _, attr := range []string{"id", "sink_consumer", "from_lsn", "from_timestamp", "triggers", "message_count", "started_at"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+
+	// Every argument must force replacement so that Update is never planned
+	for _, field := range []string{"sink_consumer", "from_lsn", "from_timestamp"} {
+		if len(resp.Schema.Attributes[field].(schema.StringAttribute).PlanModifiers) == 0 {
+			t.Errorf("field %s should have plan modifiers", field)
+		}
+	}
+	if len(resp.Schema.Attributes["triggers"].(schema.MapAttribute).PlanModifiers) == 0 {
+		t.Error("field triggers should have plan modifiers")
+	}
+}
+
+func TestLSNPattern(t *testing.T) {
+	for _, lsn := range []string{"0/16B3748", "1A/FF000000", "0/0"} {
+		if !lsnPattern.MatchString(lsn) {
+			t.Errorf("%q should be a valid LSN", lsn)
+		}
+	}
+	for _, lsn := range []string{"16B3748", "0/", "0/16B3748/1", "2024-05-01T12:00:00Z"} {
+		if lsnPattern.MatchString(lsn) {
+			t.Errorf("%q should not be a valid LSN", lsn)
+		}
+	}
+}
+
+func TestMapReplayToModel(t *testing.T) {
+	data := ReplayResourceModel{FromLSN: types.StringValue("0/16B3748")}
+
+	mapReplayToModel(&client.ReplayResponse{ID: "rpl-1", MessageCount: 42}, &data)
+
+	if data.ID.ValueString() != "rpl-1" || data.MessageCount.ValueInt64() != 42 {
+		t.Errorf("data = %+v, want replay rpl-1 with 42 messages", data)
+	}
+	if !data.StartedAt.IsNull() {
+		t.Error("started_at should be null when the API omits it")
+	}
+	if data.FromLSN.ValueString() != "0/16B3748" {
+		t.Error("configured arguments should be kept")
+	}
+}
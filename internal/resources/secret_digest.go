@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// secretDigest returns a salted SHA-256 digest of a secret value. Only the
+// digest is kept in private state, never the secret itself.
+func secretDigest(salt, value string) string {
+	sum := sha256.Sum256([]byte(salt + ":" + value))
+	return hex.EncodeToString(sum[:])
+}
+
+// newDigestSalt generates a random per-resource salt for secret digests.
+func newDigestSalt() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		// crypto/rand never fails on supported platforms; fall back to a fixed salt
+		return "sequin"
+	}
+	return hex.EncodeToString(buf)
+}
+
+// recordSecrets stores digests of the secrets Terraform just applied along
+// with the server-provided fingerprint observed in the same response.
+func (s *resourcePrivateState) recordSecrets(secrets map[string]string, fingerprint string) {
+	if s.Salt == "" {
+		s.Salt = newDigestSalt()
+	}
+	s.SecretDigests = make(map[string]string, len(secrets))
+	for name, value := range secrets {
+		s.SecretDigests[name] = secretDigest(s.Salt, value)
+	}
+	s.SecretFingerprint = fingerprint
+}
+
+// secretsDrifted reports whether the server-side credential fingerprint has
+// changed since Terraform last applied secrets, while the secrets held in
+// state are still the ones Terraform applied. That combination means the
+// credential was changed out-of-band. Without a server fingerprint on both
+// sides there is nothing to compare, so no drift is reported.
+func (s *resourcePrivateState) secretsDrifted(secrets map[string]string, fingerprint string) bool {
+	if s.SecretFingerprint == "" || fingerprint == "" || s.SecretFingerprint == fingerprint {
+		return false
+	}
+	if len(s.SecretDigests) != len(secrets) {
+		return false
+	}
+	for name, value := range secrets {
+		if s.SecretDigests[name] != secretDigest(s.Salt, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// stringSecrets collects the non-empty values of the given secret attributes.
+func stringSecrets(values map[string]types.String) map[string]string {
+	secrets := make(map[string]string, len(values))
+	for name, value := range values {
+		if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+			secrets[name] = value.ValueString()
+		}
+	}
+	return secrets
+}
+
+// objectStringSecrets collects the non-empty values of the named string
+// attributes from an object value.
+func objectStringSecrets(obj types.Object, names ...string) map[string]types.String {
+	values := make(map[string]types.String, len(names))
+	if obj.IsNull() || obj.IsUnknown() {
+		return values
+	}
+	attrs := obj.Attributes()
+	for _, name := range names {
+		if value, ok := attrs[name].(types.String); ok {
+			values[name] = value
+		}
+	}
+	return values
+}
+
+// nullObjectAttributes returns a copy of obj with the named attributes set to
+// null. Used to surface credential drift so the configured value is re-applied.
+func nullObjectAttributes(ctx context.Context, obj types.Object, names ...string) (types.Object, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return obj, nil
+	}
+
+	attrs := make(map[string]attr.Value, len(obj.Attributes()))
+	for name, value := range obj.Attributes() {
+		attrs[name] = value
+	}
+	for _, name := range names {
+		if _, ok := attrs[name]; ok {
+			attrs[name] = types.StringNull()
+		}
+	}
+
+	return types.ObjectValue(obj.AttributeTypes(ctx), attrs)
+}
@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSecretDigest_SaltedAndDeterministic(t *testing.T) {
+	if secretDigest("salt-a", "hunter2") != secretDigest("salt-a", "hunter2") {
+		t.Error("digest should be deterministic for the same salt and value")
+	}
+	if secretDigest("salt-a", "hunter2") == secretDigest("salt-b", "hunter2") {
+		t.Error("digest should differ across salts")
+	}
+	if secretDigest("salt-a", "hunter2") == "hunter2" {
+		t.Error("digest must not contain the plaintext secret")
+	}
+}
+
+func TestSecretsDrifted(t *testing.T) {
+	secrets := map[string]string{"password": "hunter2"}
+
+	state := &resourcePrivateState{}
+	state.recordSecrets(secrets, "fp-1")
+	if state.Salt == "" {
+		t.Fatal("recordSecrets() should generate a salt")
+	}
+
+	tests := []struct {
+		name        string
+		secrets     map[string]string
+		fingerprint string
+		want        bool
+	}{
+		{"unchanged fingerprint", secrets, "fp-1", false},
+		{"server fingerprint unavailable", secrets, "", false},
+		{"fingerprint rotated out-of-band", secrets, "fp-2", true},
+		{"secret changed in state", map[string]string{"password": "other"}, "fp-2", false},
+		{"secret removed from state", map[string]string{}, "fp-2", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := state.secretsDrifted(tt.secrets, tt.fingerprint); got != tt.want {
+				t.Errorf("secretsDrifted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSecretsDrifted_NoRecordedFingerprint(t *testing.T) {
+	state := &resourcePrivateState{}
+	state.recordSecrets(map[string]string{"password": "hunter2"}, "")
+
+	if state.secretsDrifted(map[string]string{"password": "hunter2"}, "fp-1") {
+		t.Error("drift should not be reported without a recorded fingerprint")
+	}
+}
+
+func TestDestinationSecrets_IgnoresNullAndNonSecret(t *testing.T) {
+	attrs := map[string]attr.Value{}
+	for name, typ := range destAttrTypes {
+		attrs[name] = nullValue(t, typ)
+	}
+	attrs["type"] = types.StringValue("kafka")
+	attrs["hosts"] = types.StringValue("broker:9092")
+	attrs["password"] = types.StringValue("kafka-pass")
+	obj, diags := types.ObjectValue(destAttrTypes, attrs)
+	if diags.HasError() {
+		t.Fatalf("ObjectValue errors: %v", diags.Errors())
+	}
+
+	secrets := destinationSecrets(obj)
+	if len(secrets) != 1 || secrets["password"] != "kafka-pass" {
+		t.Errorf("destinationSecrets() = %v, want only password", secrets)
+	}
+}
+
+func TestNullObjectAttributes(t *testing.T) {
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{"user": types.StringType, "password": types.StringType}
+	obj, _ := types.ObjectValue(attrTypes, map[string]attr.Value{
+		"user":     types.StringValue("admin"),
+		"password": types.StringValue("secret"),
+	})
+
+	got, diags := nullObjectAttributes(ctx, obj, "password")
+	if diags.HasError() {
+		t.Fatalf("nullObjectAttributes() errors: %v", diags.Errors())
+	}
+	if !got.Attributes()["password"].IsNull() {
+		t.Error("password should be null")
+	}
+	if got.Attributes()["user"].(types.String).ValueString() != "admin" {
+		t.Error("user should be preserved")
+	}
+}
@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// requireServerFeature reports a setting the connected Sequin server does not
+// support, naming the version that added it instead of leaving the API to
+// reject the request
+func requireServerFeature(c client.SequinAPI, feature, setting string, at path.Path, diags *diag.Diagnostics) {
+	if c == nil {
+		return
+	}
+	supported, minVersion := c.SupportsFeature(feature)
+	if supported {
+		return
+	}
+
+	detail := fmt.Sprintf("%s is not supported by the Sequin server", setting)
+	if minVersion != "" {
+		detail = fmt.Sprintf("%s requires Sequin >= %s", setting, minVersion)
+	}
+	if version := c.ServerVersion(); version != "" {
+		detail += fmt.Sprintf(", but the server runs %s", version)
+	}
+	diags.AddAttributeError(
+		at,
+		"Feature Not Supported by Sequin Server",
+		detail+". Upgrade Sequin or remove the setting.",
+	)
+}
+
+// plannedChange reports whether a planned value is set and differs from the
+// prior state, so features already in use are not re-checked on every plan
+func plannedChange(planned, prior attr.Value) bool {
+	return !planned.IsNull() && !planned.IsUnknown() && !planned.Equal(prior)
+}
@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestRequireServerFeature(t *testing.T) {
+	var diags diag.Diagnostics
+	old := &client.Client{Server: &client.ServerInfo{Version: "0.9.4"}}
+	requireServerFeature(old, client.FeatureEnrichment, "enrichment", path.Root("enrichment"), &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("errors = %d, want 1", diags.ErrorsCount())
+	}
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "requires Sequin >= 0.10.0") || !strings.Contains(detail, "0.9.4") {
+		t.Errorf("detail = %q, want minimum and current versions", detail)
+	}
+
+	diags = nil
+	requireServerFeature(&client.Client{}, client.FeatureEnrichment, "enrichment", path.Root("enrichment"), &diags)
+	requireServerFeature(&client.Client{Server: &client.ServerInfo{Version: "0.10.0"}}, client.FeatureEnrichment, "enrichment", path.Root("enrichment"), &diags)
+	if diags.HasError() {
+		t.Errorf("supported or undetected features should pass: %v", diags.Errors())
+	}
+}
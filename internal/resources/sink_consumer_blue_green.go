@@ -0,0 +1,352 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Sink names are unique, so the new sink runs under a temporary name until
+// the old one is deleted
+const blueGreenNameSuffix = "-green"
+
+// blueGreenDefaultHealthTimeout bounds the wait for the new sink to become
+// active when health_timeout_seconds is not configured
+const blueGreenDefaultHealthTimeout = 300
+
+// blueGreenPollInterval is how often the new sink's status is checked
+var blueGreenPollInterval = 5 * time.Second
+
+// blueGreenAttrTypes describes the sink consumer blue_green object
+var blueGreenAttrTypes = map[string]attr.Type{
+	"health_timeout_seconds": types.Int64Type,
+	"backfill":               types.BoolType,
+}
+
+// blueGreenDestinationAttributes lists the destination attributes that point
+// the sink at a different target system. Changing one with blue_green set
+// replaces the sink instead of updating it in place.
+var blueGreenDestinationAttributes = []string{
+	"type",
+	"hosts",
+	"topic",
+	"queue_url",
+	"stream_arn",
+	"http_endpoint",
+	"http_endpoint_id",
+	"project_id",
+	"topic_id",
+	"namespace",
+	"event_hub_name",
+	"virtual_host",
+	"exchange",
+	"database_index",
+	"stream_key",
+	"endpoint_url",
+	"index_name",
+	"collection_name",
+}
+
+// blueGreenSchemaAttribute returns the schema of the blue_green attribute
+func blueGreenSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Replace the sink blue/green when its database or destination target (type, hosts, topic, queue_url, stream_arn, http_endpoint, http_endpoint_id, project_id, topic_id, namespace, event_hub_name, virtual_host, exchange, database_index, stream_key, endpoint_url, index_name, collection_name) changes: " +
+			"a new sink is created, must become active, and is optionally backfilled to completion before the old sink is deleted, so delivery never stops. " +
+			"Messages may be delivered twice while both sinks run. Without it these changes are applied in place. The sink ID changes on replacement.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"health_timeout_seconds": schema.Int64Attribute{
+				Description: "How long to wait for the new sink to become active and, with backfill, for its backfills to complete. On timeout or failure the new sink is deleted and the old one keeps running. Defaults to 300.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(10),
+				},
+			},
+			"backfill": schema.BoolAttribute{
+				Description: "Backfill the new sink's tables and wait for the backfills to complete, within health_timeout_seconds, before the old sink is deleted. Defaults to false.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// blueGreenSwapRequired reports whether applying the plan replaces the sink
+// blue/green: blue_green is set and the database or destination target changes
+func blueGreenSwapRequired(state, plan *SinkConsumerResourceModel) bool {
+	if plan.BlueGreen.IsNull() || plan.BlueGreen.IsUnknown() {
+		return false
+	}
+
+	if !plan.Database.Equal(state.Database) && !plan.DatabaseID.Equal(state.DatabaseID) {
+		return true
+	}
+
+	if plan.Destination.IsNull() || plan.Destination.IsUnknown() || state.Destination.IsNull() {
+		return false
+	}
+	planned := plan.Destination.Attributes()
+	prior := state.Destination.Attributes()
+	for _, name := range blueGreenDestinationAttributes {
+		planValue, ok := planned[name]
+		if !ok {
+			continue
+		}
+		if priorValue, ok := prior[name]; !ok || !planValue.Equal(priorValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// planBlueGreenSwap marks the ID and status of a sink that will be replaced
+// blue/green as unknown, since the replacement gets new ones
+func (r *SinkConsumerResource) planBlueGreenSwap(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state SinkConsumerResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !blueGreenSwapRequired(&state, &plan) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status_info"), types.ObjectUnknown(statusInfoAttrTypes))...)
+	resp.Diagnostics.AddWarning(
+		"Sink Consumer Blue/Green Replacement",
+		"Sink consumer "+state.Name.ValueString()+" will be replaced by a new sink. The old sink keeps delivering until the new one is active, "+
+			"so some messages may be delivered twice.",
+	)
+}
+
+// replaceBlueGreen creates a sink from apiReq next to the old one, waits for
+// it to become active, optionally backfills it to completion, deletes the old
+// sink and renames the new one. Both waits share the health timeout. Until the old sink is deleted any failure removes the
+// new sink and returns nil. A failed rename is reported as an error alongside
+// the new sink, which keeps its temporary name until the next apply.
+func (r *SinkConsumerResource) replaceBlueGreen(ctx context.Context, oldID string, plan *SinkConsumerResourceModel, apiReq *client.SinkConsumerRequest, diags *diag.Diagnostics) *client.SinkConsumerResponse {
+	greenReq := *apiReq
+	greenReq.Name = apiReq.Name + blueGreenNameSuffix
+	if greenReq.Status == "" && !plan.Status.IsNull() && !plan.Status.IsUnknown() {
+		greenReq.Status = plan.Status.ValueString()
+	}
+
+	green, err := r.client.CreateSinkConsumer(ctx, &greenReq)
+	if err != nil {
+		diags.AddError(
+			"Error Replacing Sink Consumer",
+			"Could not create the replacement for sink consumer ID "+oldID+": "+err.Error(),
+		)
+		return nil
+	}
+	tflog.Info(ctx, "Created blue/green replacement sink consumer", map[string]any{"old_id": oldID, "new_id": green.ID})
+
+	abandon := func(summary, detail string) *client.SinkConsumerResponse {
+		if err := r.client.DeleteSinkConsumer(ctx, green.ID); err != nil {
+			detail += "\n\nThe replacement sink " + greenReq.Name + " (ID " + green.ID + ") could not be deleted and must be removed manually: " + err.Error()
+		}
+		diags.AddError(summary, detail)
+		return nil
+	}
+
+	timeout := blueGreenHealthTimeout(plan.BlueGreen)
+	deadline := time.Now().Add(timeout)
+
+	// A paused or disabled sink never becomes active; there is nothing to wait for
+	if greenReq.Status == "" || greenReq.Status == client.SinkStatusActive {
+		if err := r.waitForSinkActive(ctx, green.ID, timeout); err != nil {
+			return abandon(
+				"Sink Consumer Replacement Unhealthy",
+				"The replacement for sink consumer ID "+oldID+" did not become active, so the old sink was kept: "+err.Error(),
+			)
+		}
+	}
+
+	if blueGreenBackfill(plan.BlueGreen) {
+		backfills, err := r.backfillSinkTables(ctx, green.ID, greenReq.Tables)
+		if err == nil {
+			err = r.waitForBackfills(ctx, green.ID, backfills, time.Until(deadline))
+		}
+		if err != nil {
+			return abandon(
+				"Error Backfilling Sink Consumer Replacement",
+				"Could not backfill the replacement for sink consumer ID "+oldID+", so the old sink was kept: "+err.Error(),
+			)
+		}
+	}
+
+	if err := r.client.DeleteSinkConsumer(ctx, oldID); err != nil {
+		return abandon(
+			"Error Replacing Sink Consumer",
+			"Could not delete sink consumer ID "+oldID+" after its replacement became active, so the old sink was kept: "+err.Error(),
+		)
+	}
+
+	renamed, err := updateWithConflictRetry(ctx, green.ID, nil,
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			return r.client.GetSinkConsumer(ctx, green.ID)
+		},
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			return r.client.UpdateSinkConsumer(ctx, green.ID, apiReq)
+		},
+	)
+	if err != nil {
+		diags.AddError(
+			"Error Renaming Sink Consumer Replacement",
+			fmt.Sprintf("Sink consumer ID %s replaced sink consumer ID %s but could not be renamed from %s to %s: %s. "+
+				"It will be renamed on the next apply.", green.ID, oldID, greenReq.Name, apiReq.Name, err.Error()),
+		)
+		return green
+	}
+
+	tflog.Info(ctx, "Replaced sink consumer blue/green", map[string]any{"old_id": oldID, "new_id": renamed.ID})
+	return renamed
+}
+
+// waitForSinkActive polls the sink until its status is active. A failed sink
+// or the timeout elapsing returns an error with the sink's last error.
+func (r *SinkConsumerResource) waitForSinkActive(ctx context.Context, id string, timeout time.Duration) error {
+	ctx = client.WithoutReadCache(ctx)
+	deadline := time.Now().Add(timeout)
+
+	for {
+		sink, err := r.client.GetSinkConsumer(ctx, id)
+		if err != nil && !client.IsNotFoundError(err) {
+			return err
+		}
+		if err == nil {
+			switch sink.StatusInfo.State {
+			case "active":
+				return nil
+			case "failed":
+				return fmt.Errorf("sink failed: %s", sink.StatusInfo.LastError)
+			}
+		}
+
+		if time.Now().Add(blueGreenPollInterval).After(deadline) {
+			if err == nil && sink.StatusInfo.LastError != "" {
+				return fmt.Errorf("still %s after %s: %s", sink.StatusInfo.State, timeout, sink.StatusInfo.LastError)
+			}
+			return fmt.Errorf("not active after %s", timeout)
+		}
+
+		tflog.Debug(ctx, "Waiting for sink consumer to become active", map[string]any{
+			"id":       id,
+			"interval": blueGreenPollInterval.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(blueGreenPollInterval):
+		}
+	}
+}
+
+// backfillSinkTables starts a backfill of every listed table, or of the sink's
+// only table when it streams from source filters, and returns the backfills
+func (r *SinkConsumerResource) backfillSinkTables(ctx context.Context, id string, tables []client.SinkConsumerTable) ([]*client.BackfillResponse, error) {
+	if len(tables) == 0 {
+		backfill, err := r.client.CreateBackfill(ctx, id, &client.BackfillCreateRequest{})
+		if err != nil {
+			return nil, err
+		}
+		return []*client.BackfillResponse{backfill}, nil
+	}
+
+	var backfills []*client.BackfillResponse
+	for _, table := range tables {
+		backfill, err := r.client.CreateBackfill(ctx, id, &client.BackfillCreateRequest{Table: table.Name})
+		if err != nil {
+			return nil, fmt.Errorf("table %s: %w", table.Name, err)
+		}
+		backfills = append(backfills, backfill)
+	}
+	return backfills, nil
+}
+
+// waitForBackfills polls the sink's backfills until all have completed. A
+// cancelled backfill or the timeout elapsing returns an error naming the
+// backfill's table.
+func (r *SinkConsumerResource) waitForBackfills(ctx context.Context, id string, backfills []*client.BackfillResponse, timeout time.Duration) error {
+	ctx = client.WithoutReadCache(ctx)
+	deadline := time.Now().Add(timeout)
+
+	for {
+		var pending []*client.BackfillResponse
+		for _, backfill := range backfills {
+			if backfill.State != client.BackfillStateCompleted {
+				latest, err := r.client.GetBackfill(ctx, id, backfill.ID)
+				if err != nil {
+					return fmt.Errorf("backfill %s: %w", backfill.ID, err)
+				}
+				backfill = latest
+			}
+			switch backfill.State {
+			case client.BackfillStateCompleted:
+			case client.BackfillStateCancelled:
+				return fmt.Errorf("backfill of %s was cancelled", backfillTable(backfill))
+			default:
+				pending = append(pending, backfill)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		if time.Now().Add(blueGreenPollInterval).After(deadline) {
+			return fmt.Errorf("backfill of %s not complete within the health timeout (%d of %d rows processed)",
+				backfillTable(pending[0]), pending[0].RowsProcessedCount, pending[0].RowsInitialCount)
+		}
+
+		tflog.Debug(ctx, "Waiting for sink consumer backfills to complete", map[string]any{
+			"id":       id,
+			"pending":  len(pending),
+			"interval": blueGreenPollInterval.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(blueGreenPollInterval):
+		}
+		backfills = pending
+	}
+}
+
+// backfillTable names the table of a backfill for messages
+func backfillTable(backfill *client.BackfillResponse) string {
+	if backfill.Table == "" {
+		return "the sink's table"
+	}
+	return backfill.Table
+}
+
+// blueGreenHealthTimeout returns the configured health timeout or the default
+func blueGreenHealthTimeout(blueGreen types.Object) time.Duration {
+	seconds := int64(blueGreenDefaultHealthTimeout)
+	if !blueGreen.IsNull() && !blueGreen.IsUnknown() {
+		if v, ok := blueGreen.Attributes()["health_timeout_seconds"].(types.Int64); ok && !v.IsNull() && !v.IsUnknown() {
+			seconds = v.ValueInt64()
+		}
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// blueGreenBackfill reports whether the replacement sink is backfilled
+func blueGreenBackfill(blueGreen types.Object) bool {
+	if blueGreen.IsNull() || blueGreen.IsUnknown() {
+		return false
+	}
+	v, ok := blueGreen.Attributes()["backfill"].(types.Bool)
+	return ok && v.ValueBool()
+}
@@ -0,0 +1,204 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// credentialVerifyDefaultSeconds is how long a sink is watched after a
+// credential rotation when credential_verify_seconds is not configured
+const credentialVerifyDefaultSeconds = 30
+
+// credentialVerifyInterval is how often the sink is checked while a
+// credential rotation is verified
+var credentialVerifyInterval = 5 * time.Second
+
+// destinationCredentialAttributes lists the destination attributes a
+// credential rotation may change
+var destinationCredentialAttributes = append([]string{"username", "credential_ref"}, destinationSecretAttributes...)
+
+// credentialRotationOnly reports whether the plan changes the destination
+// credentials and nothing else
+func credentialRotationOnly(ctx context.Context, state, plan *SinkConsumerResourceModel) bool {
+	if state.Destination.IsNull() || plan.Destination.IsNull() || plan.Destination.IsUnknown() {
+		return false
+	}
+	if !plan.Status.Equal(state.Status) || !plan.Database.Equal(state.Database) || !plan.CursorResetLSN.Equal(state.CursorResetLSN) {
+		return false
+	}
+
+	rotated := false
+	planned := plan.Destination.Attributes()
+	prior := state.Destination.Attributes()
+	for _, name := range destinationCredentialAttributes {
+		if value, ok := planned[name]; ok && !value.Equal(prior[name]) {
+			rotated = true
+			break
+		}
+	}
+	if !rotated {
+		return false
+	}
+
+	// Everything sent to the API but the credentials must be unchanged
+	var diags diag.Diagnostics
+	plannedReq := buildSinkConsumerRequest(ctx, plan, &diags)
+	priorReq := buildSinkConsumerRequest(ctx, state, &diags)
+	if diags.HasError() {
+		return false
+	}
+	plannedReq.Destination = withoutCredentials(plannedReq.Destination)
+	priorReq.Destination = withoutCredentials(priorReq.Destination)
+	return reflect.DeepEqual(plannedReq, priorReq)
+}
+
+// withoutCredentials returns a copy of the destination with the fields of
+// destinationCredentialAttributes cleared. Fields are matched by their JSON
+// name, which is the attribute name.
+func withoutCredentials(dest client.SinkConsumerDestination) client.SinkConsumerDestination {
+	value := reflect.ValueOf(&dest).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		if slices.Contains(destinationCredentialAttributes, jsonFieldName(value.Type().Field(i))) {
+			value.Field(i).SetZero()
+		}
+	}
+	return dest
+}
+
+// jsonFieldName returns the name a struct field is encoded as in JSON
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	return name
+}
+
+// credentialVerifyWindow returns how long a credential rotation is verified.
+// Zero turns verification off.
+func credentialVerifyWindow(seconds types.Int64) time.Duration {
+	if seconds.IsNull() || seconds.IsUnknown() {
+		return credentialVerifyDefaultSeconds * time.Second
+	}
+	return time.Duration(seconds.ValueInt64()) * time.Second
+}
+
+// previousCredentialsKnown reports whether the prior state still holds the
+// secrets Terraform last applied, as recorded by their digests in private
+// state. Secrets cleared from state by drift detection or missing after import
+// cannot be restored.
+func previousCredentialsKnown(privateState *resourcePrivateState, priorDestination types.Object) bool {
+	secrets := destinationSecrets(priorDestination)
+	for name, digest := range privateState.SecretDigests {
+		value, ok := secrets[name]
+		if !ok || secretDigest(privateState.Salt, value) != digest {
+			return false
+		}
+	}
+	return true
+}
+
+// failingMessageCount returns the sink's failing message count, or nil when
+// the server does not report metrics
+func (r *SinkConsumerResource) failingMessageCount(ctx context.Context, id string) *int64 {
+	metrics, err := r.client.GetSinkConsumerMetrics(ctx, id)
+	if err != nil {
+		tflog.Debug(ctx, "Sink consumer metrics unavailable, verifying rotation by status only", map[string]any{"id": id, "error": err.Error()})
+		return nil
+	}
+	return &metrics.MessagesFailingCount
+}
+
+// verifyCredentialRotation watches the sink for the verify window after its
+// credentials were rotated. The rotation fails when the sink fails or more
+// messages start failing than before the rotation.
+func (r *SinkConsumerResource) verifyCredentialRotation(ctx context.Context, id string, window time.Duration, baseline *int64) error {
+	ctx = client.WithoutReadCache(ctx)
+	deadline := time.Now().Add(window)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(credentialVerifyInterval):
+		}
+
+		sink, err := r.client.GetSinkConsumer(ctx, id)
+		if err != nil {
+			return err
+		}
+		if sink.StatusInfo.State == "failed" {
+			return fmt.Errorf("sink failed: %s", sink.StatusInfo.LastError)
+		}
+		if baseline != nil {
+			if failing := r.failingMessageCount(ctx, id); failing != nil && *failing > *baseline {
+				return fmt.Errorf("%d messages failing, up from %d before the rotation", *failing, *baseline)
+			}
+		}
+
+		if !time.Now().Before(deadline) {
+			tflog.Info(ctx, "Verified sink consumer credential rotation", map[string]any{"id": id, "window": window.String()})
+			return nil
+		}
+	}
+}
+
+// rollbackCredentialRotation restores the credentials from the prior state
+// after a rotation failed verification, and keeps the prior state. It returns
+// false when the credentials could not be restored, in which case the caller
+// saves the new credentials.
+func (r *SinkConsumerResource) rollbackCredentialRotation(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, state *SinkConsumerResourceModel, updateReq *client.SinkConsumerRequest, cause error) bool {
+	consumerID := state.ID.ValueString()
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if !previousCredentialsKnown(privateState, state.Destination) {
+		resp.Diagnostics.AddError(
+			"Sink Consumer Credential Rotation Failed",
+			"Delivery failed after rotating the destination credentials of sink consumer ID "+consumerID+": "+cause.Error()+". "+
+				"The previous credentials are not in state, so the new credentials were kept.",
+		)
+		return false
+	}
+
+	rollbackReq := *updateReq
+	rollbackReq.Destination = buildDestinationRequest(state.Destination)
+	restored, err := updateWithConflictRetry(ctx, consumerID, nil,
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			return r.client.GetSinkConsumer(ctx, consumerID)
+		},
+		func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+			return r.client.UpdateSinkConsumer(ctx, consumerID, &rollbackReq)
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Sink Consumer Credential Rollback Failed",
+			"Delivery failed after rotating the destination credentials of sink consumer ID "+consumerID+": "+cause.Error()+". "+
+				"Restoring the previous credentials also failed, so the new credentials were kept: "+err.Error(),
+		)
+		return false
+	}
+
+	privateState.recordVersion(restored)
+	privateState.UpdatedAt = restored.StatusInfo.UpdatedAt
+	privateState.recordSecrets(destinationSecrets(state.Destination), destinationFingerprint(restored.Destination))
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, state.ID)...)
+
+	resp.Diagnostics.AddError(
+		"Sink Consumer Credential Rotation Rolled Back",
+		"Delivery failed after rotating the destination credentials of sink consumer ID "+consumerID+": "+cause.Error()+". "+
+			"The previous credentials were restored; fix the new credentials and apply again.",
+	)
+	tflog.Warn(ctx, "Rolled back sink consumer credential rotation", map[string]any{"id": consumerID, "cause": cause.Error()})
+	return true
+}
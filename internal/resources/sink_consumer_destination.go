@@ -0,0 +1,1248 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// destinationAttrTypes describes the sink consumer destination object
+var destinationAttrTypes = map[string]attr.Type{
+	"type":                       types.StringType,
+	"hosts":                      types.StringType,
+	"topic":                      types.StringType,
+	"tls":                        types.BoolType,
+	"username":                   types.StringType,
+	"password":                   types.StringType,
+	"sasl_mechanism":             types.StringType,
+	"aws_region":                 types.StringType,
+	"aws_access_key_id":          types.StringType,
+	"aws_secret_access_key":      types.StringType,
+	"partition_key_expression":   types.StringType,
+	"queue_url":                  types.StringType,
+	"region":                     types.StringType,
+	"access_key_id":              types.StringType,
+	"secret_access_key":          types.StringType,
+	"is_fifo":                    types.BoolType,
+	"stream_arn":                 types.StringType,
+	"http_endpoint":              types.StringType,
+	"http_endpoint_id":           types.StringType,
+	"http_endpoint_path":         types.StringType,
+	"batch":                      types.BoolType,
+	"batch_format":               types.StringType,
+	"batch_max_bytes":            types.Int64Type,
+	"credential_ref":             types.StringType,
+	"aws_credentials_source":     types.StringType,
+	"project_id":                 types.StringType,
+	"topic_id":                   types.StringType,
+	"gcp_credentials":            types.StringType,
+	"use_workload_identity":      types.BoolType,
+	"namespace":                  types.StringType,
+	"event_hub_name":             types.StringType,
+	"shared_access_key_name":     types.StringType,
+	"shared_access_key":          types.StringType,
+	"managed_identity_client_id": types.StringType,
+	"creds":                      types.StringType,
+	"nkey_seed":                  types.StringType,
+	"jwt":                        types.StringType,
+	"virtual_host":               types.StringType,
+	"exchange":                   types.StringType,
+	"exchange_type":              types.StringType,
+	"durable":                    types.BoolType,
+	"auto_delete":                types.BoolType,
+	"publisher_confirms":         types.BoolType,
+	"headers":                    types.MapType{ElemType: types.StringType},
+	"database_index":             types.Int64Type,
+	"stream_key":                 types.StringType,
+	"max_len":                    types.Int64Type,
+	"ttl_seconds":                types.Int64Type,
+	"endpoint_url":               types.StringType,
+	"index_name":                 types.StringType,
+	"auth_type":                  types.StringType,
+	"api_key":                    types.StringType,
+	"document_id_columns":        types.ListType{ElemType: types.StringType},
+	"auto_create_index":          types.BoolType,
+	"index_settings":             types.StringType,
+	"collection_name":            types.StringType,
+}
+
+// Kafka partition key expressions: a column list or a template with
+// {{...}} placeholders
+var (
+	partitionKeyColumnsPattern  = regexp.MustCompile(`^\s*[A-Za-z_][A-Za-z0-9_]*(\s*,\s*[A-Za-z_][A-Za-z0-9_]*)*\s*$`)
+	partitionKeyTemplatePattern = regexp.MustCompile(`\{\{\s*[^{}\s][^{}]*\}\}`)
+)
+
+// columnNamePattern matches an unquoted Postgres column name
+var columnNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// webhookBatchFormatJSONArray is the default body format of batched webhooks
+const webhookBatchFormatJSONArray = "json_array"
+
+// AWS credential sources. Anything but static uses the Sequin host's own
+// credentials.
+const awsCredentialsStatic = "static"
+
+var awsCredentialsSources = []string{awsCredentialsStatic, "environment", "instance_profile"}
+
+// awsKeyAttributes lists the destination attributes holding static AWS keys
+var awsKeyAttributes = []string{
+	"aws_access_key_id",
+	"aws_secret_access_key",
+	"access_key_id",
+	"secret_access_key",
+}
+
+// usesAmbientAWSCredentials reports whether the credentials source leaves AWS
+// authentication to the Sequin host
+func usesAmbientAWSCredentials(source string) bool {
+	return source != "" && source != awsCredentialsStatic
+}
+
+// destinationSchemaAttributes defines the attributes of the sink consumer
+// destination. Fields are flat and grouped by destination type.
+func destinationSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Description: "Destination type: kafka, sqs, kinesis, webhook, gcp_pubsub, azure_event_hub, nats, rabbitmq, redis_stream, redis_string, elasticsearch, typesense, meilisearch.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(client.DestinationTypes...),
+			},
+		},
+		// Kafka, NATS, RabbitMQ and Redis fields
+		"hosts": schema.StringAttribute{
+			Description: "Kafka broker hosts, NATS server URLs, or RabbitMQ or Redis hosts (comma-separated).",
+			Optional:    true,
+		},
+		"topic": schema.StringAttribute{
+			Description: "Kafka topic name.",
+			Optional:    true,
+		},
+		"tls": schema.BoolAttribute{
+			Description: "Enable TLS for the Kafka, NATS, RabbitMQ or Redis connection.",
+			Optional:    true,
+		},
+		"username": schema.StringAttribute{
+			Description: "Username for Kafka, NATS, RabbitMQ or Elasticsearch basic authentication, or the Redis ACL user.",
+			Optional:    true,
+		},
+		"password": schema.StringAttribute{
+			Description: "Password for Kafka, NATS, RabbitMQ, Redis or Elasticsearch basic authentication.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"sasl_mechanism": schema.StringAttribute{
+			Description: "SASL mechanism: PLAIN, SCRAM-SHA-256, SCRAM-SHA-512, AWS_MSK_IAM.",
+			Optional:    true,
+		},
+		"aws_region": schema.StringAttribute{
+			Description: "AWS region for MSK IAM authentication.",
+			Optional:    true,
+		},
+		"aws_access_key_id": schema.StringAttribute{
+			Description: "AWS access key ID for MSK IAM authentication.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"aws_secret_access_key": schema.StringAttribute{
+			Description: "AWS secret access key for MSK IAM authentication.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"partition_key_expression": schema.StringAttribute{
+			Description: "Kafka message key, which decides the partition a message lands on: a comma-separated column list such as tenant_id,order_id, or a template such as {{record.tenant_id}}-{{table_name}}. Independent of message grouping. Defaults to the message group, or the primary key without grouping.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.Any(
+					stringvalidator.RegexMatches(partitionKeyColumnsPattern, "must be a comma-separated column list"),
+					stringvalidator.RegexMatches(partitionKeyTemplatePattern, "must be a template with at least one {{...}} placeholder"),
+				),
+			},
+		},
+		// SQS fields
+		"queue_url": schema.StringAttribute{
+			Description: "SQS queue URL.",
+			Optional:    true,
+		},
+		"region": schema.StringAttribute{
+			Description: "AWS region for SQS/Kinesis.",
+			Optional:    true,
+		},
+		"access_key_id": schema.StringAttribute{
+			Description: "AWS access key ID.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"secret_access_key": schema.StringAttribute{
+			Description: "AWS secret access key.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"is_fifo": schema.BoolAttribute{
+			Description: "Whether the SQS queue is FIFO.",
+			Optional:    true,
+		},
+		// Kinesis fields
+		"stream_arn": schema.StringAttribute{
+			Description: "Kinesis stream ARN.",
+			Optional:    true,
+		},
+		// Webhook fields
+		"http_endpoint": schema.StringAttribute{
+			Description: "Webhook HTTP endpoint base URL. Conflicts with http_endpoint_id.",
+			Optional:    true,
+		},
+		"http_endpoint_id": schema.StringAttribute{
+			Description: "ID or name of an existing HTTP endpoint to deliver to, so endpoints with auth headers can be shared across sinks. Names are resolved to IDs and kept as written in state. Conflicts with http_endpoint.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("http_endpoint")),
+			},
+		},
+		"http_endpoint_path": schema.StringAttribute{
+			Description: "Webhook HTTP endpoint path.",
+			Optional:    true,
+		},
+		"batch": schema.BoolAttribute{
+			Description: "Enable batched delivery for webhooks.",
+			Optional:    true,
+		},
+		"batch_format": schema.StringAttribute{
+			Description: "Body format of batched webhook requests: json_array (a JSON array of messages) or ndjson (one JSON message per line, for streaming parsers). Defaults to json_array. Requires batch = true.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(webhookBatchFormatJSONArray, "ndjson"),
+			},
+		},
+		"batch_max_bytes": schema.Int64Attribute{
+			Description: "Maximum size in bytes of a batched webhook request body, for receivers with request size limits. Batches are split to stay below it. Requires batch = true.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1024),
+			},
+		},
+		// Pub/Sub fields
+		"project_id": schema.StringAttribute{
+			Description: "GCP project ID of the Pub/Sub topic.",
+			Optional:    true,
+		},
+		"topic_id": schema.StringAttribute{
+			Description: "Pub/Sub topic ID.",
+			Optional:    true,
+		},
+		"gcp_credentials": schema.StringAttribute{
+			Description: "GCP service account key JSON for Pub/Sub. Exactly one of gcp_credentials and use_workload_identity must be set.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"use_workload_identity": schema.BoolAttribute{
+			Description: "Authenticate to Pub/Sub with the self-hosted Sequin host's workload identity, so no service account key is stored in Terraform state. Exactly one of gcp_credentials and use_workload_identity must be set.",
+			Optional:    true,
+		},
+		// Event Hubs fields
+		"namespace": schema.StringAttribute{
+			Description: "Event Hubs namespace, without the .servicebus.windows.net suffix.",
+			Optional:    true,
+		},
+		"event_hub_name": schema.StringAttribute{
+			Description: "Event hub name.",
+			Optional:    true,
+		},
+		"shared_access_key_name": schema.StringAttribute{
+			Description: "Name of the Event Hubs shared access policy.",
+			Optional:    true,
+		},
+		"shared_access_key": schema.StringAttribute{
+			Description: "Event Hubs shared access key. Requires shared_access_key_name. Exactly one of shared_access_key and managed_identity_client_id must be set.",
+			Optional:    true,
+			Sensitive:   true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("shared_access_key_name")),
+			},
+		},
+		"managed_identity_client_id": schema.StringAttribute{
+			Description: "Client ID of the Azure managed identity the self-hosted Sequin host authenticates to Event Hubs as, for AKS deployments, so no shared access key is stored in Terraform state. Exactly one of shared_access_key and managed_identity_client_id must be set.",
+			Optional:    true,
+		},
+		// NATS fields
+		"creds": schema.StringAttribute{
+			Description: "Contents of a NATS credentials file holding a user JWT and nkey seed, as issued by Synadia Cloud (NGS). Conflicts with the other NATS authentication attributes.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"nkey_seed": schema.StringAttribute{
+			Description: "NATS nkey seed, for nkey authentication or together with jwt for JWT authentication.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"jwt": schema.StringAttribute{
+			Description: "NATS user JWT. Requires nkey_seed.",
+			Optional:    true,
+			Sensitive:   true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("nkey_seed")),
+			},
+		},
+		// RabbitMQ fields
+		"virtual_host": schema.StringAttribute{
+			Description: "RabbitMQ virtual host. Defaults to /.",
+			Optional:    true,
+		},
+		"exchange": schema.StringAttribute{
+			Description: "RabbitMQ exchange messages are published to.",
+			Optional:    true,
+		},
+		"exchange_type": schema.StringAttribute{
+			Description: "Type the exchange is declared with: direct, fanout, topic or headers. Must match an existing exchange.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(client.RabbitMQExchangeTypes...),
+			},
+		},
+		"durable": schema.BoolAttribute{
+			Description: "Declare the exchange durable, so it survives broker restarts.",
+			Optional:    true,
+		},
+		"auto_delete": schema.BoolAttribute{
+			Description: "Declare the exchange auto-delete, so it is removed once no queue is bound to it.",
+			Optional:    true,
+		},
+		"publisher_confirms": schema.BoolAttribute{
+			Description: "Wait for the broker to confirm each publish before a message counts as delivered.",
+			Optional:    true,
+		},
+		"headers": schema.MapAttribute{
+			Description: "Headers added to every published RabbitMQ message, e.g. for headers exchanges.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		// Redis fields
+		"database_index": schema.Int64Attribute{
+			Description: "Redis logical database index. Defaults to 0.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"stream_key": schema.StringAttribute{
+			Description: "Key of the Redis stream messages are added to. Only for redis_stream.",
+			Optional:    true,
+		},
+		"max_len": schema.Int64Attribute{
+			Description: "Trim the Redis stream to about this many entries (XADD MAXLEN ~) so it cannot grow without bound. Only for redis_stream.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"ttl_seconds": schema.Int64Attribute{
+			Description: "Expire keys written by redis_string destinations after this many seconds. Only for redis_string.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		// Elasticsearch, Typesense and Meilisearch fields
+		"endpoint_url": schema.StringAttribute{
+			Description: "Elasticsearch, Typesense or Meilisearch endpoint URL.",
+			Optional:    true,
+		},
+		"index_name": schema.StringAttribute{
+			Description: "Elasticsearch or Meilisearch index documents are written to.",
+			Optional:    true,
+		},
+		"collection_name": schema.StringAttribute{
+			Description: "Typesense collection documents are written to.",
+			Optional:    true,
+		},
+		"auth_type": schema.StringAttribute{
+			Description: "Elasticsearch authentication: api_key (the api_key attribute) or basic (username and password). Either may instead come from credential_ref.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(client.ElasticsearchAuthTypes...),
+			},
+		},
+		"api_key": schema.StringAttribute{
+			Description: "Typesense or Meilisearch API key, or the Elasticsearch API key with auth_type = api_key.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"document_id_columns": schema.ListAttribute{
+			Description: "Columns of the streamed tables joined into the document ID of search destinations, so documents are addressed by a business key instead of the primary key. Checked against the tables during plan. Defaults to the primary key.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.RegexMatches(columnNamePattern, "must be a column name")),
+			},
+		},
+		"auto_create_index": schema.BoolAttribute{
+			Description: "Create the index on first write when it does not exist.",
+			Optional:    true,
+		},
+		"index_settings": schema.StringAttribute{
+			Description: "JSON settings and mappings the index is created with. Requires auto_create_index = true.",
+			Optional:    true,
+		},
+		// Shared credentials
+		"aws_credentials_source": schema.StringAttribute{
+			Description: "Where SQS, Kinesis and MSK IAM destinations get AWS credentials: static (the access key attributes), environment or instance_profile (the self-hosted Sequin host's ambient credentials, so no AWS keys are stored in Terraform state). Defaults to static.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(awsCredentialsSources...),
+			},
+		},
+		"credential_ref": schema.StringAttribute{
+			Description: "Name or ID of a sequin_credential whose secrets are used instead of inline password, access keys and other secrets, so rotating the credential updates every sink that references it. Conflicts with the inline secret attributes.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("password"),
+					path.MatchRelative().AtParent().AtName("aws_access_key_id"),
+					path.MatchRelative().AtParent().AtName("aws_secret_access_key"),
+					path.MatchRelative().AtParent().AtName("access_key_id"),
+					path.MatchRelative().AtParent().AtName("secret_access_key"),
+					path.MatchRelative().AtParent().AtName("gcp_credentials"),
+					path.MatchRelative().AtParent().AtName("shared_access_key"),
+					path.MatchRelative().AtParent().AtName("creds"),
+					path.MatchRelative().AtParent().AtName("nkey_seed"),
+					path.MatchRelative().AtParent().AtName("jwt"),
+					path.MatchRelative().AtParent().AtName("api_key"),
+				),
+			},
+		},
+	}
+}
+
+// buildDestinationRequest converts the planned destination object into the
+// API representation
+func buildDestinationRequest(destination types.Object) client.SinkConsumerDestination {
+	destAttrs := destination.Attributes()
+	apiDest := client.SinkConsumerDestination{
+		Type: destAttrs["type"].(types.String).ValueString(),
+	}
+
+	// Kafka fields
+	if hosts, ok := destAttrs["hosts"].(types.String); ok && !hosts.IsNull() {
+		apiDest.Hosts = hosts.ValueString()
+	}
+	if topic, ok := destAttrs["topic"].(types.String); ok && !topic.IsNull() {
+		apiDest.Topic = topic.ValueString()
+	}
+	if tls, ok := destAttrs["tls"].(types.Bool); ok && !tls.IsNull() {
+		val := tls.ValueBool()
+		apiDest.TLS = &val
+	}
+	if username, ok := destAttrs["username"].(types.String); ok && !username.IsNull() {
+		apiDest.Username = username.ValueString()
+	}
+	if password, ok := destAttrs["password"].(types.String); ok && !password.IsNull() {
+		apiDest.Password = password.ValueString()
+	}
+	if saslMech, ok := destAttrs["sasl_mechanism"].(types.String); ok && !saslMech.IsNull() {
+		apiDest.SASLMechanism = saslMech.ValueString()
+	}
+	if awsRegion, ok := destAttrs["aws_region"].(types.String); ok && !awsRegion.IsNull() {
+		apiDest.AWSRegion = awsRegion.ValueString()
+	}
+	if awsAccessKey, ok := destAttrs["aws_access_key_id"].(types.String); ok && !awsAccessKey.IsNull() {
+		apiDest.AWSAccessKeyID = awsAccessKey.ValueString()
+	}
+	if awsSecretKey, ok := destAttrs["aws_secret_access_key"].(types.String); ok && !awsSecretKey.IsNull() {
+		apiDest.AWSSecretAccessKey = awsSecretKey.ValueString()
+	}
+	if partitionKey, ok := destAttrs["partition_key_expression"].(types.String); ok && !partitionKey.IsNull() {
+		apiDest.PartitionKeyExpression = partitionKey.ValueString()
+	}
+
+	// SQS fields
+	if queueURL, ok := destAttrs["queue_url"].(types.String); ok && !queueURL.IsNull() {
+		apiDest.QueueURL = queueURL.ValueString()
+	}
+	if region, ok := destAttrs["region"].(types.String); ok && !region.IsNull() {
+		apiDest.Region = region.ValueString()
+	}
+	if accessKey, ok := destAttrs["access_key_id"].(types.String); ok && !accessKey.IsNull() {
+		apiDest.AccessKeyID = accessKey.ValueString()
+	}
+	if secretKey, ok := destAttrs["secret_access_key"].(types.String); ok && !secretKey.IsNull() {
+		apiDest.SecretAccessKey = secretKey.ValueString()
+	}
+	if isFIFO, ok := destAttrs["is_fifo"].(types.Bool); ok && !isFIFO.IsNull() {
+		val := isFIFO.ValueBool()
+		apiDest.IsFIFO = &val
+	}
+
+	// Kinesis fields
+	if streamARN, ok := destAttrs["stream_arn"].(types.String); ok && !streamARN.IsNull() {
+		apiDest.StreamARN = streamARN.ValueString()
+	}
+
+	// Webhook fields
+	if httpEndpoint, ok := destAttrs["http_endpoint"].(types.String); ok && !httpEndpoint.IsNull() {
+		apiDest.HTTPEndpoint = httpEndpoint.ValueString()
+	}
+	if httpEndpointID, ok := destAttrs["http_endpoint_id"].(types.String); ok && !httpEndpointID.IsNull() {
+		apiDest.HTTPEndpointID = httpEndpointID.ValueString()
+	}
+	if httpEndpointPath, ok := destAttrs["http_endpoint_path"].(types.String); ok && !httpEndpointPath.IsNull() {
+		apiDest.HTTPEndpointPath = httpEndpointPath.ValueString()
+	}
+	if batch, ok := destAttrs["batch"].(types.Bool); ok && !batch.IsNull() {
+		val := batch.ValueBool()
+		apiDest.Batch = &val
+	}
+
+	if batchFormat, ok := destAttrs["batch_format"].(types.String); ok && !batchFormat.IsNull() {
+		apiDest.BatchFormat = batchFormat.ValueString()
+	}
+	if batchMaxBytes, ok := destAttrs["batch_max_bytes"].(types.Int64); ok && !batchMaxBytes.IsNull() {
+		val := batchMaxBytes.ValueInt64()
+		apiDest.BatchMaxBytes = &val
+	}
+
+	// Pub/Sub fields
+	if projectID, ok := destAttrs["project_id"].(types.String); ok && !projectID.IsNull() {
+		apiDest.ProjectID = projectID.ValueString()
+	}
+	if topicID, ok := destAttrs["topic_id"].(types.String); ok && !topicID.IsNull() {
+		apiDest.TopicID = topicID.ValueString()
+	}
+	if gcpCredentials, ok := destAttrs["gcp_credentials"].(types.String); ok && !gcpCredentials.IsNull() {
+		apiDest.GCPCredentials = gcpCredentials.ValueString()
+	}
+	if workloadIdentity, ok := destAttrs["use_workload_identity"].(types.Bool); ok && !workloadIdentity.IsNull() {
+		val := workloadIdentity.ValueBool()
+		apiDest.UseWorkloadIdentity = &val
+	}
+
+	// Event Hubs fields
+	if namespace, ok := destAttrs["namespace"].(types.String); ok && !namespace.IsNull() {
+		apiDest.Namespace = namespace.ValueString()
+	}
+	if eventHubName, ok := destAttrs["event_hub_name"].(types.String); ok && !eventHubName.IsNull() {
+		apiDest.EventHubName = eventHubName.ValueString()
+	}
+	if keyName, ok := destAttrs["shared_access_key_name"].(types.String); ok && !keyName.IsNull() {
+		apiDest.SharedAccessKeyName = keyName.ValueString()
+	}
+	if key, ok := destAttrs["shared_access_key"].(types.String); ok && !key.IsNull() {
+		apiDest.SharedAccessKey = key.ValueString()
+	}
+	if clientID, ok := destAttrs["managed_identity_client_id"].(types.String); ok && !clientID.IsNull() {
+		apiDest.ManagedIdentityClientID = clientID.ValueString()
+	}
+
+	// NATS fields
+	if creds, ok := destAttrs["creds"].(types.String); ok && !creds.IsNull() {
+		apiDest.Creds = creds.ValueString()
+	}
+	if nkeySeed, ok := destAttrs["nkey_seed"].(types.String); ok && !nkeySeed.IsNull() {
+		apiDest.NKeySeed = nkeySeed.ValueString()
+	}
+	if jwt, ok := destAttrs["jwt"].(types.String); ok && !jwt.IsNull() {
+		apiDest.JWT = jwt.ValueString()
+	}
+
+	// RabbitMQ fields
+	if virtualHost, ok := destAttrs["virtual_host"].(types.String); ok && !virtualHost.IsNull() {
+		apiDest.VirtualHost = virtualHost.ValueString()
+	}
+	if exchange, ok := destAttrs["exchange"].(types.String); ok && !exchange.IsNull() {
+		apiDest.Exchange = exchange.ValueString()
+	}
+	if exchangeType, ok := destAttrs["exchange_type"].(types.String); ok && !exchangeType.IsNull() {
+		apiDest.ExchangeType = exchangeType.ValueString()
+	}
+	if durable, ok := destAttrs["durable"].(types.Bool); ok && !durable.IsNull() {
+		val := durable.ValueBool()
+		apiDest.Durable = &val
+	}
+	if autoDelete, ok := destAttrs["auto_delete"].(types.Bool); ok && !autoDelete.IsNull() {
+		val := autoDelete.ValueBool()
+		apiDest.AutoDelete = &val
+	}
+	if confirms, ok := destAttrs["publisher_confirms"].(types.Bool); ok && !confirms.IsNull() {
+		val := confirms.ValueBool()
+		apiDest.PublisherConfirms = &val
+	}
+	if headers, ok := destAttrs["headers"].(types.Map); ok && !headers.IsNull() {
+		apiDest.Headers = make(map[string]string, len(headers.Elements()))
+		for name, value := range headers.Elements() {
+			if value, ok := value.(types.String); ok {
+				apiDest.Headers[name] = value.ValueString()
+			}
+		}
+	}
+
+	// Redis fields
+	if databaseIndex, ok := destAttrs["database_index"].(types.Int64); ok && !databaseIndex.IsNull() {
+		val := databaseIndex.ValueInt64()
+		apiDest.DatabaseIndex = &val
+	}
+	if streamKey, ok := destAttrs["stream_key"].(types.String); ok && !streamKey.IsNull() {
+		apiDest.StreamKey = streamKey.ValueString()
+	}
+	if maxLen, ok := destAttrs["max_len"].(types.Int64); ok && !maxLen.IsNull() {
+		val := maxLen.ValueInt64()
+		apiDest.MaxLen = &val
+	}
+	if ttl, ok := destAttrs["ttl_seconds"].(types.Int64); ok && !ttl.IsNull() {
+		val := ttl.ValueInt64()
+		apiDest.TTLSeconds = &val
+	}
+
+	// Elasticsearch fields
+	if endpointURL, ok := destAttrs["endpoint_url"].(types.String); ok && !endpointURL.IsNull() {
+		apiDest.EndpointURL = endpointURL.ValueString()
+	}
+	if indexName, ok := destAttrs["index_name"].(types.String); ok && !indexName.IsNull() {
+		apiDest.IndexName = indexName.ValueString()
+	}
+	if authType, ok := destAttrs["auth_type"].(types.String); ok && !authType.IsNull() {
+		apiDest.AuthType = authType.ValueString()
+	}
+	if apiKey, ok := destAttrs["api_key"].(types.String); ok && !apiKey.IsNull() {
+		apiDest.APIKey = apiKey.ValueString()
+	}
+	if columns, ok := destAttrs["document_id_columns"].(types.List); ok && !columns.IsNull() {
+		apiDest.DocumentIDColumns = listStrings(columns)
+	}
+	if autoCreate, ok := destAttrs["auto_create_index"].(types.Bool); ok && !autoCreate.IsNull() {
+		val := autoCreate.ValueBool()
+		apiDest.AutoCreateIndex = &val
+	}
+	if settings, ok := destAttrs["index_settings"].(types.String); ok && !settings.IsNull() {
+		apiDest.IndexSettings = settings.ValueString()
+	}
+	if collection, ok := destAttrs["collection_name"].(types.String); ok && !collection.IsNull() {
+		apiDest.CollectionName = collection.ValueString()
+	}
+
+	// Shared credentials
+	if source, ok := destAttrs["aws_credentials_source"].(types.String); ok && !source.IsNull() {
+		apiDest.AWSCredentialsSource = source.ValueString()
+	}
+	if credentialRef, ok := destAttrs["credential_ref"].(types.String); ok && !credentialRef.IsNull() {
+		apiDest.CredentialRef = credentialRef.ValueString()
+	}
+
+	return apiDest
+}
+
+// mapDestinationToObject converts an API destination into the Terraform
+// object, preserving values from prior state that the API does not return
+func mapDestinationToObject(apiDest client.SinkConsumerDestination, prior types.Object, diags *diag.Diagnostics) types.Object {
+	destAttrs := map[string]attr.Value{
+		"type":                       types.StringValue(apiDest.Type),
+		"hosts":                      types.StringNull(),
+		"topic":                      types.StringNull(),
+		"tls":                        types.BoolNull(),
+		"username":                   types.StringNull(),
+		"password":                   types.StringNull(),
+		"sasl_mechanism":             types.StringNull(),
+		"aws_region":                 types.StringNull(),
+		"aws_access_key_id":          types.StringNull(),
+		"aws_secret_access_key":      types.StringNull(),
+		"partition_key_expression":   types.StringNull(),
+		"queue_url":                  types.StringNull(),
+		"region":                     types.StringNull(),
+		"access_key_id":              types.StringNull(),
+		"secret_access_key":          types.StringNull(),
+		"is_fifo":                    types.BoolNull(),
+		"stream_arn":                 types.StringNull(),
+		"http_endpoint":              types.StringNull(),
+		"http_endpoint_id":           types.StringNull(),
+		"http_endpoint_path":         types.StringNull(),
+		"batch":                      types.BoolNull(),
+		"batch_format":               types.StringNull(),
+		"batch_max_bytes":            types.Int64Null(),
+		"credential_ref":             types.StringNull(),
+		"aws_credentials_source":     types.StringNull(),
+		"project_id":                 types.StringNull(),
+		"topic_id":                   types.StringNull(),
+		"gcp_credentials":            types.StringNull(),
+		"use_workload_identity":      types.BoolNull(),
+		"namespace":                  types.StringNull(),
+		"event_hub_name":             types.StringNull(),
+		"shared_access_key_name":     types.StringNull(),
+		"shared_access_key":          types.StringNull(),
+		"managed_identity_client_id": types.StringNull(),
+		"creds":                      types.StringNull(),
+		"nkey_seed":                  types.StringNull(),
+		"jwt":                        types.StringNull(),
+		"virtual_host":               types.StringNull(),
+		"exchange":                   types.StringNull(),
+		"exchange_type":              types.StringNull(),
+		"durable":                    types.BoolNull(),
+		"auto_delete":                types.BoolNull(),
+		"publisher_confirms":         types.BoolNull(),
+		"headers":                    types.MapNull(types.StringType),
+		"database_index":             types.Int64Null(),
+		"stream_key":                 types.StringNull(),
+		"max_len":                    types.Int64Null(),
+		"ttl_seconds":                types.Int64Null(),
+		"endpoint_url":               types.StringNull(),
+		"index_name":                 types.StringNull(),
+		"auth_type":                  types.StringNull(),
+		"api_key":                    types.StringNull(),
+		"document_id_columns":        types.ListNull(types.StringType),
+		"auto_create_index":          types.BoolNull(),
+		"index_settings":             types.StringNull(),
+		"collection_name":            types.StringNull(),
+	}
+
+	// Populate non-empty fields
+	if apiDest.Hosts != "" {
+		destAttrs["hosts"] = types.StringValue(apiDest.Hosts)
+	}
+	if apiDest.Topic != "" {
+		destAttrs["topic"] = types.StringValue(apiDest.Topic)
+	}
+	if apiDest.TLS != nil {
+		destAttrs["tls"] = types.BoolValue(*apiDest.TLS)
+	}
+	if apiDest.Username != "" {
+		destAttrs["username"] = types.StringValue(apiDest.Username)
+	}
+	// Preserve values from existing state that the API doesn't return
+	if !prior.IsNull() {
+		origDestAttrs := prior.Attributes()
+		// Preserve sensitive fields (API doesn't return them)
+		if origPassword, ok := origDestAttrs["password"].(types.String); ok && !origPassword.IsNull() {
+			destAttrs["password"] = origPassword
+		}
+		if origAWSAccessKey, ok := origDestAttrs["aws_access_key_id"].(types.String); ok && !origAWSAccessKey.IsNull() {
+			destAttrs["aws_access_key_id"] = origAWSAccessKey
+		}
+		if origAWSSecretKey, ok := origDestAttrs["aws_secret_access_key"].(types.String); ok && !origAWSSecretKey.IsNull() {
+			destAttrs["aws_secret_access_key"] = origAWSSecretKey
+		}
+		if origSecretKey, ok := origDestAttrs["secret_access_key"].(types.String); ok && !origSecretKey.IsNull() {
+			destAttrs["secret_access_key"] = origSecretKey
+		}
+		if origAccessKey, ok := origDestAttrs["access_key_id"].(types.String); ok && !origAccessKey.IsNull() {
+			destAttrs["access_key_id"] = origAccessKey
+		}
+		if origGCPCredentials, ok := origDestAttrs["gcp_credentials"].(types.String); ok && !origGCPCredentials.IsNull() {
+			destAttrs["gcp_credentials"] = origGCPCredentials
+		}
+		if origSharedAccessKey, ok := origDestAttrs["shared_access_key"].(types.String); ok && !origSharedAccessKey.IsNull() {
+			destAttrs["shared_access_key"] = origSharedAccessKey
+		}
+		for _, name := range []string{"creds", "nkey_seed", "jwt", "api_key"} {
+			if orig, ok := origDestAttrs[name].(types.String); ok && !orig.IsNull() {
+				destAttrs[name] = orig
+			}
+		}
+		// Preserve topic from state if API returns empty (e.g. when routing overrides topic)
+		if apiDest.Topic == "" {
+			if origTopic, ok := origDestAttrs["topic"].(types.String); ok && !origTopic.IsNull() {
+				destAttrs["topic"] = origTopic
+			}
+		}
+	}
+	if apiDest.SASLMechanism != "" {
+		destAttrs["sasl_mechanism"] = types.StringValue(apiDest.SASLMechanism)
+	}
+	if apiDest.AWSRegion != "" {
+		destAttrs["aws_region"] = types.StringValue(apiDest.AWSRegion)
+	}
+	if apiDest.PartitionKeyExpression != "" {
+		destAttrs["partition_key_expression"] = types.StringValue(apiDest.PartitionKeyExpression)
+	}
+	if apiDest.QueueURL != "" {
+		destAttrs["queue_url"] = types.StringValue(apiDest.QueueURL)
+	}
+	if apiDest.Region != "" {
+		destAttrs["region"] = types.StringValue(apiDest.Region)
+	}
+	if apiDest.AccessKeyID != "" {
+		destAttrs["access_key_id"] = types.StringValue(apiDest.AccessKeyID)
+	}
+	if apiDest.SecretAccessKey != "" {
+		destAttrs["secret_access_key"] = types.StringValue(apiDest.SecretAccessKey)
+	}
+	if apiDest.IsFIFO != nil {
+		destAttrs["is_fifo"] = types.BoolValue(*apiDest.IsFIFO)
+	}
+	if apiDest.StreamARN != "" {
+		destAttrs["stream_arn"] = types.StringValue(apiDest.StreamARN)
+	}
+	// When delivering to a referenced endpoint the API also reports that
+	// endpoint's URL; only keep it if the configuration set it
+	if apiDest.HTTPEndpoint != "" && (apiDest.HTTPEndpointID == "" || priorStringSet(prior, "http_endpoint")) {
+		destAttrs["http_endpoint"] = types.StringValue(apiDest.HTTPEndpoint)
+	}
+	if apiDest.HTTPEndpointID != "" {
+		destAttrs["http_endpoint_id"] = types.StringValue(apiDest.HTTPEndpointID)
+	}
+	if apiDest.HTTPEndpointPath != "" {
+		destAttrs["http_endpoint_path"] = types.StringValue(apiDest.HTTPEndpointPath)
+	}
+	if apiDest.Batch != nil {
+		destAttrs["batch"] = types.BoolValue(*apiDest.Batch)
+	}
+	// The API reports the json_array default for batched sinks; only keep it
+	// if configured
+	if apiDest.BatchFormat != "" &&
+		(apiDest.BatchFormat != webhookBatchFormatJSONArray || priorStringSet(prior, "batch_format")) {
+		destAttrs["batch_format"] = types.StringValue(apiDest.BatchFormat)
+	}
+	if apiDest.BatchMaxBytes != nil {
+		destAttrs["batch_max_bytes"] = types.Int64Value(*apiDest.BatchMaxBytes)
+	}
+	if apiDest.ProjectID != "" {
+		destAttrs["project_id"] = types.StringValue(apiDest.ProjectID)
+	}
+	if apiDest.TopicID != "" {
+		destAttrs["topic_id"] = types.StringValue(apiDest.TopicID)
+	}
+	if apiDest.UseWorkloadIdentity != nil {
+		destAttrs["use_workload_identity"] = types.BoolValue(*apiDest.UseWorkloadIdentity)
+	}
+	if apiDest.Namespace != "" {
+		destAttrs["namespace"] = types.StringValue(apiDest.Namespace)
+	}
+	if apiDest.EventHubName != "" {
+		destAttrs["event_hub_name"] = types.StringValue(apiDest.EventHubName)
+	}
+	if apiDest.SharedAccessKeyName != "" {
+		destAttrs["shared_access_key_name"] = types.StringValue(apiDest.SharedAccessKeyName)
+	}
+	if apiDest.ManagedIdentityClientID != "" {
+		destAttrs["managed_identity_client_id"] = types.StringValue(apiDest.ManagedIdentityClientID)
+	}
+	if apiDest.VirtualHost != "" {
+		destAttrs["virtual_host"] = types.StringValue(apiDest.VirtualHost)
+	}
+	if apiDest.Exchange != "" {
+		destAttrs["exchange"] = types.StringValue(apiDest.Exchange)
+	}
+	if apiDest.ExchangeType != "" {
+		destAttrs["exchange_type"] = types.StringValue(apiDest.ExchangeType)
+	}
+	if apiDest.Durable != nil {
+		destAttrs["durable"] = types.BoolValue(*apiDest.Durable)
+	}
+	if apiDest.AutoDelete != nil {
+		destAttrs["auto_delete"] = types.BoolValue(*apiDest.AutoDelete)
+	}
+	if apiDest.PublisherConfirms != nil {
+		destAttrs["publisher_confirms"] = types.BoolValue(*apiDest.PublisherConfirms)
+	}
+	if len(apiDest.Headers) > 0 {
+		headers := make(map[string]attr.Value, len(apiDest.Headers))
+		for name, value := range apiDest.Headers {
+			headers[name] = types.StringValue(value)
+		}
+		headersMap, d := types.MapValue(types.StringType, headers)
+		diags.Append(d...)
+		destAttrs["headers"] = headersMap
+	}
+	if apiDest.DatabaseIndex != nil {
+		destAttrs["database_index"] = types.Int64Value(*apiDest.DatabaseIndex)
+	}
+	if apiDest.StreamKey != "" {
+		destAttrs["stream_key"] = types.StringValue(apiDest.StreamKey)
+	}
+	if apiDest.MaxLen != nil {
+		destAttrs["max_len"] = types.Int64Value(*apiDest.MaxLen)
+	}
+	if apiDest.TTLSeconds != nil {
+		destAttrs["ttl_seconds"] = types.Int64Value(*apiDest.TTLSeconds)
+	}
+	if apiDest.EndpointURL != "" {
+		destAttrs["endpoint_url"] = types.StringValue(apiDest.EndpointURL)
+	}
+	if apiDest.IndexName != "" {
+		destAttrs["index_name"] = types.StringValue(apiDest.IndexName)
+	}
+	if apiDest.AuthType != "" {
+		destAttrs["auth_type"] = types.StringValue(apiDest.AuthType)
+	}
+	if len(apiDest.DocumentIDColumns) > 0 {
+		columns := make([]attr.Value, 0, len(apiDest.DocumentIDColumns))
+		for _, column := range apiDest.DocumentIDColumns {
+			columns = append(columns, types.StringValue(column))
+		}
+		columnList, d := types.ListValue(types.StringType, columns)
+		diags.Append(d...)
+		destAttrs["document_id_columns"] = columnList
+	}
+	if apiDest.AutoCreateIndex != nil {
+		destAttrs["auto_create_index"] = types.BoolValue(*apiDest.AutoCreateIndex)
+	}
+	// The API may reformat the settings JSON; keep the configured text when
+	// it is equivalent
+	if apiDest.IndexSettings != "" {
+		destAttrs["index_settings"] = types.StringValue(apiDest.IndexSettings)
+		if priorStringSet(prior, "index_settings") {
+			if orig := prior.Attributes()["index_settings"].(types.String); sameJSON(orig.ValueString(), apiDest.IndexSettings) {
+				destAttrs["index_settings"] = orig
+			}
+		}
+	}
+	if apiDest.CollectionName != "" {
+		destAttrs["collection_name"] = types.StringValue(apiDest.CollectionName)
+	}
+	if apiDest.CredentialRef != "" {
+		destAttrs["credential_ref"] = types.StringValue(apiDest.CredentialRef)
+	}
+	// The API reports the static default; only keep it if configured
+	if apiDest.AWSCredentialsSource != "" &&
+		(apiDest.AWSCredentialsSource != awsCredentialsStatic || priorStringSet(prior, "aws_credentials_source")) {
+		destAttrs["aws_credentials_source"] = types.StringValue(apiDest.AWSCredentialsSource)
+	}
+
+	destObj, d := types.ObjectValue(destinationAttrTypes, destAttrs)
+	diags.Append(d...)
+	return destObj
+}
+
+// sameJSON reports whether two JSON documents are equivalent, ignoring
+// formatting and key order. Invalid JSON is compared as text.
+func sameJSON(a, b string) bool {
+	var va, vb any
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// priorStringSet reports whether the named string attribute is set in the
+// prior destination object
+func priorStringSet(prior types.Object, name string) bool {
+	if prior.IsNull() || prior.IsUnknown() {
+		return false
+	}
+	value, ok := prior.Attributes()[name].(types.String)
+	return ok && !value.IsNull()
+}
+
+// destinationFingerprint returns the credential fingerprint tracked for drift
+// detection. Referenced credentials are rotated through sequin_credential and
+// ambient AWS credentials, workload identity and managed identities by the
+// Sequin host, so fingerprint changes on sinks that use them are expected and
+// not tracked.
+func destinationFingerprint(apiDest client.SinkConsumerDestination) string {
+	if apiDest.CredentialRef != "" || usesAmbientAWSCredentials(apiDest.AWSCredentialsSource) ||
+		(apiDest.UseWorkloadIdentity != nil && *apiDest.UseWorkloadIdentity) || apiDest.ManagedIdentityClientID != "" {
+		return ""
+	}
+	return apiDest.CredentialFingerprint
+}
+
+// validateAWSCredentialsSource reports static AWS keys or a credential_ref
+// configured alongside an ambient credentials source, which would otherwise
+// be silently ignored while still landing in state
+func validateAWSCredentialsSource(destination types.Object, at path.Path, diags *diag.Diagnostics) {
+	if destination.IsNull() || destination.IsUnknown() {
+		return
+	}
+	attrs := destination.Attributes()
+	source, ok := attrs["aws_credentials_source"].(types.String)
+	if !ok || source.IsNull() || source.IsUnknown() || !usesAmbientAWSCredentials(source.ValueString()) {
+		return
+	}
+
+	for _, name := range append(awsKeyAttributes, "credential_ref") {
+		if value, ok := attrs[name].(types.String); ok && !value.IsNull() {
+			diags.AddAttributeError(
+				at.AtName(name),
+				"Conflicting AWS Credentials",
+				fmt.Sprintf("%s cannot be set when aws_credentials_source is %q; the Sequin host's own credentials are used instead.",
+					name, source.ValueString()),
+			)
+		}
+	}
+}
+
+// destinationAuth lists the attributes that each select an authentication
+// method of a destination type; bool attributes count when true. At most one
+// may be configured, and exactly one when required.
+type destinationAuth struct {
+	methods  []string
+	required bool
+}
+
+var destinationAuthMethods = map[string]destinationAuth{
+	client.DestPubSub:        {[]string{"gcp_credentials", "credential_ref", "use_workload_identity"}, true},
+	client.DestEventHub:      {[]string{"shared_access_key", "credential_ref", "managed_identity_client_id"}, true},
+	client.DestNATS:          {[]string{"password", "creds", "nkey_seed", "credential_ref"}, false},
+	client.DestElasticsearch: {[]string{"api_key", "password", "credential_ref"}, false},
+	client.DestTypesense:     {[]string{"api_key", "credential_ref"}, true},
+	client.DestMeilisearch:   {[]string{"api_key", "credential_ref"}, true},
+}
+
+// validateDestinationAuth reports a destination configured with more than one
+// authentication method of its type, or none when one is required
+func validateDestinationAuth(destination types.Object, at path.Path, diags *diag.Diagnostics) {
+	if destination.IsNull() || destination.IsUnknown() {
+		return
+	}
+	attrs := destination.Attributes()
+	destType, ok := attrs["type"].(types.String)
+	if !ok || destType.IsUnknown() {
+		return
+	}
+	auth, ok := destinationAuthMethods[destType.ValueString()]
+	if !ok {
+		return
+	}
+
+	var methods []string
+	for _, name := range auth.methods {
+		value := attrs[name]
+		if value == nil || value.IsUnknown() {
+			return
+		}
+		if b, ok := value.(types.Bool); ok && !b.ValueBool() {
+			continue
+		}
+		if !value.IsNull() {
+			methods = append(methods, name)
+		}
+	}
+
+	switch {
+	case len(methods) == 0 && auth.required:
+		diags.AddAttributeError(
+			at.AtName(auth.methods[0]),
+			"Missing Destination Authentication",
+			fmt.Sprintf("%s destinations need one of %s.", destType.ValueString(), strings.Join(auth.methods, ", ")),
+		)
+	case len(methods) > 1:
+		diags.AddAttributeError(
+			at.AtName(methods[1]),
+			"Conflicting Destination Authentication",
+			fmt.Sprintf("Only one %s authentication method can be configured, got %s.", destType.ValueString(), strings.Join(methods, " and ")),
+		)
+	}
+}
+
+// validateWebhookBatching reports batch tuning configured on a destination
+// that does not batch, where it would have no effect
+func validateWebhookBatching(destination types.Object, at path.Path, diags *diag.Diagnostics) {
+	if destination.IsNull() || destination.IsUnknown() {
+		return
+	}
+	attrs := destination.Attributes()
+	batch, ok := attrs["batch"].(types.Bool)
+	if !ok || batch.IsUnknown() || batch.ValueBool() {
+		return
+	}
+
+	for _, name := range []string{"batch_format", "batch_max_bytes"} {
+		if value, ok := attrs[name]; ok && !value.IsNull() {
+			diags.AddAttributeError(
+				at.AtName(name),
+				"Batch Setting Without Batching",
+				name+" only applies to batched webhook delivery; set batch = true or remove it.",
+			)
+		}
+	}
+}
+
+// validateKafkaPartitionKey reports a partition key expression on a
+// destination other than Kafka, where it would have no effect
+func validateKafkaPartitionKey(destination types.Object, at path.Path, diags *diag.Diagnostics) {
+	if destination.IsNull() || destination.IsUnknown() {
+		return
+	}
+	attrs := destination.Attributes()
+	destType, ok := attrs["type"].(types.String)
+	if !ok || destType.IsNull() || destType.IsUnknown() || destType.ValueString() == client.DestKafka {
+		return
+	}
+
+	if value, ok := attrs["partition_key_expression"]; ok && !value.IsNull() {
+		diags.AddAttributeError(
+			at.AtName("partition_key_expression"),
+			"Partition Key Without Kafka",
+			"partition_key_expression only applies to kafka destinations, not "+destType.ValueString()+".",
+		)
+	}
+}
+
+// validateElasticsearchOptions reports Elasticsearch credentials that do not
+// match auth_type, and index settings without index creation
+func validateElasticsearchOptions(destination types.Object, at path.Path, diags *diag.Diagnostics) {
+	if destination.IsNull() || destination.IsUnknown() {
+		return
+	}
+	attrs := destination.Attributes()
+	destType, ok := attrs["type"].(types.String)
+	if !ok || destType.IsUnknown() || destType.ValueString() != client.DestElasticsearch {
+		return
+	}
+
+	if settings, ok := attrs["index_settings"].(types.String); ok && !settings.IsNull() && !settings.IsUnknown() {
+		if autoCreate, ok := attrs["auto_create_index"].(types.Bool); ok && !autoCreate.IsUnknown() && !autoCreate.ValueBool() {
+			diags.AddAttributeError(
+				at.AtName("index_settings"),
+				"Index Settings Without Index Creation",
+				"index_settings only applies when the index is created; set auto_create_index = true or remove it.",
+			)
+		}
+		if !json.Valid([]byte(settings.ValueString())) {
+			diags.AddAttributeError(at.AtName("index_settings"), "Invalid Index Settings", "index_settings must be a JSON object.")
+		}
+	}
+
+	authType, ok := attrs["auth_type"].(types.String)
+	if !ok || authType.IsUnknown() {
+		return
+	}
+	var forbidden []string
+	var secret string
+	detail := "%s cannot be set when auth_type is " + strconv.Quote(authType.ValueString()) + "."
+	switch authType.ValueString() {
+	case client.ElasticsearchAuthAPIKey:
+		forbidden, secret = []string{"username", "password"}, "api_key"
+	case client.ElasticsearchAuthBasic:
+		forbidden, secret = []string{"api_key"}, "password"
+	default:
+		forbidden = []string{"api_key", "username", "password", "credential_ref"}
+		detail = "%s requires auth_type to be set."
+	}
+	for _, name := range forbidden {
+		if value, ok := attrs[name]; ok && !value.IsNull() {
+			diags.AddAttributeError(at.AtName(name), "Credentials Do Not Match Authentication Type", fmt.Sprintf(detail, name))
+		}
+	}
+
+	// The credential auth_type selects must be set, inline or through a
+	// referenced credential
+	if secret == "" {
+		return
+	}
+	for _, name := range []string{secret, "credential_ref"} {
+		if value, ok := attrs[name]; !ok || value.IsUnknown() || !value.IsNull() {
+			return
+		}
+	}
+	diags.AddAttributeError(
+		at.AtName(secret),
+		"Missing Destination Authentication",
+		fmt.Sprintf("auth_type %q needs %s or credential_ref.", authType.ValueString(), secret),
+	)
+}
+
+// validateDocumentIDColumns reports document ID columns on a destination that
+// does not index documents, where they would have no effect
+func validateDocumentIDColumns(destination types.Object, at path.Path, diags *diag.Diagnostics) {
+	if destination.IsNull() || destination.IsUnknown() {
+		return
+	}
+	attrs := destination.Attributes()
+	destType, ok := attrs["type"].(types.String)
+	if !ok || destType.IsNull() || destType.IsUnknown() || slices.Contains(client.SearchDestinationTypes, destType.ValueString()) {
+		return
+	}
+
+	if value, ok := attrs["document_id_columns"]; ok && !value.IsNull() {
+		diags.AddAttributeError(
+			at.AtName("document_id_columns"),
+			"Document ID Columns Without Search Destination",
+			fmt.Sprintf("document_id_columns only applies to %s destinations, not %s.",
+				strings.Join(client.SearchDestinationTypes, ", "), destType.ValueString()),
+		)
+	}
+}
+
+// documentIDColumns returns the configured document ID columns of a
+// destination, or nil when they are unset or unknown
+func documentIDColumns(destination types.Object) []string {
+	if destination.IsNull() || destination.IsUnknown() {
+		return nil
+	}
+	columns, ok := destination.Attributes()["document_id_columns"].(types.List)
+	if !ok || columns.IsNull() || columns.IsUnknown() {
+		return nil
+	}
+	return listStrings(columns)
+}
+
+// redisAttributeTypes lists the Redis destination types each Redis-only
+// attribute applies to
+var redisAttributeTypes = map[string][]string{
+	"database_index": {client.DestRedisStream, client.DestRedisString},
+	"stream_key":     {client.DestRedisStream},
+	"max_len":        {client.DestRedisStream},
+	"ttl_seconds":    {client.DestRedisString},
+}
+
+// validateRedisOptions reports Redis settings on a destination type they do
+// not apply to, where they would have no effect
+func validateRedisOptions(destination types.Object, at path.Path, diags *diag.Diagnostics) {
+	if destination.IsNull() || destination.IsUnknown() {
+		return
+	}
+	attrs := destination.Attributes()
+	destType, ok := attrs["type"].(types.String)
+	if !ok || destType.IsNull() || destType.IsUnknown() {
+		return
+	}
+
+	for _, name := range []string{"database_index", "stream_key", "max_len", "ttl_seconds"} {
+		destTypes := redisAttributeTypes[name]
+		if value, ok := attrs[name]; ok && !value.IsNull() && !slices.Contains(destTypes, destType.ValueString()) {
+			diags.AddAttributeError(
+				at.AtName(name),
+				"Redis Setting For Another Destination Type",
+				fmt.Sprintf("%s only applies to %s destinations, not %s.", name, strings.Join(destTypes, " and "), destType.ValueString()),
+			)
+		}
+	}
+}
+
+// validateDestinationPlatform reports destination settings the configured
+// platform cannot serve: ambient AWS credentials, workload and managed
+// identities, and private network hosts on Sequin Cloud
+func validateDestinationPlatform(c client.SequinAPI, destination types.Object, at path.Path, diags *diag.Diagnostics) {
+	if destination.IsNull() || destination.IsUnknown() {
+		return
+	}
+	attrs := destination.Attributes()
+
+	if source, ok := attrs["aws_credentials_source"].(types.String); ok && !source.IsNull() && !source.IsUnknown() &&
+		usesAmbientAWSCredentials(source.ValueString()) {
+		requireSelfHosted(c, fmt.Sprintf("aws_credentials_source = %q", source.ValueString()), at.AtName("aws_credentials_source"), diags)
+	}
+
+	if workloadIdentity, ok := attrs["use_workload_identity"].(types.Bool); ok && workloadIdentity.ValueBool() {
+		requireSelfHosted(c, "use_workload_identity = true", at.AtName("use_workload_identity"), diags)
+	}
+	if clientID, ok := attrs["managed_identity_client_id"].(types.String); ok && !clientID.IsNull() {
+		requireSelfHosted(c, "managed_identity_client_id", at.AtName("managed_identity_client_id"), diags)
+	}
+
+	if hosts, ok := attrs["hosts"].(types.String); ok && !hosts.IsNull() && !hosts.IsUnknown() {
+		for _, host := range strings.Split(hosts.ValueString(), ",") {
+			requireReachableFromCloud(c, types.StringValue(strings.TrimSpace(host)), at.AtName("hosts"), diags)
+		}
+	}
+	for _, name := range []string{"http_endpoint", "endpoint_url"} {
+		if endpoint, ok := attrs[name].(types.String); ok {
+			requireReachableFromCloud(c, urlHost(endpoint), at.AtName(name), diags)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// enrichmentPlan returns a plan for a sink with the given enrichment
+func enrichmentPlan(t *testing.T, r *SinkConsumerResource, enrichment string) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	model := newRoundTripPlan(t, "webhook", map[string]attr.Value{
+		"http_endpoint": types.StringValue("https://api.example.com"),
+	})
+	model.Enrichment = types.StringValue(enrichment)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("building plan: %v", diags.Errors())
+	}
+	return tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw}
+}
+
+func TestValidatePlannedEnrichment(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path == "/api/functions/broken-lookup/validate" {
+			w.Write([]byte(`{"valid": false, "errors": ["column \"tier\" does not exist"]}`))
+			return
+		}
+		w.Write([]byte(`{"valid": true}`))
+	}))
+	defer server.Close()
+
+	c := client.New(server.URL, "key", "1.0.0")
+	c.ValidateEnrichment = true
+	r := &SinkConsumerResource{client: c}
+	ctx := context.Background()
+
+	run := func(enrichment string, state tfsdk.State) *resource.ModifyPlanResponse {
+		plan := enrichmentPlan(t, r, enrichment)
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		r.validatePlannedEnrichment(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+		return resp
+	}
+	noState := tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil)}
+
+	resp := run("broken-lookup", noState)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("invalid enrichment should fail the plan")
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, `column "tier" does not exist`) {
+		t.Errorf("detail = %q, want the validation error", detail)
+	}
+
+	if resp := run("customer-lookup", noState); resp.Diagnostics.HasError() {
+		t.Errorf("valid enrichment should pass, got: %v", resp.Diagnostics.Errors())
+	}
+
+	// Unchanged enrichment and database skip the API call
+	plan := enrichmentPlan(t, r, "broken-lookup")
+	before := calls
+	if resp := run("broken-lookup", tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}); resp.Diagnostics.HasError() {
+		t.Errorf("unchanged enrichment should not be revalidated, got: %v", resp.Diagnostics.Errors())
+	}
+	if calls != before {
+		t.Errorf("unchanged enrichment made %d validation calls, want 0", calls-before)
+	}
+
+	// Opt-in only
+	c.ValidateEnrichment = false
+	if resp := run("broken-lookup", noState); resp.Diagnostics.HasError() {
+		t.Error("validation should be skipped unless validate_enrichment is set")
+	}
+}
@@ -0,0 +1,181 @@
+package resources
+
+import (
+	"context"
+	"sort"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Failover server defaults, reported by the API when not configured
+const failoverDefaultUnavailableSeconds = 300
+
+var failoverDefaultConditions = []string{"connection_error", "timeout"}
+
+// failoverConditions lists the primary destination health failures that
+// count towards failing over
+var failoverConditions = []string{"connection_error", "timeout", "server_error", "throttled", "auth_error"}
+
+// failoverPolicyAttrTypes describes the failover attributes added to the
+// destination attributes of failover_destination
+var failoverPolicyAttrTypes = map[string]attr.Type{
+	"unavailable_for_seconds": types.Int64Type,
+	"conditions":              types.ListType{ElemType: types.StringType},
+	"fail_back":               types.BoolType,
+}
+
+// failoverDestinationAttrTypes describes the sink consumer
+// failover_destination object
+var failoverDestinationAttrTypes = func() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(destinationAttrTypes)+len(failoverPolicyAttrTypes))
+	for name, attrType := range destinationAttrTypes {
+		attrTypes[name] = attrType
+	}
+	for name, attrType := range failoverPolicyAttrTypes {
+		attrTypes[name] = attrType
+	}
+	return attrTypes
+}()
+
+// failoverDestinationSchemaAttribute returns the schema of the
+// failover_destination attribute: the destination attributes plus the
+// conditions under which Sequin switches to it
+func failoverDestinationSchemaAttribute() schema.SingleNestedAttribute {
+	attributes := destinationSchemaAttributes()
+	attributes["unavailable_for_seconds"] = schema.Int64Attribute{
+		Description: "How long the primary destination must keep failing before Sequin delivers to the failover destination. Defaults to 300.",
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(30),
+		},
+	}
+	attributes["conditions"] = schema.ListAttribute{
+		Description: "Primary delivery failures that count as unavailable: connection_error, timeout, server_error, throttled, auth_error. Defaults to connection_error and timeout.",
+		Optional:    true,
+		ElementType: types.StringType,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(stringvalidator.OneOf(failoverConditions...)),
+		},
+	}
+	attributes["fail_back"] = schema.BoolAttribute{
+		Description: "Return to the primary destination once it is healthy again. Defaults to true.",
+		Optional:    true,
+	}
+
+	return schema.SingleNestedAttribute{
+		Description: "Secondary destination Sequin delivers to while the primary destination is unavailable. Takes the same attributes as destination plus the failover conditions. Credential drift is only detected for the primary destination.",
+		Optional:    true,
+		Attributes:  attributes,
+	}
+}
+
+// buildFailoverDestinationRequest converts the failover_destination object
+// into its API representation, or nil when it is unset
+func buildFailoverDestinationRequest(ctx context.Context, failover types.Object, diags *diag.Diagnostics) *client.SinkConsumerFailoverDestination {
+	if failover.IsNull() || failover.IsUnknown() {
+		return nil
+	}
+
+	result := &client.SinkConsumerFailoverDestination{
+		SinkConsumerDestination: buildDestinationRequest(failover),
+	}
+	attrs := failover.Attributes()
+	if seconds, ok := attrs["unavailable_for_seconds"].(types.Int64); ok && !seconds.IsNull() && !seconds.IsUnknown() {
+		val := seconds.ValueInt64()
+		result.UnavailableForSeconds = &val
+	}
+	if conditions, ok := attrs["conditions"].(types.List); ok && !conditions.IsNull() && !conditions.IsUnknown() {
+		diags.Append(conditions.ElementsAs(ctx, &result.Conditions, false)...)
+	}
+	if failBack, ok := attrs["fail_back"].(types.Bool); ok && !failBack.IsNull() && !failBack.IsUnknown() {
+		val := failBack.ValueBool()
+		result.FailBack = &val
+	}
+	return result
+}
+
+// mapFailoverDestinationToObject converts the API failover destination into
+// the failover_destination object. Server defaults are only kept when they
+// were configured.
+func mapFailoverDestinationToObject(ctx context.Context, apiFailover *client.SinkConsumerFailoverDestination, prior types.Object, diags *diag.Diagnostics) types.Object {
+	if apiFailover == nil {
+		return types.ObjectNull(failoverDestinationAttrTypes)
+	}
+
+	attrs := mapDestinationToObject(apiFailover.SinkConsumerDestination, prior, diags).Attributes()
+	attrs["unavailable_for_seconds"] = types.Int64Null()
+	attrs["conditions"] = types.ListNull(types.StringType)
+	attrs["fail_back"] = types.BoolNull()
+
+	if apiFailover.UnavailableForSeconds != nil &&
+		(*apiFailover.UnavailableForSeconds != failoverDefaultUnavailableSeconds || priorAttributeSet(prior, "unavailable_for_seconds")) {
+		attrs["unavailable_for_seconds"] = types.Int64Value(*apiFailover.UnavailableForSeconds)
+	}
+	if len(apiFailover.Conditions) > 0 &&
+		(!sameStringSet(apiFailover.Conditions, failoverDefaultConditions) || priorAttributeSet(prior, "conditions")) {
+		conditions, d := types.ListValueFrom(ctx, types.StringType, apiFailover.Conditions)
+		diags.Append(d...)
+		attrs["conditions"] = conditions
+	}
+	if apiFailover.FailBack != nil && (!*apiFailover.FailBack || priorAttributeSet(prior, "fail_back")) {
+		attrs["fail_back"] = types.BoolValue(*apiFailover.FailBack)
+	}
+
+	obj, d := types.ObjectValue(failoverDestinationAttrTypes, attrs)
+	diags.Append(d...)
+	return obj
+}
+
+// validateFailoverDestination runs the destination checks against the
+// failover destination
+func validateFailoverDestination(failover types.Object, diags *diag.Diagnostics) {
+	if failover.IsNull() || failover.IsUnknown() {
+		return
+	}
+	at := path.Root("failover_destination")
+	validateAWSCredentialsSource(failover, at, diags)
+	validateWebhookBatching(failover, at, diags)
+	validateKafkaPartitionKey(failover, at, diags)
+	validateDestinationAuth(failover, at, diags)
+	validateRedisOptions(failover, at, diags)
+	validateElasticsearchOptions(failover, at, diags)
+	validateDocumentIDColumns(failover, at, diags)
+}
+
+// priorAttributeSet reports whether the named attribute is set in the prior
+// object
+func priorAttributeSet(prior types.Object, name string) bool {
+	if prior.IsNull() || prior.IsUnknown() {
+		return false
+	}
+	value, ok := prior.Attributes()[name]
+	return ok && !value.IsNull()
+}
+
+// sameStringSet reports whether a and b hold the same values in any order
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,146 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// messageShapeAttrTypes describes the sink consumer message_shape object
+var messageShapeAttrTypes = map[string]attr.Type{
+	"include_columns":    types.ListType{ElemType: types.StringType},
+	"exclude_columns":    types.ListType{ElemType: types.StringType},
+	"include_old_values": types.BoolType,
+	"metadata_fields":    types.ListType{ElemType: types.StringType},
+}
+
+// messageMetadataFields lists the envelope metadata fields a message can carry
+var messageMetadataFields = []string{
+	"table_schema",
+	"table_name",
+	"commit_timestamp",
+	"commit_lsn",
+	"commit_idx",
+	"consumer",
+	"database_name",
+	"transaction_annotations",
+	"idempotency_key",
+	"record_pks",
+}
+
+// messageShapeSchemaAttribute returns the schema of the message_shape attribute
+func messageShapeSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Controls the shape of delivered messages without a transform. Removing it restores the full record, old values and metadata envelope.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"include_columns": schema.ListAttribute{
+				Description: "Only deliver these columns. Use column to match in every table or schema.table.column for one table.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("exclude_columns")),
+				},
+			},
+			"exclude_columns": schema.ListAttribute{
+				Description: "Deliver every column except these. Uses the same format as include_columns.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"include_old_values": schema.BoolAttribute{
+				Description: "Include the previous values of changed columns in update messages. Defaults to true.",
+				Optional:    true,
+			},
+			"metadata_fields": schema.ListAttribute{
+				Description: "Only include these fields in the metadata envelope: table_schema, table_name, commit_timestamp, commit_lsn, commit_idx, consumer, database_name, transaction_annotations, idempotency_key, record_pks. All fields are included when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(messageMetadataFields...)),
+				},
+			},
+		},
+	}
+}
+
+// buildMessageShapeRequest converts the message_shape object into its API
+// representation. An empty shape is sent when the attribute is unset so that
+// removing it resets the sink to the full message.
+func buildMessageShapeRequest(ctx context.Context, shape types.Object, diags *diag.Diagnostics) *client.SinkConsumerMessageShape {
+	result := &client.SinkConsumerMessageShape{}
+	if shape.IsNull() || shape.IsUnknown() {
+		return result
+	}
+
+	attrs := shape.Attributes()
+	for name, target := range map[string]*[]string{
+		"include_columns": &result.IncludeColumns,
+		"exclude_columns": &result.ExcludeColumns,
+		"metadata_fields": &result.MetadataFields,
+	} {
+		if list, ok := attrs[name].(types.List); ok && !list.IsNull() && !list.IsUnknown() {
+			diags.Append(list.ElementsAs(ctx, target, false)...)
+		}
+	}
+	if v, ok := attrs["include_old_values"].(types.Bool); ok && !v.IsNull() && !v.IsUnknown() {
+		val := v.ValueBool()
+		result.IncludeOldValues = &val
+	}
+
+	return result
+}
+
+// mapMessageShapeToObject converts the API message shape into the
+// message_shape object. Server defaults are only kept when they were
+// configured, so an unconfigured shape stays null.
+func mapMessageShapeToObject(ctx context.Context, apiShape *client.SinkConsumerMessageShape, prior types.Object, diags *diag.Diagnostics) types.Object {
+	attrs := map[string]attr.Value{
+		"include_columns":    types.ListNull(types.StringType),
+		"exclude_columns":    types.ListNull(types.StringType),
+		"include_old_values": types.BoolNull(),
+		"metadata_fields":    types.ListNull(types.StringType),
+	}
+
+	shapeSet := false
+	if apiShape != nil {
+		for name, values := range map[string][]string{
+			"include_columns": apiShape.IncludeColumns,
+			"exclude_columns": apiShape.ExcludeColumns,
+			"metadata_fields": apiShape.MetadataFields,
+		} {
+			if len(values) > 0 {
+				list, d := types.ListValueFrom(ctx, types.StringType, values)
+				diags.Append(d...)
+				attrs[name] = list
+				shapeSet = true
+			}
+		}
+		if apiShape.IncludeOldValues != nil && (!*apiShape.IncludeOldValues || priorAttributeSet(prior, "include_old_values")) {
+			attrs["include_old_values"] = types.BoolValue(*apiShape.IncludeOldValues)
+			shapeSet = true
+		}
+	}
+
+	if !shapeSet && (prior.IsNull() || prior.IsUnknown()) {
+		return types.ObjectNull(messageShapeAttrTypes)
+	}
+
+	obj, d := types.ObjectValue(messageShapeAttrTypes, attrs)
+	diags.Append(d...)
+	return obj
+}
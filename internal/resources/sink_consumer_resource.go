@@ -3,8 +3,12 @@ package resources
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -17,42 +21,80 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies expected interfaces
 var (
-	_ resource.Resource                = &SinkConsumerResource{}
-	_ resource.ResourceWithConfigure   = &SinkConsumerResource{}
-	_ resource.ResourceWithImportState = &SinkConsumerResource{}
+	_ resource.Resource                   = &SinkConsumerResource{}
+	_ resource.ResourceWithConfigure      = &SinkConsumerResource{}
+	_ resource.ResourceWithImportState    = &SinkConsumerResource{}
+	_ resource.ResourceWithIdentity       = &SinkConsumerResource{}
+	_ resource.ResourceWithModifyPlan     = &SinkConsumerResource{}
+	_ resource.ResourceWithValidateConfig = &SinkConsumerResource{}
 )
 
 // SinkConsumerResource defines the resource implementation
 type SinkConsumerResource struct {
-	client *client.Client
+	client client.SequinAPI
 }
 
 // SinkConsumerResourceModel describes the resource data model
 type SinkConsumerResourceModel struct {
-	ID                 types.String    `tfsdk:"id"`
-	Name               types.String    `tfsdk:"name"`
-	Status             types.String    `tfsdk:"status"`
-	Database           types.String    `tfsdk:"database"`
-	Source             types.Object    `tfsdk:"source"`
-	Tables             types.List      `tfsdk:"tables"`
-	Actions            types.List      `tfsdk:"actions"`
-	Destination        types.Object    `tfsdk:"destination"`
-	Filter             types.String    `tfsdk:"filter"`
-	Transform          types.String    `tfsdk:"transform"`
-	Enrichment         types.String    `tfsdk:"enrichment"`
-	Routing            types.String `tfsdk:"routing"`
-	MessageGrouping    types.Bool   `tfsdk:"message_grouping"`
-	BatchSize          types.Int64  `tfsdk:"batch_size"`
-	MaxRetryCount      types.Int64  `tfsdk:"max_retry_count"`
-	LoadSheddingPolicy types.String `tfsdk:"load_shedding_policy"`
-	TimestampFormat    types.String `tfsdk:"timestamp_format"`
-	StatusInfo         types.Object `tfsdk:"status_info"`
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Status                  types.String `tfsdk:"status"`
+	Database                types.String `tfsdk:"database"`
+	DatabaseID              types.String `tfsdk:"database_id"`
+	Source                  types.Object `tfsdk:"source"`
+	Tables                  types.List   `tfsdk:"tables"`
+	Actions                 types.List   `tfsdk:"actions"`
+	Destination             types.Object `tfsdk:"destination"`
+	FailoverDestination     types.Object `tfsdk:"failover_destination"`
+	Filter                  types.String `tfsdk:"filter"`
+	Transform               types.String `tfsdk:"transform"`
+	FunctionVersion         types.Int64  `tfsdk:"function_version"`
+	Enrichment              types.String `tfsdk:"enrichment"`
+	Routing                 types.String `tfsdk:"routing"`
+	MessageGrouping         types.Bool   `tfsdk:"message_grouping"`
+	BatchSize               types.Int64  `tfsdk:"batch_size"`
+	MaxRetryCount           types.Int64  `tfsdk:"max_retry_count"`
+	LoadSheddingPolicy      types.String `tfsdk:"load_shedding_policy"`
+	TimestampFormat         types.String `tfsdk:"timestamp_format"`
+	MessageShape            types.Object `tfsdk:"message_shape"`
+	BlueGreen               types.Object `tfsdk:"blue_green"`
+	CredentialVerifySeconds types.Int64  `tfsdk:"credential_verify_seconds"`
+	CursorResetLSN          types.String `tfsdk:"cursor_reset_lsn"`
+	Annotations             types.Map    `tfsdk:"annotations"`
+	AnnotationsAll          types.Map    `tfsdk:"annotations_all"`
+	StatusInfo              types.Object `tfsdk:"status_info"`
+	Timeouts                types.Object `tfsdk:"timeouts"`
+}
+
+// destinationSecretAttributes lists the sensitive destination attributes the
+// API never returns and which are tracked for credential drift detection
+var destinationSecretAttributes = []string{
+	"password",
+	"aws_access_key_id",
+	"aws_secret_access_key",
+	"access_key_id",
+	"secret_access_key",
+	"gcp_credentials",
+	"shared_access_key",
+	"creds",
+	"nkey_seed",
+	"jwt",
+	"api_key",
+}
+
+// statusInfoAttrTypes describes the sink consumer status_info object
+var statusInfoAttrTypes = map[string]attr.Type{
+	"state":      types.StringType,
+	"created_at": types.StringType,
+	"updated_at": types.StringType,
+	"last_error": types.StringType,
 }
 
 // NewSinkConsumerResource creates a new resource
@@ -109,19 +151,24 @@ func (r *SinkConsumerResource) Schema(ctx context.Context, req resource.SchemaRe
 				Required:    true,
 			},
 			"status": schema.StringAttribute{
-				Description: "Desired status of the sink consumer: active, disabled, paused.",
+				Description: "Desired status of the sink consumer: active, disabled, paused. When omitted, pausing or resuming the sink outside Terraform is not reported as drift.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 				Validators: []validator.String{
-					stringvalidator.OneOf("active", "disabled", "paused"),
+					stringvalidator.OneOf(client.SinkStatuses...),
 				},
 			},
 			"database": schema.StringAttribute{
-				Description: "ID of the database connection to stream from.",
-				Required:    true,
+				Description: "Name or ID of the database connection to stream from. Defaults to the provider's default_database.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database connection, resolved from database.",
+				Computed:    true,
 			},
 			"tables": schema.ListNestedAttribute{
 				Description: "List of tables to stream changes from.",
@@ -144,115 +191,44 @@ func (r *SinkConsumerResource) Schema(ctx context.Context, req resource.SchemaRe
 				Description: "List of change actions to capture: insert, update, delete.",
 				Optional:    true,
 				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(client.Actions...)),
+				},
 			},
 			"destination": schema.SingleNestedAttribute{
 				Description: "Destination configuration for where to send changes.",
 				Required:    true,
-				Attributes: map[string]schema.Attribute{
-					"type": schema.StringAttribute{
-						Description: "Destination type: kafka, sqs, kinesis, webhook.",
-						Required:    true,
-						Validators: []validator.String{
-							stringvalidator.OneOf("kafka", "sqs", "kinesis", "webhook"),
-						},
-					},
-					// Kafka fields
-					"hosts": schema.StringAttribute{
-						Description: "Kafka broker hosts (comma-separated).",
-						Optional:    true,
-					},
-					"topic": schema.StringAttribute{
-						Description: "Kafka topic name.",
-						Optional:    true,
-					},
-					"tls": schema.BoolAttribute{
-						Description: "Enable TLS for Kafka connection.",
-						Optional:    true,
-					},
-					"username": schema.StringAttribute{
-						Description: "Username for Kafka authentication.",
-						Optional:    true,
-					},
-					"password": schema.StringAttribute{
-						Description: "Password for Kafka authentication.",
-						Optional:    true,
-						Sensitive:   true,
-					},
-					"sasl_mechanism": schema.StringAttribute{
-						Description: "SASL mechanism: PLAIN, SCRAM-SHA-256, SCRAM-SHA-512, AWS_MSK_IAM.",
-						Optional:    true,
-					},
-					"aws_region": schema.StringAttribute{
-						Description: "AWS region for MSK IAM authentication.",
-						Optional:    true,
-					},
-					"aws_access_key_id": schema.StringAttribute{
-						Description: "AWS access key ID for MSK IAM authentication.",
-						Optional:    true,
-						Sensitive:   true,
-					},
-					"aws_secret_access_key": schema.StringAttribute{
-						Description: "AWS secret access key for MSK IAM authentication.",
-						Optional:    true,
-						Sensitive:   true,
-					},
-					// SQS fields
-					"queue_url": schema.StringAttribute{
-						Description: "SQS queue URL.",
-						Optional:    true,
-					},
-					"region": schema.StringAttribute{
-						Description: "AWS region for SQS/Kinesis.",
-						Optional:    true,
-					},
-					"access_key_id": schema.StringAttribute{
-						Description: "AWS access key ID.",
-						Optional:    true,
-						Sensitive:   true,
-					},
-					"secret_access_key": schema.StringAttribute{
-						Description: "AWS secret access key.",
-						Optional:    true,
-						Sensitive:   true,
-					},
-					"is_fifo": schema.BoolAttribute{
-						Description: "Whether the SQS queue is FIFO.",
-						Optional:    true,
-					},
-					// Kinesis fields
-					"stream_arn": schema.StringAttribute{
-						Description: "Kinesis stream ARN.",
-						Optional:    true,
-					},
-					// Webhook fields
-					"http_endpoint": schema.StringAttribute{
-						Description: "Webhook HTTP endpoint base URL.",
-						Optional:    true,
-					},
-					"http_endpoint_path": schema.StringAttribute{
-						Description: "Webhook HTTP endpoint path.",
-						Optional:    true,
-					},
-					"batch": schema.BoolAttribute{
-						Description: "Enable batched delivery for webhooks.",
-						Optional:    true,
-					},
-				},
+				Attributes:  destinationSchemaAttributes(),
 			},
 			"filter": schema.StringAttribute{
-				Description: "Named filter function to control which rows trigger changes.",
+				Description: "Name or ID of the filter function to control which rows trigger changes.",
 				Optional:    true,
 			},
 			"transform": schema.StringAttribute{
-				Description: "Named transform function to reshape messages before delivery.",
+				Description: "Name or ID of the transform function to reshape messages before delivery.",
 				Optional:    true,
 			},
+			"function_version": schema.Int64Attribute{
+				Description: "Version of the transform function to pin the sink to. When omitted, the sink follows the function's active version.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("transform")),
+				},
+			},
 			"enrichment": schema.StringAttribute{
-				Description: "Named enrichment function that runs a SQL query to add data to messages.",
+				Description: "Name or ID of the enrichment function that runs a SQL query to add data to messages.",
 				Optional:    true,
 			},
+			"cursor_reset_lsn": schema.StringAttribute{
+				Description: "Replication LSN to move the sink's delivery cursor to, e.g. 0/16B3748. The cursor is moved when this is set on create or changed; removing it leaves the cursor where it is. Use the sequin_sink_consumer_cursor data source to read the current position.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(lsnPattern, "must be a Postgres LSN such as 0/16B3748"),
+				},
+			},
 			"routing": schema.StringAttribute{
-				Description: "Named routing function to dynamically direct messages to destinations.",
+				Description: "Name or ID of the routing function to dynamically direct messages to destinations.",
 				Optional:    true,
 			},
 			"message_grouping": schema.BoolAttribute{
@@ -297,6 +273,17 @@ func (r *SinkConsumerResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringvalidator.OneOf("iso8601", "unix_microsecond"),
 				},
 			},
+			"message_shape":        messageShapeSchemaAttribute(),
+			"failover_destination": failoverDestinationSchemaAttribute(),
+			"blue_green":           blueGreenSchemaAttribute(),
+			"credential_verify_seconds": schema.Int64Attribute{
+				Description: "After an apply that only changes destination credentials, watch the sink for this many seconds and restore the previous credentials if delivery starts failing. " +
+					"The sink keeps running during the rotation. Defaults to 30; 0 turns verification off.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"status_info": schema.SingleNestedAttribute{
 				Description: "Current operational status of the sink consumer.",
 				Computed:    true,
@@ -322,21 +309,30 @@ func (r *SinkConsumerResource) Schema(ctx context.Context, req resource.SchemaRe
 					},
 				},
 			},
+			"annotations":     annotationsAttribute(),
+			"annotations_all": annotationsAllAttribute(),
+			"timeouts":        timeoutsAttribute(),
 		},
 	}
 }
 
+// IdentitySchema defines the resource identity used by import blocks and
+// config generation
+func (r *SinkConsumerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Unique identifier for the sink consumer.")
+}
+
 // Configure adds the provider-configured client to the resource
 func (r *SinkConsumerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.SequinAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -346,6 +342,8 @@ func (r *SinkConsumerResource) Configure(ctx context.Context, req resource.Confi
 
 // Create creates a new sink consumer resource
 func (r *SinkConsumerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_sink_consumer", "create")
+
 	var data SinkConsumerResourceModel
 
 	// Read Terraform plan data into the model
@@ -354,174 +352,30 @@ func (r *SinkConsumerResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	// Build API request
-	createReq := &client.SinkConsumerRequest{
-		Name:     data.Name.ValueString(),
-		Database: data.Database.ValueString(),
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts, operationCreate)
+	defer cancel()
+
+	databaseID, err := r.databaseIDFor(ctx, data.Database, data.DatabaseID)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("database"), "Error Resolving Database", err.Error())
+		return
 	}
+	data.DatabaseID = types.StringValue(databaseID)
+
+	// Build API request
+	createReq := buildSinkConsumerRequest(ctx, &data, &resp.Diagnostics)
+	createReq.Database = databaseID
+	createReq.Annotations = mergeAnnotations(defaultAnnotations(r.client), data.Annotations)
 
 	// Optional fields
 	if !data.Status.IsNull() {
 		createReq.Status = data.Status.ValueString()
 	}
 
-	// Parse source
-	if !data.Source.IsNull() {
-		source := &client.SinkConsumerSource{}
-		sourceAttrs := data.Source.Attributes()
-
-		if includeSchemas, ok := sourceAttrs["include_schemas"].(types.List); ok && !includeSchemas.IsNull() {
-			var schemas []string
-			resp.Diagnostics.Append(includeSchemas.ElementsAs(ctx, &schemas, false)...)
-			source.IncludeSchemas = schemas
-		}
-		if excludeSchemas, ok := sourceAttrs["exclude_schemas"].(types.List); ok && !excludeSchemas.IsNull() {
-			var schemas []string
-			resp.Diagnostics.Append(excludeSchemas.ElementsAs(ctx, &schemas, false)...)
-			source.ExcludeSchemas = schemas
-		}
-		if includeTables, ok := sourceAttrs["include_tables"].(types.List); ok && !includeTables.IsNull() {
-			var tables []string
-			resp.Diagnostics.Append(includeTables.ElementsAs(ctx, &tables, false)...)
-			source.IncludeTables = tables
-		}
-		if excludeTables, ok := sourceAttrs["exclude_tables"].(types.List); ok && !excludeTables.IsNull() {
-			var tables []string
-			resp.Diagnostics.Append(excludeTables.ElementsAs(ctx, &tables, false)...)
-			source.ExcludeTables = tables
-		}
-
-		createReq.Source = source
-	}
-
-	// Parse tables
-	var tablesData []struct {
-		Name              types.String `tfsdk:"name"`
-		GroupColumnNames  types.List   `tfsdk:"group_column_names"`
-	}
-	resp.Diagnostics.Append(data.Tables.ElementsAs(ctx, &tablesData, false)...)
-
-	createReq.Tables = make([]client.SinkConsumerTable, len(tablesData))
-	for i, table := range tablesData {
-		createReq.Tables[i].Name = table.Name.ValueString()
-		if !table.GroupColumnNames.IsNull() {
-			var groupCols []string
-			resp.Diagnostics.Append(table.GroupColumnNames.ElementsAs(ctx, &groupCols, false)...)
-			createReq.Tables[i].GroupColumnNames = groupCols
-		}
-	}
-
-	// Parse actions
-	if !data.Actions.IsNull() {
-		var actions []string
-		resp.Diagnostics.Append(data.Actions.ElementsAs(ctx, &actions, false)...)
-		createReq.Actions = actions
-	}
-
-	// Parse destination
-	destAttrs := data.Destination.Attributes()
-	createReq.Destination = client.SinkConsumerDestination{
-		Type: destAttrs["type"].(types.String).ValueString(),
-	}
-
-	// Kafka fields
-	if hosts, ok := destAttrs["hosts"].(types.String); ok && !hosts.IsNull() {
-		createReq.Destination.Hosts = hosts.ValueString()
-	}
-	if topic, ok := destAttrs["topic"].(types.String); ok && !topic.IsNull() {
-		createReq.Destination.Topic = topic.ValueString()
-	}
-	if tls, ok := destAttrs["tls"].(types.Bool); ok && !tls.IsNull() {
-		val := tls.ValueBool()
-		createReq.Destination.TLS = &val
-	}
-	if username, ok := destAttrs["username"].(types.String); ok && !username.IsNull() {
-		createReq.Destination.Username = username.ValueString()
-	}
-	if password, ok := destAttrs["password"].(types.String); ok && !password.IsNull() {
-		createReq.Destination.Password = password.ValueString()
-	}
-	if saslMech, ok := destAttrs["sasl_mechanism"].(types.String); ok && !saslMech.IsNull() {
-		createReq.Destination.SASLMechanism = saslMech.ValueString()
-	}
-	if awsRegion, ok := destAttrs["aws_region"].(types.String); ok && !awsRegion.IsNull() {
-		createReq.Destination.AWSRegion = awsRegion.ValueString()
-	}
-	if awsAccessKey, ok := destAttrs["aws_access_key_id"].(types.String); ok && !awsAccessKey.IsNull() {
-		createReq.Destination.AWSAccessKeyID = awsAccessKey.ValueString()
-	}
-	if awsSecretKey, ok := destAttrs["aws_secret_access_key"].(types.String); ok && !awsSecretKey.IsNull() {
-		createReq.Destination.AWSSecretAccessKey = awsSecretKey.ValueString()
-	}
-
-	// SQS fields
-	if queueURL, ok := destAttrs["queue_url"].(types.String); ok && !queueURL.IsNull() {
-		createReq.Destination.QueueURL = queueURL.ValueString()
-	}
-	if region, ok := destAttrs["region"].(types.String); ok && !region.IsNull() {
-		createReq.Destination.Region = region.ValueString()
-	}
-	if accessKey, ok := destAttrs["access_key_id"].(types.String); ok && !accessKey.IsNull() {
-		createReq.Destination.AccessKeyID = accessKey.ValueString()
-	}
-	if secretKey, ok := destAttrs["secret_access_key"].(types.String); ok && !secretKey.IsNull() {
-		createReq.Destination.SecretAccessKey = secretKey.ValueString()
-	}
-	if isFIFO, ok := destAttrs["is_fifo"].(types.Bool); ok && !isFIFO.IsNull() {
-		val := isFIFO.ValueBool()
-		createReq.Destination.IsFIFO = &val
-	}
-
-	// Kinesis fields
-	if streamARN, ok := destAttrs["stream_arn"].(types.String); ok && !streamARN.IsNull() {
-		createReq.Destination.StreamARN = streamARN.ValueString()
-	}
-
-	// Webhook fields
-	if httpEndpoint, ok := destAttrs["http_endpoint"].(types.String); ok && !httpEndpoint.IsNull() {
-		createReq.Destination.HTTPEndpoint = httpEndpoint.ValueString()
-	}
-	if httpEndpointPath, ok := destAttrs["http_endpoint_path"].(types.String); ok && !httpEndpointPath.IsNull() {
-		createReq.Destination.HTTPEndpointPath = httpEndpointPath.ValueString()
-	}
-	if batch, ok := destAttrs["batch"].(types.Bool); ok && !batch.IsNull() {
-		val := batch.ValueBool()
-		createReq.Destination.Batch = &val
-	}
-
-	// Optional string fields
-	if !data.Filter.IsNull() {
-		createReq.Filter = data.Filter.ValueString()
-	}
-	if !data.Transform.IsNull() {
-		createReq.Transform = data.Transform.ValueString()
-	}
-	if !data.Enrichment.IsNull() {
-		createReq.Enrichment = data.Enrichment.ValueString()
-	}
-	if !data.Routing.IsNull() {
-		createReq.Routing = data.Routing.ValueString()
-	}
-	if !data.LoadSheddingPolicy.IsNull() {
-		createReq.LoadSheddingPolicy = data.LoadSheddingPolicy.ValueString()
-	}
-	if !data.TimestampFormat.IsNull() {
-		createReq.TimestampFormat = data.TimestampFormat.ValueString()
-	}
-
-	// Optional bool/int fields
-	if !data.MessageGrouping.IsNull() {
-		val := data.MessageGrouping.ValueBool()
-		createReq.MessageGrouping = &val
-	}
-	if !data.BatchSize.IsNull() {
-		val := int(data.BatchSize.ValueInt64())
-		createReq.BatchSize = &val
-	}
-	if !data.MaxRetryCount.IsNull() {
-		val := int(data.MaxRetryCount.ValueInt64())
-		createReq.MaxRetryCount = &val
-	}
+	configuredFunctions := snapshotFunctionRefs(&data)
+	functionRefs := r.resolveFunctionRefs(ctx, &data, createReq, &resp.Diagnostics)
+	configuredEndpoints := snapshotEndpointRefs(&data)
+	endpointRefs := r.resolveEndpointRefs(ctx, createReq, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -536,15 +390,26 @@ func (r *SinkConsumerResource) Create(ctx context.Context, req resource.CreateRe
 	// Call API
 	created, err := r.client.CreateSinkConsumer(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Sink Consumer",
-			"Could not create sink consumer: "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error Creating Sink Consumer", "Could not create sink consumer", err)
 		return
 	}
 
 	// Map response to model (this will overwrite destination)
 	r.mapResponseToModel(ctx, created, &data, &resp.Diagnostics)
+	restoreFunctionRefs(&data, configuredFunctions, functionRefs)
+	restoreEndpointRefs(ctx, &data, configuredEndpoints, endpointRefs, &resp.Diagnostics)
+
+	// Track server-side metadata outside the public schema
+	privateState := &resourcePrivateState{
+		ETag:               created.ETag,
+		UpdatedAt:          created.StatusInfo.UpdatedAt,
+		IgnoreRemoteStatus: statusUnmanaged(ctx, req.Config, &resp.Diagnostics),
+		FunctionRefs:       functionRefs,
+		EndpointRefs:       endpointRefs,
+	}
+	privateState.markCreated()
+	privateState.recordSecrets(destinationSecrets(data.Destination), destinationFingerprint(created.Destination))
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
 
 	// Restore destination from plan to preserve sensitive values
 	data.Destination = originalDestination
@@ -565,14 +430,27 @@ func (r *SinkConsumerResource) Create(ctx context.Context, req resource.CreateRe
 		data.Enrichment = types.StringNull()
 	}
 
+	// A failed reset fails the create, which rolls back the new sink
+	data.CursorResetLSN = r.applyCursorReset(ctx, created.ID, data.CursorResetLSN, types.StringNull(), &resp.Diagnostics)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID)...)
+
+	rollbackCreate(ctx, r.client.CreateRollbackSkipped(), "sink consumer", created.ID, &resp.Diagnostics, &resp.State, func(ctx context.Context) error {
+		return r.client.DeleteSinkConsumer(ctx, created.ID)
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Info(ctx, "Created sink consumer resource", map[string]any{"id": data.ID.ValueString()})
 }
 
 // Read refreshes the Terraform state with the latest data from the API
 func (r *SinkConsumerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = traceOperation(ctx, "sequin_sink_consumer", "read")
+
 	var data SinkConsumerResourceModel
 
 	// Read Terraform current state into the model
@@ -581,9 +459,17 @@ func (r *SinkConsumerResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts, operationRead)
+	defer cancel()
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
 	// Get current state from API
 	consumerID := data.ID.ValueString()
-	consumer, err := r.client.GetSinkConsumer(ctx, consumerID)
+	consumer, err := getWithCreateGrace(ctx, privateState, consumerID, func() (*client.SinkConsumerResponse, error) {
+		return r.client.GetSinkConsumer(ctx, consumerID)
+	})
 	if err != nil {
 		if client.IsNotFoundError(err) {
 			// Resource was deleted outside Terraform
@@ -599,14 +485,52 @@ func (r *SinkConsumerResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Update model with latest values from API (drift detection)
+	priorStatus := data.Status
+	configuredFunctions := snapshotFunctionRefs(&data)
+	configuredEndpoints := snapshotEndpointRefs(&data)
 	r.mapResponseToModel(ctx, consumer, &data, &resp.Diagnostics)
+	restoreFunctionRefs(&data, configuredFunctions, privateState.FunctionRefs)
+	restoreEndpointRefs(ctx, &data, configuredEndpoints, privateState.EndpointRefs, &resp.Diagnostics)
+
+	privateState.recordVersion(consumer)
+	privateState.UpdatedAt = consumer.StatusInfo.UpdatedAt
+
+	// Imported or pre-existing state may not have the resolved ID yet
+	if data.DatabaseID.IsNull() || data.DatabaseID.IsUnknown() {
+		if databaseID, err := resolveDatabaseID(ctx, r.client, data.Database.ValueString()); err == nil {
+			data.DatabaseID = types.StringValue(databaseID)
+		} else {
+			tflog.Warn(ctx, "Could not resolve sink consumer database ID", map[string]any{"id": consumerID, "error": err.Error()})
+		}
+	}
+
+	// Status not managed by configuration: on-call pausing/resuming is not drift
+	data.Status = preserveUnmanagedStatus(priorStatus, data.Status, privateState.IgnoreRemoteStatus)
+
+	// Destination secrets are never returned by the API; rely on the server
+	// fingerprint to detect out-of-band rotation and force a re-apply.
+	if privateState.secretsDrifted(destinationSecrets(data.Destination), destinationFingerprint(consumer.Destination)) {
+		resp.Diagnostics.AddWarning(
+			"Sink Consumer Credential Drift Detected",
+			"The destination credentials for sink consumer ID "+consumerID+" were changed outside of Terraform. "+
+				"The configured credentials will be re-applied on the next apply.",
+		)
+		destination, d := nullObjectAttributes(ctx, data.Destination, destinationSecretAttributes...)
+		resp.Diagnostics.Append(d...)
+		data.Destination = destination
+	}
+
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
 
 	// Save updated state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 // Update updates an existing sink consumer resource
 func (r *SinkConsumerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = traceOperation(ctx, "sequin_sink_consumer", "update")
+
 	var plan, state SinkConsumerResourceModel
 
 	// Read Terraform plan and current state
@@ -616,201 +540,114 @@ func (r *SinkConsumerResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	// Build update request (same structure as create)
-	updateReq := &client.SinkConsumerRequest{
-		Name:     plan.Name.ValueString(),
-		Database: plan.Database.ValueString(),
-	}
-
-	// Copy all the same logic from Create for building the request
-	if !plan.Status.IsNull() {
-		updateReq.Status = plan.Status.ValueString()
-	}
-
-	// Parse source
-	if !plan.Source.IsNull() {
-		source := &client.SinkConsumerSource{}
-		sourceAttrs := plan.Source.Attributes()
-
-		if includeSchemas, ok := sourceAttrs["include_schemas"].(types.List); ok && !includeSchemas.IsNull() {
-			var schemas []string
-			resp.Diagnostics.Append(includeSchemas.ElementsAs(ctx, &schemas, false)...)
-			source.IncludeSchemas = schemas
-		}
-		if excludeSchemas, ok := sourceAttrs["exclude_schemas"].(types.List); ok && !excludeSchemas.IsNull() {
-			var schemas []string
-			resp.Diagnostics.Append(excludeSchemas.ElementsAs(ctx, &schemas, false)...)
-			source.ExcludeSchemas = schemas
-		}
-		if includeTables, ok := sourceAttrs["include_tables"].(types.List); ok && !includeTables.IsNull() {
-			var tables []string
-			resp.Diagnostics.Append(includeTables.ElementsAs(ctx, &tables, false)...)
-			source.IncludeTables = tables
-		}
-		if excludeTables, ok := sourceAttrs["exclude_tables"].(types.List); ok && !excludeTables.IsNull() {
-			var tables []string
-			resp.Diagnostics.Append(excludeTables.ElementsAs(ctx, &tables, false)...)
-			source.ExcludeTables = tables
-		}
-
-		updateReq.Source = source
-	}
-
-	// Parse tables
-	var tablesData []struct {
-		Name              types.String `tfsdk:"name"`
-		GroupColumnNames  types.List   `tfsdk:"group_column_names"`
-	}
-	resp.Diagnostics.Append(plan.Tables.ElementsAs(ctx, &tablesData, false)...)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts, operationUpdate)
+	defer cancel()
 
-	updateReq.Tables = make([]client.SinkConsumerTable, len(tablesData))
-	for i, table := range tablesData {
-		updateReq.Tables[i].Name = table.Name.ValueString()
-		if !table.GroupColumnNames.IsNull() {
-			var groupCols []string
-			resp.Diagnostics.Append(table.GroupColumnNames.ElementsAs(ctx, &groupCols, false)...)
-			updateReq.Tables[i].GroupColumnNames = groupCols
-		}
-	}
-
-	// Parse actions
-	if !plan.Actions.IsNull() {
-		var actions []string
-		resp.Diagnostics.Append(plan.Actions.ElementsAs(ctx, &actions, false)...)
-		updateReq.Actions = actions
-	}
-
-	// Parse destination
-	destAttrs := plan.Destination.Attributes()
-	updateReq.Destination = client.SinkConsumerDestination{
-		Type: destAttrs["type"].(types.String).ValueString(),
+	databaseID, err := r.databaseIDFor(ctx, plan.Database, plan.DatabaseID)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("database"), "Error Resolving Database", err.Error())
+		return
 	}
+	plan.DatabaseID = types.StringValue(databaseID)
 
-	// Kafka fields
-	if hosts, ok := destAttrs["hosts"].(types.String); ok && !hosts.IsNull() {
-		updateReq.Destination.Hosts = hosts.ValueString()
-	}
-	if topic, ok := destAttrs["topic"].(types.String); ok && !topic.IsNull() {
-		updateReq.Destination.Topic = topic.ValueString()
-	}
-	if tls, ok := destAttrs["tls"].(types.Bool); ok && !tls.IsNull() {
-		val := tls.ValueBool()
-		updateReq.Destination.TLS = &val
-	}
-	if username, ok := destAttrs["username"].(types.String); ok && !username.IsNull() {
-		updateReq.Destination.Username = username.ValueString()
-	}
-	if password, ok := destAttrs["password"].(types.String); ok && !password.IsNull() {
-		updateReq.Destination.Password = password.ValueString()
-	}
-	if saslMech, ok := destAttrs["sasl_mechanism"].(types.String); ok && !saslMech.IsNull() {
-		updateReq.Destination.SASLMechanism = saslMech.ValueString()
-	}
-	if awsRegion, ok := destAttrs["aws_region"].(types.String); ok && !awsRegion.IsNull() {
-		updateReq.Destination.AWSRegion = awsRegion.ValueString()
-	}
-	if awsAccessKey, ok := destAttrs["aws_access_key_id"].(types.String); ok && !awsAccessKey.IsNull() {
-		updateReq.Destination.AWSAccessKeyID = awsAccessKey.ValueString()
-	}
-	if awsSecretKey, ok := destAttrs["aws_secret_access_key"].(types.String); ok && !awsSecretKey.IsNull() {
-		updateReq.Destination.AWSSecretAccessKey = awsSecretKey.ValueString()
+	// Build update request (same structure as create)
+	updateReq := buildSinkConsumerRequest(ctx, &plan, &resp.Diagnostics)
+	updateReq.Database = databaseID
+	updateReq.Annotations = mergeAnnotations(defaultAnnotations(r.client), plan.Annotations)
+
+	// Status is only sent when configured; otherwise the planned value is a
+	// copy of state and would undo a pause/resume made outside Terraform.
+	ignoreRemoteStatus := statusUnmanaged(ctx, req.Config, &resp.Diagnostics)
+	if !plan.Status.IsNull() && !ignoreRemoteStatus {
+		updateReq.Status = plan.Status.ValueString()
 	}
 
-	// SQS fields
-	if queueURL, ok := destAttrs["queue_url"].(types.String); ok && !queueURL.IsNull() {
-		updateReq.Destination.QueueURL = queueURL.ValueString()
-	}
-	if region, ok := destAttrs["region"].(types.String); ok && !region.IsNull() {
-		updateReq.Destination.Region = region.ValueString()
-	}
-	if accessKey, ok := destAttrs["access_key_id"].(types.String); ok && !accessKey.IsNull() {
-		updateReq.Destination.AccessKeyID = accessKey.ValueString()
-	}
-	if secretKey, ok := destAttrs["secret_access_key"].(types.String); ok && !secretKey.IsNull() {
-		updateReq.Destination.SecretAccessKey = secretKey.ValueString()
-	}
-	if isFIFO, ok := destAttrs["is_fifo"].(types.Bool); ok && !isFIFO.IsNull() {
-		val := isFIFO.ValueBool()
-		updateReq.Destination.IsFIFO = &val
-	}
+	configuredFunctions := snapshotFunctionRefs(&plan)
+	functionRefs := r.resolveFunctionRefs(ctx, &plan, updateReq, &resp.Diagnostics)
+	configuredEndpoints := snapshotEndpointRefs(&plan)
+	endpointRefs := r.resolveEndpointRefs(ctx, updateReq, &resp.Diagnostics)
 
-	// Kinesis fields
-	if streamARN, ok := destAttrs["stream_arn"].(types.String); ok && !streamARN.IsNull() {
-		updateReq.Destination.StreamARN = streamARN.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Webhook fields
-	if httpEndpoint, ok := destAttrs["http_endpoint"].(types.String); ok && !httpEndpoint.IsNull() {
-		updateReq.Destination.HTTPEndpoint = httpEndpoint.ValueString()
-	}
-	if httpEndpointPath, ok := destAttrs["http_endpoint_path"].(types.String); ok && !httpEndpointPath.IsNull() {
-		updateReq.Destination.HTTPEndpointPath = httpEndpointPath.ValueString()
-	}
-	if batch, ok := destAttrs["batch"].(types.Bool); ok && !batch.IsNull() {
-		val := batch.ValueBool()
-		updateReq.Destination.Batch = &val
-	}
+	// Call API
+	consumerID := state.ID.ValueString()
+	swapped := blueGreenSwapRequired(&state, &plan)
 
-	// Optional string fields
-	if !plan.Filter.IsNull() {
-		updateReq.Filter = plan.Filter.ValueString()
-	}
-	if !plan.Transform.IsNull() {
-		updateReq.Transform = plan.Transform.ValueString()
-	}
-	if !plan.Enrichment.IsNull() {
-		updateReq.Enrichment = plan.Enrichment.ValueString()
-	}
-	if !plan.Routing.IsNull() {
-		updateReq.Routing = plan.Routing.ValueString()
-	}
-	if !plan.LoadSheddingPolicy.IsNull() {
-		updateReq.LoadSheddingPolicy = plan.LoadSheddingPolicy.ValueString()
-	}
-	if !plan.TimestampFormat.IsNull() {
-		updateReq.TimestampFormat = plan.TimestampFormat.ValueString()
+	// Credential-only changes are verified after the update and rolled back if
+	// delivery starts failing
+	verifyWindow := credentialVerifyWindow(plan.CredentialVerifySeconds)
+	rotating := !swapped && verifyWindow > 0 && credentialRotationOnly(ctx, &state, &plan)
+	var failingBefore *int64
+	if rotating {
+		failingBefore = r.failingMessageCount(ctx, consumerID)
 	}
 
-	// Optional bool/int fields
-	if !plan.MessageGrouping.IsNull() {
-		val := plan.MessageGrouping.ValueBool()
-		updateReq.MessageGrouping = &val
-	}
-	if !plan.BatchSize.IsNull() {
-		val := int(plan.BatchSize.ValueInt64())
-		updateReq.BatchSize = &val
-	}
-	if !plan.MaxRetryCount.IsNull() {
-		val := int(plan.MaxRetryCount.ValueInt64())
-		updateReq.MaxRetryCount = &val
-	}
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
 
-	if resp.Diagnostics.HasError() {
-		return
+	var updated *client.SinkConsumerResponse
+	if swapped {
+		updated = r.replaceBlueGreen(ctx, consumerID, &plan, updateReq, &resp.Diagnostics)
+		if updated == nil {
+			return
+		}
+		consumerID = updated.ID
+	} else {
+		updated, err = updateWithConflictRetry(ctx, consumerID, privateState,
+			func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+				return r.client.GetSinkConsumer(ctx, consumerID)
+			},
+			func(ctx context.Context) (*client.SinkConsumerResponse, error) {
+				return r.client.UpdateSinkConsumer(ctx, consumerID, updateReq)
+			},
+		)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error Updating Sink Consumer", "Could not update sink consumer ID "+consumerID, err)
+			return
+		}
 	}
 
-	// Call API
-	consumerID := state.ID.ValueString()
-	updated, err := r.client.UpdateSinkConsumer(ctx, consumerID, updateReq)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Sink Consumer",
-			"Could not update sink consumer ID "+consumerID+": "+err.Error(),
-		)
-		return
+	if rotating {
+		if err := r.verifyCredentialRotation(ctx, consumerID, verifyWindow, failingBefore); err != nil {
+			if r.rollbackCredentialRotation(ctx, req, resp, &state, updateReq, err) {
+				return
+			}
+		}
 	}
 
 	// Update model with response
+	plannedStatus := plan.Status
 	r.mapResponseToModel(ctx, updated, &plan, &resp.Diagnostics)
+	restoreFunctionRefs(&plan, configuredFunctions, functionRefs)
+	restoreEndpointRefs(ctx, &plan, configuredEndpoints, endpointRefs, &resp.Diagnostics)
+	plan.Status = preserveUnmanagedStatus(plannedStatus, plan.Status, ignoreRemoteStatus)
+	plan.CursorResetLSN = r.applyCursorReset(ctx, consumerID, plan.CursorResetLSN, state.CursorResetLSN, &resp.Diagnostics)
+
+	privateState.IgnoreRemoteStatus = ignoreRemoteStatus
+	privateState.FunctionRefs = functionRefs
+	privateState.EndpointRefs = endpointRefs
+	if swapped {
+		// The replacement is new; give Read the same grace as after a create
+		privateState.markCreated()
+		privateState.recordVersion(updated)
+	}
+	privateState.UpdatedAt = updated.StatusInfo.UpdatedAt
+	privateState.recordSecrets(destinationSecrets(plan.Destination), destinationFingerprint(updated.Destination))
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
 
 	// Save updated state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, plan.ID)...)
 
 	tflog.Info(ctx, "Updated sink consumer resource", map[string]any{"id": consumerID})
 }
 
 // Delete deletes a sink consumer resource
 func (r *SinkConsumerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = traceOperation(ctx, "sequin_sink_consumer", "delete")
+
 	var data SinkConsumerResourceModel
 
 	// Read Terraform current state
@@ -819,6 +656,9 @@ func (r *SinkConsumerResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts, operationDelete)
+	defer cancel()
+
 	// Call API to delete
 	consumerID := data.ID.ValueString()
 	err := r.client.DeleteSinkConsumer(ctx, consumerID)
@@ -837,224 +677,390 @@ func (r *SinkConsumerResource) Delete(ctx context.Context, req resource.DeleteRe
 // ImportState imports an existing sink consumer resource by ID
 func (r *SinkConsumerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import by ID: terraform import sequin_sink_consumer.example <consumer-id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// or an import block with id or identity = { id = ... }, which also
+	// supports terraform plan -generate-config-out
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
-// mapResponseToModel maps API response to Terraform model
-func (r *SinkConsumerResource) mapResponseToModel(ctx context.Context, response *client.SinkConsumerResponse, model *SinkConsumerResourceModel, diags *diag.Diagnostics) {
-	model.ID = types.StringValue(response.ID)
-	model.Name = types.StringValue(response.Name)
-	model.Status = types.StringValue(response.Status)
-	model.Database = types.StringValue(response.Database)
-
-	// Map source — treat empty source (no filters) as null to avoid drift
-	sourceAttrTypes := map[string]attr.Type{
-		"include_schemas": types.ListType{ElemType: types.StringType},
-		"exclude_schemas": types.ListType{ElemType: types.StringType},
-		"include_tables":  types.ListType{ElemType: types.StringType},
-		"exclude_tables":  types.ListType{ElemType: types.StringType},
-	}
-	sourceHasData := response.Source != nil &&
-		(len(response.Source.IncludeSchemas) > 0 ||
-			len(response.Source.ExcludeSchemas) > 0 ||
-			len(response.Source.IncludeTables) > 0 ||
-			len(response.Source.ExcludeTables) > 0)
-
-	if sourceHasData {
-		sourceAttrs := map[string]attr.Value{
-			"include_schemas": types.ListNull(types.StringType),
-			"exclude_schemas": types.ListNull(types.StringType),
-			"include_tables":  types.ListNull(types.StringType),
-			"exclude_tables":  types.ListNull(types.StringType),
-		}
+// ValidateConfig checks destination settings that cannot be expressed with
+// attribute validators
+func (r *SinkConsumerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var destination types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("destination"), &destination)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateAWSCredentialsSource(destination, path.Root("destination"), &resp.Diagnostics)
+	validateWebhookBatching(destination, path.Root("destination"), &resp.Diagnostics)
+	validateKafkaPartitionKey(destination, path.Root("destination"), &resp.Diagnostics)
+	validateDestinationAuth(destination, path.Root("destination"), &resp.Diagnostics)
+	validateRedisOptions(destination, path.Root("destination"), &resp.Diagnostics)
+	validateElasticsearchOptions(destination, path.Root("destination"), &resp.Diagnostics)
+	validateDocumentIDColumns(destination, path.Root("destination"), &resp.Diagnostics)
+
+	var failover types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("failover_destination"), &failover)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateFailoverDestination(failover, &resp.Diagnostics)
+}
+
+// ModifyPlan validates the plan against the API and plans blue/green
+// replacements. No destination attribute requires replacement: credential and
+// tuning changes are always updated in place, and so are changes of the target
+// system unless blue_green is set.
+func (r *SinkConsumerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
-		if len(response.Source.IncludeSchemas) > 0 {
-			list, d := types.ListValueFrom(ctx, types.StringType, response.Source.IncludeSchemas)
-			diags.Append(d...)
-			sourceAttrs["include_schemas"] = list
+	r.planDefaultDatabase(ctx, req, resp)
+	r.planDatabaseID(ctx, req, resp)
+	r.validatePlannedEnrichment(ctx, req, resp)
+	r.validatePlannedDocumentIDColumns(ctx, req, resp)
+	r.validatePlatform(ctx, resp)
+	r.validateServerFeatures(ctx, req, resp)
+	planAnnotations(ctx, r.client, resp)
+
+	// The remaining logic only applies to updates
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	r.planBlueGreenSwap(ctx, req, resp)
+}
+
+// validatePlatform checks the planned destinations against the platform the
+// provider targets
+func (r *SinkConsumerResource) validatePlatform(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	for _, name := range []string{"destination", "failover_destination"} {
+		var destination types.Object
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(name), &destination)...)
+		validateDestinationPlatform(r.client, destination, path.Root(name), &resp.Diagnostics)
+	}
+}
+
+// validateServerFeatures checks newly configured settings against the
+// features the Sequin server supports
+func (r *SinkConsumerResource) validateServerFeatures(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state SinkConsumerResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plannedChange(plan.Enrichment, state.Enrichment) {
+		requireServerFeature(r.client, client.FeatureEnrichment, "enrichment", path.Root("enrichment"), &resp.Diagnostics)
+	}
+	if plannedChange(plan.MessageShape, state.MessageShape) {
+		requireServerFeature(r.client, client.FeatureMessageShape, "message_shape", path.Root("message_shape"), &resp.Diagnostics)
+	}
+	if plannedChange(plan.TimestampFormat, state.TimestampFormat) && plan.TimestampFormat.ValueString() == "unix_microsecond" {
+		requireServerFeature(r.client, client.FeatureUnixMicrosecondTimestamps, `timestamp_format = "unix_microsecond"`, path.Root("timestamp_format"), &resp.Diagnostics)
+	}
+
+	for name, destinations := range map[string][2]types.Object{
+		"destination":          {plan.Destination, state.Destination},
+		"failover_destination": {plan.FailoverDestination, state.FailoverDestination},
+	} {
+		planned, prior := destinations[0], destinations[1]
+		if planned.IsNull() || planned.IsUnknown() {
+			continue
 		}
-		if len(response.Source.ExcludeSchemas) > 0 {
-			list, d := types.ListValueFrom(ctx, types.StringType, response.Source.ExcludeSchemas)
-			diags.Append(d...)
-			sourceAttrs["exclude_schemas"] = list
+		destType, ok := planned.Attributes()["type"].(types.String)
+		if !ok || destType.ValueString() != client.DestKinesis {
+			continue
 		}
-		if len(response.Source.IncludeTables) > 0 {
-			list, d := types.ListValueFrom(ctx, types.StringType, response.Source.IncludeTables)
-			diags.Append(d...)
-			sourceAttrs["include_tables"] = list
+		var priorType attr.Value = types.StringNull()
+		if !prior.IsNull() && !prior.IsUnknown() {
+			priorType = prior.Attributes()["type"]
 		}
-		if len(response.Source.ExcludeTables) > 0 {
-			list, d := types.ListValueFrom(ctx, types.StringType, response.Source.ExcludeTables)
-			diags.Append(d...)
-			sourceAttrs["exclude_tables"] = list
+		if plannedChange(destType, priorType) {
+			requireServerFeature(r.client, client.FeatureKinesisDestination, "Kinesis destinations", path.Root(name).AtName("type"), &resp.Diagnostics)
 		}
+	}
+}
 
-		obj, d := types.ObjectValue(sourceAttrTypes, sourceAttrs)
-		diags.Append(d...)
-		model.Source = obj
-	} else {
-		model.Source = types.ObjectNull(sourceAttrTypes)
+// planDatabaseID resolves the database reference to an ID at plan time so
+// database_id is known and an unknown database name surfaces before apply
+func (r *SinkConsumerResource) planDatabaseID(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var database, priorDatabase, priorDatabaseID types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("database"), &database)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("database"), &priorDatabase)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("database_id"), &priorDatabaseID)...)
+	}
+	if resp.Diagnostics.HasError() || database.IsUnknown() || database.IsNull() {
+		return
 	}
 
-	// Map tables
-	tablesList := make([]attr.Value, len(response.Tables))
-	for i, table := range response.Tables {
-		tableAttrs := map[string]attr.Value{
-			"name": types.StringValue(table.Name),
-		}
+	// Unchanged reference: the ID from state is still accurate
+	if database.Equal(priorDatabase) && !priorDatabaseID.IsNull() && !priorDatabaseID.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("database_id"), priorDatabaseID)...)
+		return
+	}
 
-		if len(table.GroupColumnNames) > 0 {
-			list, d := types.ListValueFrom(ctx, types.StringType, table.GroupColumnNames)
-			diags.Append(d...)
-			tableAttrs["group_column_names"] = list
-		} else {
-			tableAttrs["group_column_names"] = types.ListNull(types.StringType)
-		}
+	if r.client == nil {
+		return
+	}
 
-		obj, d := types.ObjectValue(map[string]attr.Type{
-			"name":                types.StringType,
-			"group_column_names": types.ListType{ElemType: types.StringType},
-		}, tableAttrs)
-		diags.Append(d...)
-		tablesList[i] = obj
+	databaseID, err := resolveDatabaseID(ctx, r.client, database.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("database"), "Error Resolving Database", err.Error())
+		return
 	}
-	list, d := types.ListValue(types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"name":                types.StringType,
-			"group_column_names": types.ListType{ElemType: types.StringType},
-		},
-	}, tablesList)
-	diags.Append(d...)
-	model.Tables = list
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("database_id"), types.StringValue(databaseID))...)
+}
 
-	// Map actions
-	if len(response.Actions) > 0 {
-		list, d := types.ListValueFrom(ctx, types.StringType, response.Actions)
-		diags.Append(d...)
-		model.Actions = list
-	} else {
-		model.Actions = types.ListNull(types.StringType)
+// planDefaultDatabase plans the provider's default database for sinks that
+// do not set one
+func (r *SinkConsumerResource) planDefaultDatabase(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var database types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("database"), &database)...)
+	if resp.Diagnostics.HasError() || !database.IsNull() || r.client == nil {
+		return
 	}
 
-	// Map destination
-	destAttrs := map[string]attr.Value{
-		"type":                  types.StringValue(response.Destination.Type),
-		"hosts":                 types.StringNull(),
-		"topic":                 types.StringNull(),
-		"tls":                   types.BoolNull(),
-		"username":              types.StringNull(),
-		"password":              types.StringNull(),
-		"sasl_mechanism":        types.StringNull(),
-		"aws_region":            types.StringNull(),
-		"aws_access_key_id":     types.StringNull(),
-		"aws_secret_access_key": types.StringNull(),
-		"queue_url":             types.StringNull(),
-		"region":                types.StringNull(),
-		"access_key_id":         types.StringNull(),
-		"secret_access_key":     types.StringNull(),
-		"is_fifo":               types.BoolNull(),
-		"stream_arn":            types.StringNull(),
-		"http_endpoint":         types.StringNull(),
-		"http_endpoint_path":    types.StringNull(),
-		"batch":                 types.BoolNull(),
-	}
-
-	// Populate non-empty fields
-	if response.Destination.Hosts != "" {
-		destAttrs["hosts"] = types.StringValue(response.Destination.Hosts)
-	}
-	if response.Destination.Topic != "" {
-		destAttrs["topic"] = types.StringValue(response.Destination.Topic)
-	}
-	if response.Destination.TLS != nil {
-		destAttrs["tls"] = types.BoolValue(*response.Destination.TLS)
-	}
-	if response.Destination.Username != "" {
-		destAttrs["username"] = types.StringValue(response.Destination.Username)
-	}
-	// Preserve values from existing state that the API doesn't return
-	if !model.Destination.IsNull() {
-		origDestAttrs := model.Destination.Attributes()
-		// Preserve sensitive fields (API doesn't return them)
-		if origPassword, ok := origDestAttrs["password"].(types.String); ok && !origPassword.IsNull() {
-			destAttrs["password"] = origPassword
-		}
-		if origAWSAccessKey, ok := origDestAttrs["aws_access_key_id"].(types.String); ok && !origAWSAccessKey.IsNull() {
-			destAttrs["aws_access_key_id"] = origAWSAccessKey
-		}
-		if origAWSSecretKey, ok := origDestAttrs["aws_secret_access_key"].(types.String); ok && !origAWSSecretKey.IsNull() {
-			destAttrs["aws_secret_access_key"] = origAWSSecretKey
-		}
-		if origSecretKey, ok := origDestAttrs["secret_access_key"].(types.String); ok && !origSecretKey.IsNull() {
-			destAttrs["secret_access_key"] = origSecretKey
+	if r.client.DefaultDatabaseName() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("database"),
+			"Missing Database",
+			"Set database, or default_database in the provider configuration.",
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("database"), types.StringValue(r.client.DefaultDatabaseName()))...)
+}
+
+// validatePlannedEnrichment validates a new or changed enrichment function
+// against the sink's database when the provider opts in, so a broken query
+// fails the plan rather than live delivery
+func (r *SinkConsumerResource) validatePlannedEnrichment(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !r.client.EnrichmentValidated() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var enrichment, databaseID, priorEnrichment, priorDatabaseID types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("enrichment"), &enrichment)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("database_id"), &databaseID)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("enrichment"), &priorEnrichment)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("database_id"), &priorDatabaseID)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to validate yet, or nothing changed since the last apply
+	if enrichment.IsNull() || enrichment.IsUnknown() || databaseID.IsNull() || databaseID.IsUnknown() {
+		return
+	}
+	if enrichment.Equal(priorEnrichment) && databaseID.Equal(priorDatabaseID) {
+		return
+	}
+
+	result, err := r.client.ValidateFunction(ctx, enrichment.ValueString(), &client.FunctionValidationRequest{
+		Database: databaseID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enrichment"),
+			"Error Validating Enrichment",
+			"Could not validate enrichment "+enrichment.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !result.Valid {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enrichment"),
+			"Invalid Enrichment Query",
+			fmt.Sprintf("Enrichment %s is not valid for database %s:\n%s",
+				enrichment.ValueString(), databaseID.ValueString(), strings.Join(result.Errors, "\n")),
+		)
+	}
+}
+
+// validatePlannedDocumentIDColumns checks new or changed document ID columns
+// against the columns of the streamed tables, so a misspelled column fails
+// the plan rather than every write. Tables that cannot be inspected are
+// reported as warnings.
+func (r *SinkConsumerResource) validatePlannedDocumentIDColumns(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state SinkConsumerResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.DatabaseID.IsNull() || plan.DatabaseID.IsUnknown() || plan.Tables.IsUnknown() {
+		return
+	}
+
+	destinations := []struct {
+		name           string
+		planned, prior types.Object
+	}{
+		{"destination", plan.Destination, state.Destination},
+		{"failover_destination", plan.FailoverDestination, state.FailoverDestination},
+	}
+	for _, destination := range destinations {
+		columns := documentIDColumns(destination.planned)
+		if len(columns) == 0 {
+			continue
 		}
-		if origAccessKey, ok := origDestAttrs["access_key_id"].(types.String); ok && !origAccessKey.IsNull() {
-			destAttrs["access_key_id"] = origAccessKey
+		// Nothing changed since the last apply
+		if slices.Equal(columns, documentIDColumns(destination.prior)) &&
+			plan.Tables.Equal(state.Tables) && plan.DatabaseID.Equal(state.DatabaseID) {
+			continue
 		}
-		// Preserve topic from state if API returns empty (e.g. when routing overrides topic)
-		if response.Destination.Topic == "" {
-			if origTopic, ok := origDestAttrs["topic"].(types.String); ok && !origTopic.IsNull() {
-				destAttrs["topic"] = origTopic
+
+		at := path.Root(destination.name).AtName("document_id_columns")
+		for _, table := range buildTablesRequest(plan.Tables) {
+			existing, err := r.client.ListTableColumns(ctx, plan.DatabaseID.ValueString(), table.Name)
+			if err != nil {
+				resp.Diagnostics.AddAttributeWarning(at, "Could Not Check Document ID Columns",
+					fmt.Sprintf("Could not list the columns of %s: %s", table.Name, err))
+				continue
+			}
+			names := make([]string, len(existing))
+			for i, column := range existing {
+				names[i] = column.Name
+			}
+			for _, column := range columns {
+				if !slices.Contains(names, column) {
+					resp.Diagnostics.AddAttributeError(at, "Unknown Document ID Column",
+						fmt.Sprintf("Column %s does not exist on table %s.", column, table.Name))
+				}
 			}
 		}
 	}
-	if response.Destination.SASLMechanism != "" {
-		destAttrs["sasl_mechanism"] = types.StringValue(response.Destination.SASLMechanism)
+}
+
+// databaseIDFor returns the planned database ID, resolving the reference when
+// it could not be resolved at plan time
+func (r *SinkConsumerResource) databaseIDFor(ctx context.Context, database, plannedID types.String) (string, error) {
+	if !plannedID.IsNull() && !plannedID.IsUnknown() {
+		return plannedID.ValueString(), nil
 	}
-	if response.Destination.AWSRegion != "" {
-		destAttrs["aws_region"] = types.StringValue(response.Destination.AWSRegion)
+	return resolveDatabaseID(ctx, r.client, database.ValueString())
+}
+
+// applyCursorReset moves the sink's cursor to the planned LSN when it is set
+// and differs from the prior value. On failure the prior value is returned so
+// the reset is attempted again on the next apply.
+func (r *SinkConsumerResource) applyCursorReset(ctx context.Context, consumerID string, planned, prior types.String, diags *diag.Diagnostics) types.String {
+	if planned.IsNull() || planned.IsUnknown() || planned.Equal(prior) {
+		return planned
 	}
-	if response.Destination.QueueURL != "" {
-		destAttrs["queue_url"] = types.StringValue(response.Destination.QueueURL)
+
+	lsn := planned.ValueString()
+	if _, err := r.client.ResetSinkConsumerCursor(ctx, consumerID, &client.SinkConsumerCursorResetRequest{LSN: lsn}); err != nil {
+		diags.AddAttributeError(
+			path.Root("cursor_reset_lsn"),
+			"Error Resetting Sink Consumer Cursor",
+			"Could not move the cursor of sink consumer ID "+consumerID+" to "+lsn+": "+err.Error(),
+		)
+		return prior
 	}
-	if response.Destination.Region != "" {
-		destAttrs["region"] = types.StringValue(response.Destination.Region)
+
+	return planned
+}
+
+// buildSinkConsumerRequest converts the planned model into an API request.
+// Database and status are set by the caller since Create and Update treat
+// them differently.
+func buildSinkConsumerRequest(ctx context.Context, model *SinkConsumerResourceModel, diags *diag.Diagnostics) *client.SinkConsumerRequest {
+	apiReq := &client.SinkConsumerRequest{
+		Name: model.Name.ValueString(),
 	}
-	if response.Destination.AccessKeyID != "" {
-		destAttrs["access_key_id"] = types.StringValue(response.Destination.AccessKeyID)
+
+	apiReq.Source = buildSourceRequest(model.Source)
+	apiReq.Tables = buildTablesRequest(model.Tables)
+
+	// Parse actions
+	if !model.Actions.IsNull() {
+		apiReq.Actions = listStrings(model.Actions)
 	}
-	if response.Destination.SecretAccessKey != "" {
-		destAttrs["secret_access_key"] = types.StringValue(response.Destination.SecretAccessKey)
+
+	// Parse destination
+	apiReq.Destination = buildDestinationRequest(model.Destination)
+	apiReq.FailoverDestination = buildFailoverDestinationRequest(ctx, model.FailoverDestination, diags)
+
+	// Optional string fields
+	if !model.Filter.IsNull() {
+		apiReq.Filter = model.Filter.ValueString()
 	}
-	if response.Destination.IsFIFO != nil {
-		destAttrs["is_fifo"] = types.BoolValue(*response.Destination.IsFIFO)
+	if !model.Transform.IsNull() {
+		apiReq.Transform = model.Transform.ValueString()
 	}
-	if response.Destination.StreamARN != "" {
-		destAttrs["stream_arn"] = types.StringValue(response.Destination.StreamARN)
+	if !model.Enrichment.IsNull() {
+		apiReq.Enrichment = model.Enrichment.ValueString()
 	}
-	if response.Destination.HTTPEndpoint != "" {
-		destAttrs["http_endpoint"] = types.StringValue(response.Destination.HTTPEndpoint)
+	if !model.Routing.IsNull() {
+		apiReq.Routing = model.Routing.ValueString()
 	}
-	if response.Destination.HTTPEndpointPath != "" {
-		destAttrs["http_endpoint_path"] = types.StringValue(response.Destination.HTTPEndpointPath)
+	if !model.LoadSheddingPolicy.IsNull() {
+		apiReq.LoadSheddingPolicy = model.LoadSheddingPolicy.ValueString()
 	}
-	if response.Destination.Batch != nil {
-		destAttrs["batch"] = types.BoolValue(*response.Destination.Batch)
+	if !model.TimestampFormat.IsNull() {
+		apiReq.TimestampFormat = model.TimestampFormat.ValueString()
 	}
 
-	destObj, d := types.ObjectValue(map[string]attr.Type{
-		"type":                  types.StringType,
-		"hosts":                 types.StringType,
-		"topic":                 types.StringType,
-		"tls":                   types.BoolType,
-		"username":              types.StringType,
-		"password":              types.StringType,
-		"sasl_mechanism":        types.StringType,
-		"aws_region":            types.StringType,
-		"aws_access_key_id":     types.StringType,
-		"aws_secret_access_key": types.StringType,
-		"queue_url":             types.StringType,
-		"region":                types.StringType,
-		"access_key_id":         types.StringType,
-		"secret_access_key":     types.StringType,
-		"is_fifo":               types.BoolType,
-		"stream_arn":            types.StringType,
-		"http_endpoint":         types.StringType,
-		"http_endpoint_path":    types.StringType,
-		"batch":                 types.BoolType,
-	}, destAttrs)
-	diags.Append(d...)
-	model.Destination = destObj
+	apiReq.MessageShape = buildMessageShapeRequest(ctx, model.MessageShape, diags)
+
+	// Optional bool/int fields
+	if !model.MessageGrouping.IsNull() {
+		val := model.MessageGrouping.ValueBool()
+		apiReq.MessageGrouping = &val
+	}
+	if !model.BatchSize.IsNull() {
+		val := int(model.BatchSize.ValueInt64())
+		apiReq.BatchSize = &val
+	}
+	if !model.MaxRetryCount.IsNull() {
+		val := int(model.MaxRetryCount.ValueInt64())
+		apiReq.MaxRetryCount = &val
+	}
+	if !model.FunctionVersion.IsNull() && !model.FunctionVersion.IsUnknown() {
+		val := int(model.FunctionVersion.ValueInt64())
+		apiReq.FunctionVersion = &val
+	}
+
+	return apiReq
+}
+
+// mapResponseToModel maps API response to Terraform model
+func (r *SinkConsumerResource) mapResponseToModel(ctx context.Context, response *client.SinkConsumerResponse, model *SinkConsumerResourceModel, diags *diag.Diagnostics) {
+	model.ID = types.StringValue(response.ID)
+	model.Name = types.StringValue(response.Name)
+	model.Status = types.StringValue(response.Status)
+	// The configured database may be a name or an ID; keep whichever form the
+	// user wrote unless the sink now points at a different database
+	if model.Database.IsNull() || model.Database.IsUnknown() ||
+		!referenceMatches(response.Database, model.Database.ValueString(), model.DatabaseID.ValueString()) {
+		model.Database = types.StringValue(response.Database)
+	}
+	if isUUID(response.Database) {
+		model.DatabaseID = types.StringValue(response.Database)
+	}
+
+	model.Source = mapSourceToObject(response.Source)
+	model.Tables = mapTablesToList(response.Tables)
+
+	// Map actions
+	model.Actions = stringListValue(response.Actions)
+
+	// Map destination
+	model.Destination = mapDestinationToObject(response.Destination, model.Destination, diags)
+	model.FailoverDestination = mapFailoverDestinationToObject(ctx, response.FailoverDestination, model.FailoverDestination, diags)
 
 	// Optional string fields — API returns "none" for unset values, treat as null
 	if response.Filter != "" && response.Filter != "none" {
@@ -1084,16 +1090,17 @@ func (r *SinkConsumerResource) mapResponseToModel(ctx context.Context, response
 	} else {
 		model.MaxRetryCount = types.Int64Null()
 	}
+	if response.FunctionVersion != nil {
+		model.FunctionVersion = types.Int64Value(int64(*response.FunctionVersion))
+	} else {
+		model.FunctionVersion = types.Int64Null()
+	}
 	model.LoadSheddingPolicy = types.StringValue(response.LoadSheddingPolicy)
 	model.TimestampFormat = types.StringValue(response.TimestampFormat)
+	model.MessageShape = mapMessageShapeToObject(ctx, response.MessageShape, model.MessageShape, diags)
+	mapAnnotations(ctx, response.Annotations, defaultAnnotations(r.client), &model.Annotations, &model.AnnotationsAll, diags)
 
 	// Status info — only overwrite if API returned actual data
-	statusInfoAttrTypes := map[string]attr.Type{
-		"state":      types.StringType,
-		"created_at": types.StringType,
-		"updated_at": types.StringType,
-		"last_error": types.StringType,
-	}
 	statusInfoHasData := response.StatusInfo.State != "" ||
 		response.StatusInfo.CreatedAt != "" ||
 		response.StatusInfo.UpdatedAt != ""
@@ -1122,3 +1129,190 @@ func (r *SinkConsumerResource) mapResponseToModel(ctx context.Context, response
 	}
 	// else: keep existing state value (don't overwrite with empty data)
 }
+
+// destinationSecrets returns the configured destination secrets tracked for drift detection
+func destinationSecrets(destination types.Object) map[string]string {
+	return stringSecrets(objectStringSecrets(destination, destinationSecretAttributes...))
+}
+
+// functionReferences returns the model's function reference attributes keyed
+// by attribute name
+func functionReferences(model *SinkConsumerResourceModel) map[string]*types.String {
+	return map[string]*types.String{
+		"filter":     &model.Filter,
+		"transform":  &model.Transform,
+		"enrichment": &model.Enrichment,
+		"routing":    &model.Routing,
+	}
+}
+
+// snapshotFunctionRefs copies the configured function references before the
+// model is overwritten from an API response
+func snapshotFunctionRefs(model *SinkConsumerResourceModel) map[string]types.String {
+	snapshot := make(map[string]types.String)
+	for name, value := range functionReferences(model) {
+		snapshot[name] = *value
+	}
+	return snapshot
+}
+
+// resolveFunctionRefs sets the request's function fields, resolving function
+// IDs to names. It returns the ID to name mapping for references given by ID.
+func (r *SinkConsumerResource) resolveFunctionRefs(ctx context.Context, model *SinkConsumerResourceModel, apiReq *client.SinkConsumerRequest, diags *diag.Diagnostics) map[string]string {
+	targets := map[string]*string{
+		"filter":     &apiReq.Filter,
+		"transform":  &apiReq.Transform,
+		"enrichment": &apiReq.Enrichment,
+		"routing":    &apiReq.Routing,
+	}
+
+	var refs map[string]string
+	for name, value := range functionReferences(model) {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		ref := value.ValueString()
+		functionName, err := resolveFunctionName(ctx, r.client, ref)
+		if err != nil {
+			diags.AddAttributeError(path.Root(name), "Error Resolving Function", err.Error())
+			continue
+		}
+
+		*targets[name] = functionName
+		if functionName != ref {
+			if refs == nil {
+				refs = make(map[string]string)
+			}
+			refs[ref] = functionName
+		}
+	}
+	return refs
+}
+
+// restoreFunctionRefs puts configured function IDs back in place of the names
+// the API reports for them. A different name is real drift and is kept.
+func restoreFunctionRefs(model *SinkConsumerResourceModel, configured map[string]types.String, refs map[string]string) {
+	for name, value := range functionReferences(model) {
+		prior := configured[name]
+		if prior.IsNull() || prior.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if functionName, ok := refs[prior.ValueString()]; ok && functionName == value.ValueString() {
+			*value = prior
+		}
+	}
+}
+
+// endpointReferences returns the model's destinations that can reference an
+// HTTP endpoint, keyed by attribute name
+func endpointReferences(model *SinkConsumerResourceModel) map[string]*types.Object {
+	return map[string]*types.Object{
+		"destination":          &model.Destination,
+		"failover_destination": &model.FailoverDestination,
+	}
+}
+
+// httpEndpointID returns the http_endpoint_id of a destination object
+func httpEndpointID(destination types.Object) types.String {
+	if destination.IsNull() || destination.IsUnknown() {
+		return types.StringNull()
+	}
+	if id, ok := destination.Attributes()["http_endpoint_id"].(types.String); ok {
+		return id
+	}
+	return types.StringNull()
+}
+
+// snapshotEndpointRefs copies the configured HTTP endpoint references before
+// the model is overwritten from an API response
+func snapshotEndpointRefs(model *SinkConsumerResourceModel) map[string]types.String {
+	snapshot := make(map[string]types.String)
+	for name, destination := range endpointReferences(model) {
+		snapshot[name] = httpEndpointID(*destination)
+	}
+	return snapshot
+}
+
+// resolveEndpointRefs resolves HTTP endpoint names in the request's
+// destinations to IDs. It returns the name to ID mapping for references given
+// by name.
+func (r *SinkConsumerResource) resolveEndpointRefs(ctx context.Context, apiReq *client.SinkConsumerRequest, diags *diag.Diagnostics) map[string]string {
+	targets := map[string]*string{"destination": &apiReq.Destination.HTTPEndpointID}
+	if apiReq.FailoverDestination != nil {
+		targets["failover_destination"] = &apiReq.FailoverDestination.HTTPEndpointID
+	}
+
+	var refs map[string]string
+	for name, target := range targets {
+		ref := *target
+		if ref == "" {
+			continue
+		}
+
+		endpointID, err := resolveHTTPEndpointID(ctx, r.client, ref)
+		if err != nil {
+			diags.AddAttributeError(path.Root(name).AtName("http_endpoint_id"), "Error Resolving HTTP Endpoint", err.Error())
+			continue
+		}
+
+		*target = endpointID
+		if endpointID != ref {
+			if refs == nil {
+				refs = make(map[string]string)
+			}
+			refs[ref] = endpointID
+		}
+	}
+	return refs
+}
+
+// restoreEndpointRefs puts configured HTTP endpoint names back in place of the
+// IDs the API reports for them. A different ID is real drift and is kept.
+func restoreEndpointRefs(ctx context.Context, model *SinkConsumerResourceModel, configured map[string]types.String, refs map[string]string, diags *diag.Diagnostics) {
+	for name, destination := range endpointReferences(model) {
+		prior := configured[name]
+		current := httpEndpointID(*destination)
+		if prior.IsNull() || prior.IsUnknown() || current.IsNull() {
+			continue
+		}
+		if endpointID, ok := refs[prior.ValueString()]; !ok || endpointID != current.ValueString() {
+			continue
+		}
+
+		attrs := make(map[string]attr.Value, len(destination.Attributes()))
+		for attrName, value := range destination.Attributes() {
+			attrs[attrName] = value
+		}
+		attrs["http_endpoint_id"] = prior
+		restored, d := types.ObjectValue(destination.AttributeTypes(ctx), attrs)
+		diags.Append(d...)
+		*destination = restored
+	}
+}
+
+// statusUnmanaged reports whether status was omitted from the configuration
+func statusUnmanaged(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) bool {
+	var status types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("status"), &status)...)
+	return status.IsNull()
+}
+
+// preserveUnmanagedStatus keeps the prior status when status is not managed by
+// configuration and the remote value merely toggled between active and paused
+// (e.g. an on-call engineer pausing a sink). Any other transition, such as to
+// disabled, is still reported.
+func preserveUnmanagedStatus(prior, remote types.String, ignoreRemote bool) types.String {
+	if !ignoreRemote || prior.IsNull() || prior.IsUnknown() {
+		return remote
+	}
+	if isToggleableStatus(prior.ValueString()) && isToggleableStatus(remote.ValueString()) {
+		return prior
+	}
+	return remote
+}
+
+// isToggleableStatus reports whether a status is part of the active/paused pair
+func isToggleableStatus(status string) bool {
+	return status == client.SinkStatusActive || status == client.SinkStatusPaused
+}
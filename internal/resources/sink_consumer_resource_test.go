@@ -2,13 +2,29 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/clintdigital/terraform-provider-sequin/internal/client/clienttest"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // TestSinkConsumerResource_Configure tests the Configure method
@@ -73,10 +89,10 @@ func TestSinkConsumerResource_Schema(t *testing.T) {
 	}
 
 	requiredAttrs := []string{
-		"id", "name", "status", "database", "tables", "actions",
-		"destination", "filter", "transform", "enrichment", "routing",
+		"id", "name", "status", "database", "database_id", "tables", "actions",
+		"destination", "failover_destination", "filter", "transform", "function_version", "enrichment", "routing",
 		"message_grouping", "batch_size", "max_retry_count",
-		"load_shedding_policy", "timestamp_format", "status_info",
+		"load_shedding_policy", "timestamp_format", "message_shape", "blue_green", "credential_verify_seconds", "cursor_reset_lsn", "status_info",
 	}
 	for _, attr := range requiredAttrs {
 		if _, ok := resp.Schema.Attributes[attr]; !ok {
@@ -94,25 +110,62 @@ func TestSinkConsumerResource_Schema(t *testing.T) {
 
 // destAttrTypes is the attribute type map for destination objects
 var destAttrTypes = map[string]attr.Type{
-	"type":                  types.StringType,
-	"hosts":                 types.StringType,
-	"topic":                 types.StringType,
-	"tls":                   types.BoolType,
-	"username":              types.StringType,
-	"password":              types.StringType,
-	"sasl_mechanism":        types.StringType,
-	"aws_region":            types.StringType,
-	"aws_access_key_id":     types.StringType,
-	"aws_secret_access_key": types.StringType,
-	"queue_url":             types.StringType,
-	"region":                types.StringType,
-	"access_key_id":         types.StringType,
-	"secret_access_key":     types.StringType,
-	"is_fifo":               types.BoolType,
-	"stream_arn":            types.StringType,
-	"http_endpoint":         types.StringType,
-	"http_endpoint_path":    types.StringType,
-	"batch":                 types.BoolType,
+	"type":                       types.StringType,
+	"hosts":                      types.StringType,
+	"topic":                      types.StringType,
+	"tls":                        types.BoolType,
+	"username":                   types.StringType,
+	"password":                   types.StringType,
+	"sasl_mechanism":             types.StringType,
+	"aws_region":                 types.StringType,
+	"aws_access_key_id":          types.StringType,
+	"aws_secret_access_key":      types.StringType,
+	"partition_key_expression":   types.StringType,
+	"queue_url":                  types.StringType,
+	"region":                     types.StringType,
+	"access_key_id":              types.StringType,
+	"secret_access_key":          types.StringType,
+	"is_fifo":                    types.BoolType,
+	"stream_arn":                 types.StringType,
+	"http_endpoint":              types.StringType,
+	"http_endpoint_id":           types.StringType,
+	"http_endpoint_path":         types.StringType,
+	"batch":                      types.BoolType,
+	"batch_format":               types.StringType,
+	"batch_max_bytes":            types.Int64Type,
+	"credential_ref":             types.StringType,
+	"aws_credentials_source":     types.StringType,
+	"project_id":                 types.StringType,
+	"topic_id":                   types.StringType,
+	"gcp_credentials":            types.StringType,
+	"use_workload_identity":      types.BoolType,
+	"namespace":                  types.StringType,
+	"event_hub_name":             types.StringType,
+	"shared_access_key_name":     types.StringType,
+	"shared_access_key":          types.StringType,
+	"managed_identity_client_id": types.StringType,
+	"creds":                      types.StringType,
+	"nkey_seed":                  types.StringType,
+	"jwt":                        types.StringType,
+	"virtual_host":               types.StringType,
+	"exchange":                   types.StringType,
+	"exchange_type":              types.StringType,
+	"durable":                    types.BoolType,
+	"auto_delete":                types.BoolType,
+	"publisher_confirms":         types.BoolType,
+	"headers":                    types.MapType{ElemType: types.StringType},
+	"database_index":             types.Int64Type,
+	"stream_key":                 types.StringType,
+	"max_len":                    types.Int64Type,
+	"ttl_seconds":                types.Int64Type,
+	"endpoint_url":               types.StringType,
+	"index_name":                 types.StringType,
+	"auth_type":                  types.StringType,
+	"api_key":                    types.StringType,
+	"document_id_columns":        types.ListType{ElemType: types.StringType},
+	"auto_create_index":          types.BoolType,
+	"index_settings":             types.StringType,
+	"collection_name":            types.StringType,
 }
 
 func newNullDestModel() types.Object {
@@ -303,25 +356,62 @@ func TestMapResponseToModel_SensitiveFieldPreservation(t *testing.T) {
 
 	// Simulate existing state with sensitive values
 	allNullAttrs := map[string]attr.Value{
-		"type":                  types.StringValue("kafka"),
-		"hosts":                 types.StringValue("broker:9092"),
-		"topic":                 types.StringValue("events"),
-		"tls":                   types.BoolNull(),
-		"username":              types.StringNull(),
-		"password":              types.StringValue("my-secret-password"),
-		"sasl_mechanism":        types.StringNull(),
-		"aws_region":            types.StringNull(),
-		"aws_access_key_id":     types.StringValue("AKIAIOSFODNN7"),
-		"aws_secret_access_key": types.StringValue("wJalrXUtnFEMI/K7MDENG"),
-		"queue_url":             types.StringNull(),
-		"region":                types.StringNull(),
-		"access_key_id":         types.StringNull(),
-		"secret_access_key":     types.StringNull(),
-		"is_fifo":               types.BoolNull(),
-		"stream_arn":            types.StringNull(),
-		"http_endpoint":         types.StringNull(),
-		"http_endpoint_path":    types.StringNull(),
-		"batch":                 types.BoolNull(),
+		"type":                       types.StringValue("kafka"),
+		"hosts":                      types.StringValue("broker:9092"),
+		"topic":                      types.StringValue("events"),
+		"tls":                        types.BoolNull(),
+		"username":                   types.StringNull(),
+		"password":                   types.StringValue("my-secret-password"),
+		"sasl_mechanism":             types.StringNull(),
+		"aws_region":                 types.StringNull(),
+		"aws_access_key_id":          types.StringValue("AKIAIOSFODNN7"),
+		"aws_secret_access_key":      types.StringValue("wJalrXUtnFEMI/K7MDENG"),
+		"partition_key_expression":   types.StringNull(),
+		"queue_url":                  types.StringNull(),
+		"region":                     types.StringNull(),
+		"access_key_id":              types.StringNull(),
+		"secret_access_key":          types.StringNull(),
+		"is_fifo":                    types.BoolNull(),
+		"stream_arn":                 types.StringNull(),
+		"http_endpoint":              types.StringNull(),
+		"http_endpoint_id":           types.StringNull(),
+		"http_endpoint_path":         types.StringNull(),
+		"batch":                      types.BoolNull(),
+		"batch_format":               types.StringNull(),
+		"batch_max_bytes":            types.Int64Null(),
+		"credential_ref":             types.StringNull(),
+		"aws_credentials_source":     types.StringNull(),
+		"project_id":                 types.StringNull(),
+		"topic_id":                   types.StringNull(),
+		"gcp_credentials":            types.StringNull(),
+		"use_workload_identity":      types.BoolNull(),
+		"namespace":                  types.StringNull(),
+		"event_hub_name":             types.StringNull(),
+		"shared_access_key_name":     types.StringNull(),
+		"shared_access_key":          types.StringNull(),
+		"managed_identity_client_id": types.StringNull(),
+		"creds":                      types.StringNull(),
+		"nkey_seed":                  types.StringNull(),
+		"jwt":                        types.StringNull(),
+		"virtual_host":               types.StringNull(),
+		"exchange":                   types.StringNull(),
+		"exchange_type":              types.StringNull(),
+		"durable":                    types.BoolNull(),
+		"auto_delete":                types.BoolNull(),
+		"publisher_confirms":         types.BoolNull(),
+		"headers":                    types.MapNull(types.StringType),
+		"database_index":             types.Int64Null(),
+		"stream_key":                 types.StringNull(),
+		"max_len":                    types.Int64Null(),
+		"ttl_seconds":                types.Int64Null(),
+		"endpoint_url":               types.StringNull(),
+		"index_name":                 types.StringNull(),
+		"auth_type":                  types.StringNull(),
+		"api_key":                    types.StringNull(),
+		"document_id_columns":        types.ListNull(types.StringType),
+		"auto_create_index":          types.BoolNull(),
+		"index_settings":             types.StringNull(),
+		"collection_name":            types.StringNull(),
 	}
 	existingDest, _ := types.ObjectValue(destAttrTypes, allNullAttrs)
 
@@ -549,25 +639,62 @@ func TestMapResponseToModel_TopicPreservationWithRouting(t *testing.T) {
 
 	// State has the original topic
 	stateAttrs := map[string]attr.Value{
-		"type":                  types.StringValue("kafka"),
-		"hosts":                 types.StringValue("broker:9092"),
-		"topic":                 types.StringValue("default-topic"),
-		"tls":                   types.BoolNull(),
-		"username":              types.StringNull(),
-		"password":              types.StringNull(),
-		"sasl_mechanism":        types.StringNull(),
-		"aws_region":            types.StringNull(),
-		"aws_access_key_id":     types.StringNull(),
-		"aws_secret_access_key": types.StringNull(),
-		"queue_url":             types.StringNull(),
-		"region":                types.StringNull(),
-		"access_key_id":         types.StringNull(),
-		"secret_access_key":     types.StringNull(),
-		"is_fifo":               types.BoolNull(),
-		"stream_arn":            types.StringNull(),
-		"http_endpoint":         types.StringNull(),
-		"http_endpoint_path":    types.StringNull(),
-		"batch":                 types.BoolNull(),
+		"type":                       types.StringValue("kafka"),
+		"hosts":                      types.StringValue("broker:9092"),
+		"topic":                      types.StringValue("default-topic"),
+		"tls":                        types.BoolNull(),
+		"username":                   types.StringNull(),
+		"password":                   types.StringNull(),
+		"sasl_mechanism":             types.StringNull(),
+		"aws_region":                 types.StringNull(),
+		"aws_access_key_id":          types.StringNull(),
+		"aws_secret_access_key":      types.StringNull(),
+		"partition_key_expression":   types.StringNull(),
+		"queue_url":                  types.StringNull(),
+		"region":                     types.StringNull(),
+		"access_key_id":              types.StringNull(),
+		"secret_access_key":          types.StringNull(),
+		"is_fifo":                    types.BoolNull(),
+		"stream_arn":                 types.StringNull(),
+		"http_endpoint":              types.StringNull(),
+		"http_endpoint_id":           types.StringNull(),
+		"http_endpoint_path":         types.StringNull(),
+		"batch":                      types.BoolNull(),
+		"batch_format":               types.StringNull(),
+		"batch_max_bytes":            types.Int64Null(),
+		"credential_ref":             types.StringNull(),
+		"aws_credentials_source":     types.StringNull(),
+		"project_id":                 types.StringNull(),
+		"topic_id":                   types.StringNull(),
+		"gcp_credentials":            types.StringNull(),
+		"use_workload_identity":      types.BoolNull(),
+		"namespace":                  types.StringNull(),
+		"event_hub_name":             types.StringNull(),
+		"shared_access_key_name":     types.StringNull(),
+		"shared_access_key":          types.StringNull(),
+		"managed_identity_client_id": types.StringNull(),
+		"creds":                      types.StringNull(),
+		"nkey_seed":                  types.StringNull(),
+		"jwt":                        types.StringNull(),
+		"virtual_host":               types.StringNull(),
+		"exchange":                   types.StringNull(),
+		"exchange_type":              types.StringNull(),
+		"durable":                    types.BoolNull(),
+		"auto_delete":                types.BoolNull(),
+		"publisher_confirms":         types.BoolNull(),
+		"headers":                    types.MapNull(types.StringType),
+		"database_index":             types.Int64Null(),
+		"stream_key":                 types.StringNull(),
+		"max_len":                    types.Int64Null(),
+		"ttl_seconds":                types.Int64Null(),
+		"endpoint_url":               types.StringNull(),
+		"index_name":                 types.StringNull(),
+		"auth_type":                  types.StringNull(),
+		"api_key":                    types.StringNull(),
+		"document_id_columns":        types.ListNull(types.StringType),
+		"auto_create_index":          types.BoolNull(),
+		"index_settings":             types.StringNull(),
+		"collection_name":            types.StringNull(),
 	}
 	existingDest, _ := types.ObjectValue(destAttrTypes, stateAttrs)
 
@@ -587,3 +714,1494 @@ func TestMapResponseToModel_TopicPreservationWithRouting(t *testing.T) {
 		t.Errorf("topic should be preserved from state when empty, got %v", destAttrs["topic"])
 	}
 }
+
+func TestPreserveUnmanagedStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		prior  types.String
+		remote types.String
+		ignore bool
+		want   string
+	}{
+		{"managed status reports drift", types.StringValue("active"), types.StringValue("paused"), false, "paused"},
+		{"unmanaged pause ignored", types.StringValue("active"), types.StringValue("paused"), true, "active"},
+		{"unmanaged resume ignored", types.StringValue("paused"), types.StringValue("active"), true, "paused"},
+		{"unmanaged disable reported", types.StringValue("active"), types.StringValue("disabled"), true, "disabled"},
+		{"no prior status uses remote", types.StringNull(), types.StringValue("paused"), true, "paused"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := preserveUnmanagedStatus(tt.prior, tt.remote, tt.ignore)
+			if got.ValueString() != tt.want {
+				t.Errorf("preserveUnmanagedStatus() = %q, want %q", got.ValueString(), tt.want)
+			}
+		})
+	}
+}
+
+// planTestProvider serves resources configured with a client, so plans are
+// computed by the framework the way Terraform requests them, including
+// attribute plan modifiers
+type planTestProvider struct {
+	client    client.SequinAPI
+	resources []func() resource.Resource
+}
+
+func (p *planTestProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "sequin"
+}
+
+func (p *planTestProvider) Schema(context.Context, provider.SchemaRequest, *provider.SchemaResponse) {
+}
+
+func (p *planTestProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.ResourceData = p.client
+}
+
+func (p *planTestProvider) Resources(context.Context) []func() resource.Resource {
+	return p.resources
+}
+
+func (p *planTestProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// planResourceChange plans the change of a resource from prior to planned
+// through the provider protocol, with planned also used as the configuration
+func planResourceChange(t *testing.T, c client.SequinAPI, newResource func() resource.Resource, typeName string, prior, planned tftypes.Value) *tfprotov6.PlanResourceChangeResponse {
+	t.Helper()
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(&planTestProvider{client: c, resources: []func() resource.Resource{newResource}})()
+
+	dynamicValue := func(value tftypes.Value) *tfprotov6.DynamicValue {
+		dv, err := tfprotov6.NewDynamicValue(value.Type(), value)
+		if err != nil {
+			t.Fatalf("encoding value: %v", err)
+		}
+		return &dv
+	}
+	emptyConfig := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, map[string]tftypes.Value{})
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: dynamicValue(emptyConfig)})
+	if err != nil || len(configureResp.Diagnostics) != 0 {
+		t.Fatalf("ConfigureProvider() = %v, %v", configureResp, err)
+	}
+
+	resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       dynamicValue(prior),
+		ProposedNewState: dynamicValue(planned),
+		Config:           dynamicValue(planned),
+	})
+	if err != nil {
+		t.Fatalf("PlanResourceChange() error: %v", err)
+	}
+	return resp
+}
+
+func TestModifyPlan_DestinationChangesUpdateInPlace(t *testing.T) {
+	ctx := context.Background()
+	r := NewSinkConsumerResource()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	resourceType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	// changed returns a value of attrType other than null
+	changed := func(attrType attr.Type) attr.Value {
+		switch attrType {
+		case types.StringType:
+			return types.StringValue("changed")
+		case types.BoolType:
+			return types.BoolValue(true)
+		case types.Int64Type:
+			return types.Int64Value(1)
+		case types.ListType{ElemType: types.StringType}:
+			return types.ListValueMust(types.StringType, []attr.Value{types.StringValue("changed")})
+		case types.MapType{ElemType: types.StringType}:
+			return types.MapValueMust(types.StringType, map[string]attr.Value{"changed": types.StringValue("changed")})
+		}
+		t.Fatalf("no changed value for %s", attrType)
+		return nil
+	}
+	toValue := func(model SinkConsumerResourceModel) tftypes.Value {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(resourceType, nil)}
+		if diags := state.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("building state: %v", diags.Errors())
+		}
+		return state.Raw
+	}
+
+	prior := newRoundTripPlan(t, "kafka", nil)
+	prior.ID = types.StringValue("sink-1")
+	prior.AnnotationsAll = types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	for name, attrType := range destinationAttrTypes {
+		t.Run(name, func(t *testing.T) {
+			attrs := maps.Clone(prior.Destination.Attributes())
+			attrs[name] = changed(attrType)
+			planned := prior
+			planned.Destination = types.ObjectValueMust(destinationAttrTypes, attrs)
+
+			resp := planResourceChange(t, &clienttest.Mock{}, NewSinkConsumerResource, "sequin_sink_consumer", toValue(prior), toValue(planned))
+			if len(resp.RequiresReplace) != 0 {
+				t.Errorf("changing %s plans a replacement of %v, want an update", name, resp.RequiresReplace)
+			}
+			plannedState, err := resp.PlannedState.Unmarshal(resourceType)
+			if err != nil {
+				t.Fatalf("decoding planned state: %v", err)
+			}
+			var id types.String
+			tfsdk.State{Schema: schemaResp.Schema, Raw: plannedState}.GetAttribute(ctx, path.Root("id"), &id)
+			if id.ValueString() != "sink-1" {
+				t.Errorf("planned id = %s, want the sink kept", id)
+			}
+		})
+	}
+}
+
+func TestMapDestinationToObject_HTTPEndpointReference(t *testing.T) {
+	apiDest := client.SinkConsumerDestination{
+		Type:           "webhook",
+		HTTPEndpoint:   "https://api.example.com",
+		HTTPEndpointID: "endpoint-123",
+	}
+
+	// Configured by ID only: the URL the API reports must not become drift
+	var diags diag.Diagnostics
+	obj := mapDestinationToObject(apiDest, types.ObjectNull(destAttrTypes), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	attrs := obj.Attributes()
+	if got := attrs["http_endpoint_id"].(types.String).ValueString(); got != "endpoint-123" {
+		t.Errorf("http_endpoint_id = %q, want endpoint-123", got)
+	}
+	if !attrs["http_endpoint"].IsNull() {
+		t.Errorf("http_endpoint = %s, want null when referenced by ID", attrs["http_endpoint"])
+	}
+
+	// Inline URL without a reference is kept as before
+	apiDest.HTTPEndpointID = ""
+	obj = mapDestinationToObject(apiDest, types.ObjectNull(destAttrTypes), &diags)
+	if got := obj.Attributes()["http_endpoint"].(types.String).ValueString(); got != "https://api.example.com" {
+		t.Errorf("http_endpoint = %q, want inline URL", got)
+	}
+}
+
+func TestDestinationFingerprint_CredentialRef(t *testing.T) {
+	inline := client.SinkConsumerDestination{Type: "kafka", CredentialFingerprint: "fp-1"}
+	if got := destinationFingerprint(inline); got != "fp-1" {
+		t.Errorf("destinationFingerprint() = %q, want fp-1 for inline credentials", got)
+	}
+
+	// Rotating a referenced credential changes the sink fingerprint, which
+	// must not be reported as drift on the sink
+	referenced := client.SinkConsumerDestination{Type: "kafka", CredentialRef: "kafka-prod", CredentialFingerprint: "fp-2"}
+	if got := destinationFingerprint(referenced); got != "" {
+		t.Errorf("destinationFingerprint() = %q, want empty for a credential_ref", got)
+	}
+
+	var diags diag.Diagnostics
+	obj := mapDestinationToObject(referenced, types.ObjectNull(destAttrTypes), &diags)
+	if got := obj.Attributes()["credential_ref"].(types.String).ValueString(); got != "kafka-prod" {
+		t.Errorf("credential_ref = %q, want kafka-prod", got)
+	}
+	if got := buildDestinationRequest(obj).CredentialRef; got != "kafka-prod" {
+		t.Errorf("CredentialRef = %q, want kafka-prod", got)
+	}
+}
+
+func TestMapDestinationToObject_AWSCredentialsSource(t *testing.T) {
+	var diags diag.Diagnostics
+
+	// The static default reported by the API is not drift
+	apiDest := client.SinkConsumerDestination{Type: "sqs", AWSCredentialsSource: "static"}
+	obj := mapDestinationToObject(apiDest, types.ObjectNull(destAttrTypes), &diags)
+	if !obj.Attributes()["aws_credentials_source"].IsNull() {
+		t.Error("aws_credentials_source should stay null for the unconfigured static default")
+	}
+
+	apiDest.AWSCredentialsSource = "instance_profile"
+	apiDest.CredentialFingerprint = "fp-1"
+	obj = mapDestinationToObject(apiDest, types.ObjectNull(destAttrTypes), &diags)
+	if got := obj.Attributes()["aws_credentials_source"].(types.String).ValueString(); got != "instance_profile" {
+		t.Errorf("aws_credentials_source = %q, want instance_profile", got)
+	}
+	if got := destinationFingerprint(apiDest); got != "" {
+		t.Errorf("destinationFingerprint() = %q, want empty for ambient credentials", got)
+	}
+}
+
+func TestValidateAWSCredentialsSource(t *testing.T) {
+	newDest := func(values map[string]attr.Value) types.Object {
+		attrs := make(map[string]attr.Value, len(destAttrTypes))
+		for name, attrType := range destAttrTypes {
+			attrs[name] = nullValue(t, attrType)
+		}
+		attrs["type"] = types.StringValue("sqs")
+		for name, value := range values {
+			attrs[name] = value
+		}
+		return types.ObjectValueMust(destAttrTypes, attrs)
+	}
+
+	var diags diag.Diagnostics
+	validateAWSCredentialsSource(newDest(map[string]attr.Value{
+		"aws_credentials_source": types.StringValue("environment"),
+		"region":                 types.StringValue("us-east-1"),
+	}), path.Root("destination"), &diags)
+	if diags.HasError() {
+		t.Errorf("environment credentials without keys should be valid: %v", diags)
+	}
+
+	validateAWSCredentialsSource(newDest(map[string]attr.Value{
+		"aws_credentials_source": types.StringValue("static"),
+		"access_key_id":          types.StringValue("AKIA"),
+		"secret_access_key":      types.StringValue("secret"),
+	}), path.Root("destination"), &diags)
+	if diags.HasError() {
+		t.Errorf("static credentials with keys should be valid: %v", diags)
+	}
+
+	validateAWSCredentialsSource(newDest(map[string]attr.Value{
+		"aws_credentials_source": types.StringValue("instance_profile"),
+		"access_key_id":          types.StringValue("AKIA"),
+		"secret_access_key":      types.StringValue("secret"),
+	}), path.Root("destination"), &diags)
+	if diags.ErrorsCount() != 2 {
+		t.Errorf("errors = %d, want one per static key with instance_profile", diags.ErrorsCount())
+	}
+}
+
+func TestWebhookBatchSettings(t *testing.T) {
+	newDest := func(values map[string]attr.Value) types.Object {
+		attrs := make(map[string]attr.Value, len(destAttrTypes))
+		for name, attrType := range destAttrTypes {
+			attrs[name] = nullValue(t, attrType)
+		}
+		attrs["type"] = types.StringValue("webhook")
+		for name, value := range values {
+			attrs[name] = value
+		}
+		return types.ObjectValueMust(destAttrTypes, attrs)
+	}
+
+	var diags diag.Diagnostics
+	validateWebhookBatching(newDest(map[string]attr.Value{
+		"batch":           types.BoolValue(true),
+		"batch_format":    types.StringValue("ndjson"),
+		"batch_max_bytes": types.Int64Value(1048576),
+	}), path.Root("destination"), &diags)
+	if diags.HasError() {
+		t.Errorf("batch settings with batch = true should be valid: %v", diags)
+	}
+
+	validateWebhookBatching(newDest(map[string]attr.Value{
+		"batch_format": types.StringValue("ndjson"),
+	}), path.Root("destination"), &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("errors = %d, want one for batch_format without batching", diags.ErrorsCount())
+	}
+
+	// The json_array default reported by the API is not drift
+	batch := true
+	apiDest := client.SinkConsumerDestination{Type: "webhook", Batch: &batch, BatchFormat: "json_array"}
+	obj := mapDestinationToObject(apiDest, types.ObjectNull(destAttrTypes), &diags)
+	if !obj.Attributes()["batch_format"].IsNull() {
+		t.Error("batch_format should stay null for the unconfigured json_array default")
+	}
+	obj = mapDestinationToObject(apiDest, newDest(map[string]attr.Value{"batch_format": types.StringValue("json_array")}), &diags)
+	if got := obj.Attributes()["batch_format"].(types.String).ValueString(); got != "json_array" {
+		t.Errorf("batch_format = %q, want configured json_array kept", got)
+	}
+}
+
+func TestValidateRedisOptions(t *testing.T) {
+	newDest := func(destType string, values map[string]attr.Value) types.Object {
+		attrs := make(map[string]attr.Value, len(destAttrTypes))
+		for name, attrType := range destAttrTypes {
+			attrs[name] = nullValue(t, attrType)
+		}
+		attrs["type"] = types.StringValue(destType)
+		for name, value := range values {
+			attrs[name] = value
+		}
+		return types.ObjectValueMust(destAttrTypes, attrs)
+	}
+
+	for name, tt := range map[string]struct {
+		destType string
+		values   map[string]attr.Value
+		errors   int
+	}{
+		"stream trimming": {"redis_stream", map[string]attr.Value{
+			"database_index": types.Int64Value(2),
+			"stream_key":     types.StringValue("orders"),
+			"max_len":        types.Int64Value(100000),
+		}, 0},
+		"string ttl": {"redis_string", map[string]attr.Value{
+			"database_index": types.Int64Value(2),
+			"ttl_seconds":    types.Int64Value(3600),
+		}, 0},
+		"ttl on stream":     {"redis_stream", map[string]attr.Value{"ttl_seconds": types.Int64Value(3600)}, 1},
+		"max_len on string": {"redis_string", map[string]attr.Value{"max_len": types.Int64Value(1000)}, 1},
+		"database on kafka": {"kafka", map[string]attr.Value{"database_index": types.Int64Value(1)}, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateRedisOptions(newDest(tt.destType, tt.values), path.Root("destination"), &diags)
+			if diags.ErrorsCount() != tt.errors {
+				t.Errorf("errors = %d, want %d: %v", diags.ErrorsCount(), tt.errors, diags)
+			}
+		})
+	}
+}
+
+func TestValidateElasticsearchOptions(t *testing.T) {
+	newDest := func(values map[string]attr.Value) types.Object {
+		attrs := make(map[string]attr.Value, len(destAttrTypes))
+		for name, attrType := range destAttrTypes {
+			attrs[name] = nullValue(t, attrType)
+		}
+		attrs["type"] = types.StringValue("elasticsearch")
+		for name, value := range values {
+			attrs[name] = value
+		}
+		return types.ObjectValueMust(destAttrTypes, attrs)
+	}
+
+	for name, tt := range map[string]struct {
+		values map[string]attr.Value
+		errors int
+	}{
+		"api key": {map[string]attr.Value{
+			"auth_type": types.StringValue("api_key"),
+			"api_key":   types.StringValue("key"),
+		}, 0},
+		"basic": {map[string]attr.Value{
+			"auth_type": types.StringValue("basic"),
+			"username":  types.StringValue("elastic"),
+			"password":  types.StringValue("secret"),
+		}, 0},
+		"api key with basic": {map[string]attr.Value{
+			"auth_type": types.StringValue("basic"),
+			"password":  types.StringValue("secret"),
+			"api_key":   types.StringValue("key"),
+		}, 1},
+		"password with api key auth": {map[string]attr.Value{
+			"auth_type": types.StringValue("api_key"),
+			"api_key":   types.StringValue("key"),
+			"password":  types.StringValue("secret"),
+		}, 1},
+		"credentials without auth type": {map[string]attr.Value{"api_key": types.StringValue("key")}, 1},
+		"api key auth without key": {map[string]attr.Value{
+			"auth_type": types.StringValue("api_key"),
+		}, 1},
+		"basic without password": {map[string]attr.Value{
+			"auth_type": types.StringValue("basic"),
+			"username":  types.StringValue("elastic"),
+		}, 1},
+		"api key auth from credential_ref": {map[string]attr.Value{
+			"auth_type":      types.StringValue("api_key"),
+			"credential_ref": types.StringValue("elastic"),
+		}, 0},
+		"credential_ref without auth type": {map[string]attr.Value{"credential_ref": types.StringValue("elastic")}, 1},
+		"unknown api key": {map[string]attr.Value{
+			"auth_type": types.StringValue("api_key"),
+			"api_key":   types.StringUnknown(),
+		}, 0},
+		"index settings": {map[string]attr.Value{
+			"auto_create_index": types.BoolValue(true),
+			"index_settings":    types.StringValue(`{"settings": {"number_of_shards": 1}}`),
+		}, 0},
+		"index settings without creation": {map[string]attr.Value{
+			"index_settings": types.StringValue(`{"settings": {"number_of_shards": 1}}`),
+		}, 1},
+		"invalid index settings": {map[string]attr.Value{
+			"auto_create_index": types.BoolValue(true),
+			"index_settings":    types.StringValue(`{"settings":`),
+		}, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateElasticsearchOptions(newDest(tt.values), path.Root("destination"), &diags)
+			if diags.ErrorsCount() != tt.errors {
+				t.Errorf("errors = %d, want %d: %v", diags.ErrorsCount(), tt.errors, diags)
+			}
+		})
+	}
+
+	// Reformatted settings JSON from the API is not drift
+	var diags diag.Diagnostics
+	autoCreate := true
+	apiDest := client.SinkConsumerDestination{
+		Type:            "elasticsearch",
+		AutoCreateIndex: &autoCreate,
+		IndexSettings:   `{"settings":{"number_of_shards":1}}`,
+	}
+	configured := types.StringValue(`{"settings": {"number_of_shards": 1}}`)
+	obj := mapDestinationToObject(apiDest, newDest(map[string]attr.Value{"index_settings": configured}), &diags)
+	if got := obj.Attributes()["index_settings"]; !got.Equal(configured) {
+		t.Errorf("index_settings = %s, want the configured formatting kept", got)
+	}
+}
+
+func TestValidateDestinationAuth(t *testing.T) {
+	newDest := func(destType string, values map[string]attr.Value) types.Object {
+		attrs := make(map[string]attr.Value, len(destAttrTypes))
+		for name, attrType := range destAttrTypes {
+			attrs[name] = nullValue(t, attrType)
+		}
+		attrs["type"] = types.StringValue(destType)
+		for name, value := range values {
+			attrs[name] = value
+		}
+		return types.ObjectValueMust(destAttrTypes, attrs)
+	}
+
+	for name, tt := range map[string]struct {
+		destType string
+		values   map[string]attr.Value
+		errors   int
+	}{
+		"pubsub service account key": {"gcp_pubsub", map[string]attr.Value{"gcp_credentials": types.StringValue(`{"type": "service_account"}`)}, 0},
+		"pubsub workload identity":   {"gcp_pubsub", map[string]attr.Value{"use_workload_identity": types.BoolValue(true)}, 0},
+		"pubsub credential_ref":      {"gcp_pubsub", map[string]attr.Value{"credential_ref": types.StringValue("pubsub")}, 0},
+		"pubsub none":                {"gcp_pubsub", map[string]attr.Value{"use_workload_identity": types.BoolValue(false)}, 1},
+		"pubsub both": {"gcp_pubsub", map[string]attr.Value{
+			"gcp_credentials":       types.StringValue(`{"type": "service_account"}`),
+			"use_workload_identity": types.BoolValue(true),
+		}, 1},
+		"pubsub unknown key": {"gcp_pubsub", map[string]attr.Value{"gcp_credentials": types.StringUnknown()}, 0},
+		"event hub shared access key": {"azure_event_hub", map[string]attr.Value{
+			"shared_access_key_name": types.StringValue("RootManageSharedAccessKey"),
+			"shared_access_key":      types.StringValue("key"),
+		}, 0},
+		"event hub managed identity": {"azure_event_hub", map[string]attr.Value{
+			"managed_identity_client_id": types.StringValue("00000000-0000-0000-0000-000000000000"),
+		}, 0},
+		"event hub none": {"azure_event_hub", nil, 1},
+		"event hub both": {"azure_event_hub", map[string]attr.Value{
+			"shared_access_key":          types.StringValue("key"),
+			"managed_identity_client_id": types.StringValue("00000000-0000-0000-0000-000000000000"),
+		}, 1},
+		"nats without auth": {"nats", nil, 0},
+		"nats creds":        {"nats", map[string]attr.Value{"creds": types.StringValue("-----BEGIN NATS USER JWT-----")}, 0},
+		"nats jwt": {"nats", map[string]attr.Value{
+			"jwt":       types.StringValue("eyJ0"),
+			"nkey_seed": types.StringValue("SUAM"),
+		}, 0},
+		"nats creds and password": {"nats", map[string]attr.Value{
+			"creds":    types.StringValue("-----BEGIN NATS USER JWT-----"),
+			"password": types.StringValue("secret"),
+		}, 1},
+		"typesense api key":       {"typesense", map[string]attr.Value{"api_key": types.StringValue("key")}, 0},
+		"meilisearch without key": {"meilisearch", nil, 1},
+		"typesense key and credential_ref": {"typesense", map[string]attr.Value{
+			"api_key":        types.StringValue("key"),
+			"credential_ref": types.StringValue("typesense"),
+		}, 1},
+		"elasticsearch without auth": {"elasticsearch", nil, 0},
+		"elasticsearch api key and credential_ref": {"elasticsearch", map[string]attr.Value{
+			"api_key":        types.StringValue("key"),
+			"credential_ref": types.StringValue("elastic"),
+		}, 1},
+		"elasticsearch api key and password": {"elasticsearch", map[string]attr.Value{
+			"api_key":  types.StringValue("key"),
+			"password": types.StringValue("secret"),
+		}, 1},
+		"sqs not checked": {"sqs", nil, 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateDestinationAuth(newDest(tt.destType, tt.values), path.Root("destination"), &diags)
+			if diags.ErrorsCount() != tt.errors {
+				t.Errorf("errors = %d, want %d: %v", diags.ErrorsCount(), tt.errors, diags)
+			}
+		})
+	}
+}
+
+func TestKafkaPartitionKeyExpression(t *testing.T) {
+	newDest := func(destType string, expression string) types.Object {
+		attrs := make(map[string]attr.Value, len(destAttrTypes))
+		for name, attrType := range destAttrTypes {
+			attrs[name] = nullValue(t, attrType)
+		}
+		attrs["type"] = types.StringValue(destType)
+		attrs["partition_key_expression"] = types.StringValue(expression)
+		return types.ObjectValueMust(destAttrTypes, attrs)
+	}
+
+	var diags diag.Diagnostics
+	validateKafkaPartitionKey(newDest("kafka", "tenant_id"), path.Root("destination"), &diags)
+	if diags.HasError() {
+		t.Errorf("partition key on kafka should be valid: %v", diags)
+	}
+	validateKafkaPartitionKey(newDest("sqs", "tenant_id"), path.Root("destination"), &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("errors = %d, want one for a partition key on sqs", diags.ErrorsCount())
+	}
+
+	for expression, want := range map[string]bool{
+		"tenant_id":                           true,
+		"tenant_id, order_id":                 true,
+		"{{record.tenant_id}}-{{table_name}}": true,
+		"tenant-id":                           false,
+		"{{}}":                                false,
+		"":                                    false,
+	} {
+		got := partitionKeyColumnsPattern.MatchString(expression) || partitionKeyTemplatePattern.MatchString(expression)
+		if got != want {
+			t.Errorf("partition key %q valid = %v, want %v", expression, got, want)
+		}
+	}
+}
+
+func TestFailoverDestination(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	if req := buildFailoverDestinationRequest(ctx, types.ObjectNull(failoverDestinationAttrTypes), &diags); req != nil {
+		t.Errorf("failover = %+v, want nil so the request removes it", req)
+	}
+	body, _ := json.Marshal(&client.SinkConsumerRequest{})
+	if !strings.Contains(string(body), `"failover_destination":null`) {
+		t.Errorf("request = %s, want an explicit null failover_destination", body)
+	}
+
+	attrs := make(map[string]attr.Value, len(failoverDestinationAttrTypes))
+	for name, attrType := range failoverDestinationAttrTypes {
+		if listType, ok := attrType.(types.ListType); ok {
+			attrs[name] = types.ListNull(listType.ElemType)
+			continue
+		}
+		attrs[name] = nullValue(t, attrType)
+	}
+	attrs["type"] = types.StringValue("sqs")
+	attrs["queue_url"] = types.StringValue("https://sqs.us-west-2.amazonaws.com/123456789012/orders")
+	attrs["secret_access_key"] = types.StringValue("secret")
+	attrs["unavailable_for_seconds"] = types.Int64Value(120)
+	failover := types.ObjectValueMust(failoverDestinationAttrTypes, attrs)
+
+	req := buildFailoverDestinationRequest(ctx, failover, &diags)
+	if req == nil || req.Type != "sqs" || req.QueueURL == "" || *req.UnavailableForSeconds != 120 {
+		t.Fatalf("failover = %+v, want sqs queue with 120s threshold", req)
+	}
+	if req.Conditions != nil || req.FailBack != nil {
+		t.Error("unset conditions and fail_back should be omitted")
+	}
+
+	// Secrets are kept from state and defaults stay null
+	failBack := true
+	apiFailover := &client.SinkConsumerFailoverDestination{
+		SinkConsumerDestination: client.SinkConsumerDestination{Type: "sqs", QueueURL: req.QueueURL},
+		UnavailableForSeconds:   req.UnavailableForSeconds,
+		Conditions:              []string{"timeout", "connection_error"},
+		FailBack:                &failBack,
+	}
+	obj := mapFailoverDestinationToObject(ctx, apiFailover, failover, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !obj.Equal(failover) {
+		t.Errorf("failover = %s, want %s", obj, failover)
+	}
+
+	validateFailoverDestination(failover, &diags)
+	if diags.HasError() {
+		t.Errorf("valid failover reported errors: %v", diags)
+	}
+	attrs["aws_credentials_source"] = types.StringValue("instance_profile")
+	validateFailoverDestination(types.ObjectValueMust(failoverDestinationAttrTypes, attrs), &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("errors = %d, want one for a static key with instance_profile", diags.ErrorsCount())
+	}
+	if p := diags.Errors()[0].(diag.DiagnosticWithPath).Path(); !p.Equal(path.Root("failover_destination").AtName("secret_access_key")) {
+		t.Errorf("error path = %s, want failover_destination.secret_access_key", p)
+	}
+}
+
+func TestApplyCursorReset(t *testing.T) {
+	var resets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/sinks/sink-1/cursor" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		resets = append(resets, r.URL.Path)
+		w.Write([]byte(`{"lsn": "0/16B3748"}`))
+	}))
+	defer server.Close()
+
+	r := &SinkConsumerResource{client: client.New(server.URL, "key", "1.0.0")}
+	ctx := context.Background()
+	lsn := types.StringValue("0/16B3748")
+
+	var diags diag.Diagnostics
+	if got := r.applyCursorReset(ctx, "sink-1", lsn, types.StringNull(), &diags); !got.Equal(lsn) || diags.HasError() {
+		t.Fatalf("applyCursorReset() = %s, %v; want planned LSN", got, diags)
+	}
+	if len(resets) != 1 {
+		t.Fatalf("resets = %d, want 1 for a newly set LSN", len(resets))
+	}
+
+	// Unchanged or removed values leave the cursor alone
+	r.applyCursorReset(ctx, "sink-1", lsn, lsn, &diags)
+	r.applyCursorReset(ctx, "sink-1", types.StringNull(), lsn, &diags)
+	if len(resets) != 1 {
+		t.Errorf("resets = %d, want no further resets", len(resets))
+	}
+}
+
+func TestApplyCursorReset_FailureKeepsPriorValue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error": "lsn is ahead of the replication slot"}`))
+	}))
+	defer server.Close()
+
+	r := &SinkConsumerResource{client: client.New(server.URL, "key", "1.0.0")}
+	prior := types.StringValue("0/100")
+
+	var diags diag.Diagnostics
+	got := r.applyCursorReset(context.Background(), "sink-1", types.StringValue("FF/0"), prior, &diags)
+	if !diags.HasError() {
+		t.Fatal("a failed reset should be reported")
+	}
+	if !got.Equal(prior) {
+		t.Errorf("applyCursorReset() = %s, want prior value %s so the reset is retried", got, prior)
+	}
+}
+
+// --- message_shape tests ---
+
+func TestBuildMessageShapeRequest_NullResetsShape(t *testing.T) {
+	var diags diag.Diagnostics
+	shape := buildMessageShapeRequest(context.Background(), types.ObjectNull(messageShapeAttrTypes), &diags)
+	if shape == nil {
+		t.Fatal("unset message_shape should send an empty shape so removing it resets the sink")
+	}
+	body, _ := json.Marshal(shape)
+	if string(body) != "{}" {
+		t.Errorf("message_shape = %s, want {}", body)
+	}
+}
+
+func TestMapMessageShapeToObject_DefaultsStayNull(t *testing.T) {
+	var diags diag.Diagnostics
+	includeOldValues := true
+	obj := mapMessageShapeToObject(context.Background(), &client.SinkConsumerMessageShape{IncludeOldValues: &includeOldValues}, types.ObjectNull(messageShapeAttrTypes), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !obj.IsNull() {
+		t.Errorf("message_shape = %s, want null when the API reports defaults", obj)
+	}
+}
+
+// --- blue_green tests ---
+
+func TestBlueGreenSwapRequired(t *testing.T) {
+	newDest := func(values map[string]attr.Value) types.Object {
+		attrs := make(map[string]attr.Value, len(destAttrTypes))
+		for name, attrType := range destAttrTypes {
+			attrs[name] = nullValue(t, attrType)
+		}
+		attrs["type"] = types.StringValue("kafka")
+		attrs["hosts"] = types.StringValue("kafka:9092")
+		attrs["topic"] = types.StringValue("orders")
+		for name, value := range values {
+			attrs[name] = value
+		}
+		return types.ObjectValueMust(destAttrTypes, attrs)
+	}
+	blueGreen := types.ObjectValueMust(blueGreenAttrTypes, map[string]attr.Value{
+		"health_timeout_seconds": types.Int64Null(),
+		"backfill":               types.BoolNull(),
+	})
+	state := &SinkConsumerResourceModel{
+		Database:    types.StringValue("orders-db"),
+		DatabaseID:  types.StringValue("db-1"),
+		Destination: newDest(nil),
+		BlueGreen:   blueGreen,
+	}
+
+	tests := []struct {
+		name   string
+		modify func(plan *SinkConsumerResourceModel)
+		want   bool
+	}{
+		{"unchanged", func(plan *SinkConsumerResourceModel) {}, false},
+		{"credentials change in place", func(plan *SinkConsumerResourceModel) {
+			plan.Destination = newDest(map[string]attr.Value{"password": types.StringValue("rotated")})
+		}, false},
+		{"topic change", func(plan *SinkConsumerResourceModel) {
+			plan.Destination = newDest(map[string]attr.Value{"topic": types.StringValue("orders-v2")})
+		}, true},
+		{"unknown endpoint reference", func(plan *SinkConsumerResourceModel) {
+			plan.Destination = newDest(map[string]attr.Value{"http_endpoint_id": types.StringUnknown()})
+		}, true},
+		{"database change", func(plan *SinkConsumerResourceModel) {
+			plan.Database = types.StringValue("orders-replica")
+			plan.DatabaseID = types.StringValue("db-2")
+		}, true},
+		{"database name to ID", func(plan *SinkConsumerResourceModel) {
+			plan.Database = types.StringValue("db-1")
+		}, false},
+		{"topic change without blue_green", func(plan *SinkConsumerResourceModel) {
+			plan.Destination = newDest(map[string]attr.Value{"topic": types.StringValue("orders-v2")})
+			plan.BlueGreen = types.ObjectNull(blueGreenAttrTypes)
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := *state
+			tt.modify(&plan)
+			if got := blueGreenSwapRequired(state, &plan); got != tt.want {
+				t.Errorf("blueGreenSwapRequired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceBlueGreen(t *testing.T) {
+	defer func(interval time.Duration) { blueGreenPollInterval = interval }(blueGreenPollInterval)
+	blueGreenPollInterval = time.Millisecond
+
+	var calls []string
+	polls, backfillPolls := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/sinks/sink-new/backfills/backfill-1":
+			backfillPolls++
+			state := "active"
+			if backfillPolls > 1 {
+				state = "completed"
+			}
+			w.Write([]byte(`{"id": "backfill-1", "table": "public.orders", "state": "` + state + `"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/sinks":
+			var req client.SinkConsumerRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Name != "orders"+blueGreenNameSuffix {
+				t.Errorf("replacement name = %q, want a temporary name", req.Name)
+			}
+			w.Write([]byte(`{"id": "sink-new", "name": "orders-green", "status_info": {"state": "pending"}}`))
+		case r.Method == http.MethodGet:
+			polls++
+			state := "pending"
+			if polls > 1 {
+				state = "active"
+			}
+			w.Write([]byte(`{"id": "sink-new", "name": "orders-green", "status_info": {"state": "` + state + `"}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/sinks/sink-new/backfills":
+			w.Write([]byte(`{"id": "backfill-1", "state": "active"}`))
+		case r.Method == http.MethodDelete:
+			w.Write([]byte(`{"id": "sink-old", "deleted": true}`))
+		case r.Method == http.MethodPut:
+			w.Write([]byte(`{"id": "sink-new", "name": "orders", "status_info": {"state": "active"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	r := &SinkConsumerResource{client: client.New(server.URL, "key", "1.0.0")}
+	plan := &SinkConsumerResourceModel{
+		Status: types.StringValue("active"),
+		BlueGreen: types.ObjectValueMust(blueGreenAttrTypes, map[string]attr.Value{
+			"health_timeout_seconds": types.Int64Value(10),
+			"backfill":               types.BoolValue(true),
+		}),
+	}
+	apiReq := &client.SinkConsumerRequest{Name: "orders", Tables: []client.SinkConsumerTable{{Name: "public.orders"}}}
+
+	var diags diag.Diagnostics
+	got := r.replaceBlueGreen(context.Background(), "sink-old", plan, apiReq, &diags)
+	if diags.HasError() {
+		t.Fatalf("replaceBlueGreen() diagnostics: %v", diags)
+	}
+	if got == nil || got.ID != "sink-new" || got.Name != "orders" {
+		t.Fatalf("replaceBlueGreen() = %+v, want the renamed replacement", got)
+	}
+
+	want := []string{
+		"POST /api/sinks",
+		"GET /api/sinks/sink-new",
+		"GET /api/sinks/sink-new",
+		"POST /api/sinks/sink-new/backfills",
+		"GET /api/sinks/sink-new/backfills/backfill-1",
+		"GET /api/sinks/sink-new/backfills/backfill-1",
+		"DELETE /api/sinks/sink-old",
+		"PUT /api/sinks/sink-new",
+	}
+	if strings.Join(calls, "\n") != strings.Join(want, "\n") {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestReplaceBlueGreen_FailedReplacementKeepsOldSink(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.Write([]byte(`{"id": "sink-new", "name": "orders-green", "status_info": {"state": "pending"}}`))
+		case http.MethodGet:
+			w.Write([]byte(`{"id": "sink-new", "status_info": {"state": "failed", "last_error": "broker unreachable"}}`))
+		case http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			w.Write([]byte(`{"id": "sink-new", "deleted": true}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	r := &SinkConsumerResource{client: client.New(server.URL, "key", "1.0.0")}
+	plan := &SinkConsumerResourceModel{
+		Status:    types.StringNull(),
+		BlueGreen: types.ObjectValueMust(blueGreenAttrTypes, map[string]attr.Value{"health_timeout_seconds": types.Int64Null(), "backfill": types.BoolNull()}),
+	}
+
+	var diags diag.Diagnostics
+	got := r.replaceBlueGreen(context.Background(), "sink-old", plan, &client.SinkConsumerRequest{Name: "orders"}, &diags)
+	if got != nil {
+		t.Errorf("replaceBlueGreen() = %+v, want nil when the replacement fails", got)
+	}
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "broker unreachable") {
+		t.Errorf("diagnostics = %v, want the replacement's last error", diags)
+	}
+	if len(deleted) != 1 || deleted[0] != "/api/sinks/sink-new" {
+		t.Errorf("deleted = %v, want only the replacement", deleted)
+	}
+}
+
+func TestReplaceBlueGreen_IncompleteBackfillKeepsOldSink(t *testing.T) {
+	defer func(interval time.Duration) { blueGreenPollInterval = interval }(blueGreenPollInterval)
+	blueGreenPollInterval = time.Millisecond
+
+	var deleted []string
+	mock := &clienttest.Mock{
+		CreateSinkConsumerFunc: func(ctx context.Context, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+			return &client.SinkConsumerResponse{ID: "sink-new", Name: req.Name}, nil
+		},
+		GetSinkConsumerFunc: func(ctx context.Context, id string) (*client.SinkConsumerResponse, error) {
+			return &client.SinkConsumerResponse{ID: id, StatusInfo: client.StatusResponse{State: "active"}}, nil
+		},
+		CreateBackfillFunc: func(ctx context.Context, sinkIDOrName string, req *client.BackfillCreateRequest) (*client.BackfillResponse, error) {
+			return &client.BackfillResponse{ID: "backfill-1", Table: req.Table, State: client.BackfillStateActive}, nil
+		},
+		GetBackfillFunc: func(ctx context.Context, sinkIDOrName, backfillID string) (*client.BackfillResponse, error) {
+			return &client.BackfillResponse{ID: backfillID, Table: "public.orders", State: client.BackfillStateActive, RowsInitialCount: 1000, RowsProcessedCount: 200}, nil
+		},
+		DeleteSinkConsumerFunc: func(ctx context.Context, id string) error {
+			deleted = append(deleted, id)
+			return nil
+		},
+	}
+	r := &SinkConsumerResource{client: mock}
+	plan := &SinkConsumerResourceModel{
+		Status: types.StringValue("active"),
+		BlueGreen: types.ObjectValueMust(blueGreenAttrTypes, map[string]attr.Value{
+			"health_timeout_seconds": types.Int64Value(0),
+			"backfill":               types.BoolValue(true),
+		}),
+	}
+	apiReq := &client.SinkConsumerRequest{Name: "orders", Tables: []client.SinkConsumerTable{{Name: "public.orders"}}}
+
+	var diags diag.Diagnostics
+	if got := r.replaceBlueGreen(context.Background(), "sink-old", plan, apiReq, &diags); got != nil {
+		t.Errorf("replaceBlueGreen() = %+v, want nil while the backfill is incomplete", got)
+	}
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "public.orders not complete within the health timeout (200 of 1000 rows processed)") {
+		t.Errorf("diagnostics = %v, want the incomplete backfill reported", diags)
+	}
+	if len(deleted) != 1 || deleted[0] != "sink-new" {
+		t.Errorf("deleted = %v, want only the replacement", deleted)
+	}
+}
+
+// --- credential rotation tests ---
+
+func TestCredentialRotationOnly(t *testing.T) {
+	newDest := func(values map[string]attr.Value) types.Object {
+		attrs := make(map[string]attr.Value, len(destAttrTypes))
+		for name, attrType := range destAttrTypes {
+			attrs[name] = nullValue(t, attrType)
+		}
+		attrs["type"] = types.StringValue("kafka")
+		attrs["hosts"] = types.StringValue("kafka:9092")
+		attrs["topic"] = types.StringValue("orders")
+		attrs["username"] = types.StringValue("sequin")
+		attrs["password"] = types.StringValue("old")
+		for name, value := range values {
+			attrs[name] = value
+		}
+		return types.ObjectValueMust(destAttrTypes, attrs)
+	}
+	state := &SinkConsumerResourceModel{
+		Name:         types.StringValue("orders"),
+		Status:       types.StringValue("active"),
+		Database:     types.StringValue("orders-db"),
+		Tables:       types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "group_column_names": types.ListType{ElemType: types.StringType}}}),
+		Destination:  newDest(nil),
+		BatchSize:    types.Int64Value(10),
+		MessageShape: types.ObjectNull(messageShapeAttrTypes),
+	}
+
+	tests := []struct {
+		name   string
+		modify func(plan *SinkConsumerResourceModel)
+		want   bool
+	}{
+		{"unchanged", func(plan *SinkConsumerResourceModel) {}, false},
+		{"new password", func(plan *SinkConsumerResourceModel) {
+			plan.Destination = newDest(map[string]attr.Value{"password": types.StringValue("new")})
+		}, true},
+		{"new user and password", func(plan *SinkConsumerResourceModel) {
+			plan.Destination = newDest(map[string]attr.Value{"username": types.StringValue("sequin-2"), "password": types.StringValue("new")})
+		}, true},
+		{"password and topic", func(plan *SinkConsumerResourceModel) {
+			plan.Destination = newDest(map[string]attr.Value{"password": types.StringValue("new"), "topic": types.StringValue("orders-v2")})
+		}, false},
+		{"password and batch size", func(plan *SinkConsumerResourceModel) {
+			plan.Destination = newDest(map[string]attr.Value{"password": types.StringValue("new")})
+			plan.BatchSize = types.Int64Value(50)
+		}, false},
+		{"password and status", func(plan *SinkConsumerResourceModel) {
+			plan.Destination = newDest(map[string]attr.Value{"password": types.StringValue("new")})
+			plan.Status = types.StringValue("paused")
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := *state
+			tt.modify(&plan)
+			if got := credentialRotationOnly(context.Background(), state, &plan); got != tt.want {
+				t.Errorf("credentialRotationOnly() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCredentialRotationOnly_EverySecret(t *testing.T) {
+	tests := map[string]struct {
+		destType string
+		base     map[string]string
+		secret   string
+	}{
+		"gcp_pubsub gcp_credentials":        {client.DestPubSub, map[string]string{"project_id": "p", "topic_id": "t"}, "gcp_credentials"},
+		"azure_event_hub shared_access_key": {client.DestEventHub, map[string]string{"namespace": "n", "event_hub_name": "h", "shared_access_key_name": "k"}, "shared_access_key"},
+		"nats creds":                        {client.DestNATS, map[string]string{"hosts": "nats:4222"}, "creds"},
+		"nats nkey_seed":                    {client.DestNATS, map[string]string{"hosts": "nats:4222"}, "nkey_seed"},
+		"nats jwt":                          {client.DestNATS, map[string]string{"hosts": "nats:4222", "nkey_seed": "seed"}, "jwt"},
+		"elasticsearch api_key":             {client.DestElasticsearch, map[string]string{"endpoint_url": "https://es", "index_name": "i", "auth_type": "api_key"}, "api_key"},
+		"typesense api_key":                 {client.DestTypesense, map[string]string{"endpoint_url": "https://ts", "collection_name": "c"}, "api_key"},
+		"meilisearch api_key":               {client.DestMeilisearch, map[string]string{"endpoint_url": "https://ms", "index_name": "i"}, "api_key"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			newDest := func(secret string) types.Object {
+				attrs := make(map[string]attr.Value, len(destAttrTypes))
+				for name, attrType := range destAttrTypes {
+					attrs[name] = nullValue(t, attrType)
+				}
+				attrs["type"] = types.StringValue(tt.destType)
+				for name, value := range tt.base {
+					attrs[name] = types.StringValue(value)
+				}
+				attrs[tt.secret] = types.StringValue(secret)
+				return types.ObjectValueMust(destAttrTypes, attrs)
+			}
+			state := &SinkConsumerResourceModel{
+				Name:         types.StringValue("orders"),
+				Status:       types.StringValue("active"),
+				Database:     types.StringValue("orders-db"),
+				Tables:       types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "group_column_names": types.ListType{ElemType: types.StringType}}}),
+				Destination:  newDest("old"),
+				BatchSize:    types.Int64Value(10),
+				MessageShape: types.ObjectNull(messageShapeAttrTypes),
+			}
+			plan := *state
+			plan.Destination = newDest("new")
+			if !credentialRotationOnly(context.Background(), state, &plan) {
+				t.Errorf("rotating %s should be verified as a credential rotation", tt.secret)
+			}
+		})
+	}
+}
+
+func TestWithoutCredentials_CoversEveryCredentialAttribute(t *testing.T) {
+	fields := map[string]bool{}
+	destType := reflect.TypeOf(client.SinkConsumerDestination{})
+	for i := 0; i < destType.NumField(); i++ {
+		fields[jsonFieldName(destType.Field(i))] = true
+	}
+	for _, name := range destinationCredentialAttributes {
+		if !fields[name] {
+			t.Errorf("credential attribute %s has no destination field, so withoutCredentials cannot clear it", name)
+		}
+	}
+}
+
+func TestPreviousCredentialsKnown(t *testing.T) {
+	dest := types.ObjectValueMust(map[string]attr.Type{"password": types.StringType}, map[string]attr.Value{"password": types.StringValue("old")})
+
+	privateState := &resourcePrivateState{}
+	privateState.recordSecrets(map[string]string{"password": "old"}, "fp-1")
+	if !previousCredentialsKnown(privateState, dest) {
+		t.Error("the applied password is in state and should be restorable")
+	}
+
+	cleared := types.ObjectValueMust(map[string]attr.Type{"password": types.StringType}, map[string]attr.Value{"password": types.StringNull()})
+	if previousCredentialsKnown(privateState, cleared) {
+		t.Error("a password cleared by drift detection cannot be restored")
+	}
+}
+
+func TestVerifyCredentialRotation(t *testing.T) {
+	defer func(interval time.Duration) { credentialVerifyInterval = interval }(credentialVerifyInterval)
+	credentialVerifyInterval = time.Millisecond
+
+	failing := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/sinks/sink-1":
+			w.Write([]byte(`{"id": "sink-1", "status_info": {"state": "active"}}`))
+		case "/api/sinks/sink-1/metrics":
+			w.Write([]byte(`{"messages_failing_count": ` + strconv.Itoa(failing) + `}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	r := &SinkConsumerResource{client: client.New(server.URL, "key", "1.0.0")}
+	ctx := context.Background()
+	baseline := int64(2)
+
+	failing = 2
+	if err := r.verifyCredentialRotation(ctx, "sink-1", 5*time.Millisecond, &baseline); err != nil {
+		t.Errorf("verifyCredentialRotation() error = %v, want success while failures stay flat", err)
+	}
+
+	failing = 7
+	err := r.verifyCredentialRotation(ctx, "sink-1", 5*time.Millisecond, &baseline)
+	if err == nil || !strings.Contains(err.Error(), "7 messages failing") {
+		t.Errorf("verifyCredentialRotation() error = %v, want rising failures reported", err)
+	}
+}
+
+func TestVerifyCredentialRotation_FailedSink(t *testing.T) {
+	defer func(interval time.Duration) { credentialVerifyInterval = interval }(credentialVerifyInterval)
+	credentialVerifyInterval = time.Millisecond
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "sink-1", "status_info": {"state": "failed", "last_error": "SASL authentication failed"}}`))
+	}))
+	defer server.Close()
+
+	r := &SinkConsumerResource{client: client.New(server.URL, "key", "1.0.0")}
+	err := r.verifyCredentialRotation(context.Background(), "sink-1", time.Second, nil)
+	if err == nil || !strings.Contains(err.Error(), "SASL authentication failed") {
+		t.Errorf("verifyCredentialRotation() error = %v, want the sink's last error", err)
+	}
+}
+
+func TestPlanDefaultDatabase(t *testing.T) {
+	ctx := context.Background()
+	c := client.New("http://sequin.invalid", "key", "1.0.0")
+	r := &SinkConsumerResource{client: c}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	run := func(database types.String) *resource.ModifyPlanResponse {
+		model := newRoundTripPlan(t, "webhook", map[string]attr.Value{
+			"http_endpoint": types.StringValue("https://api.example.com"),
+		})
+		model.Database = database
+		state := tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		if diags := state.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("building plan: %v", diags.Errors())
+		}
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw}
+		config := tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		r.planDefaultDatabase(ctx, resource.ModifyPlanRequest{Config: config, Plan: plan}, resp)
+		return resp
+	}
+	planned := func(resp *resource.ModifyPlanResponse) types.String {
+		var database types.String
+		resp.Plan.GetAttribute(ctx, path.Root("database"), &database)
+		return database
+	}
+
+	if resp := run(types.StringNull()); !resp.Diagnostics.HasError() {
+		t.Error("a sink without database should fail without a provider default_database")
+	}
+
+	c.DefaultDatabase = "main"
+	resp := run(types.StringNull())
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("planDefaultDatabase() errors: %v", resp.Diagnostics.Errors())
+	}
+	if got := planned(resp); got.ValueString() != "main" {
+		t.Errorf("planned database = %v, want the default main", got)
+	}
+
+	if got := planned(run(types.StringValue("analytics"))); got.ValueString() != "analytics" {
+		t.Errorf("planned database = %v, want the configured analytics", got)
+	}
+}
+
+// createSinkConsumerWithMock applies a planned webhook sink consumer through
+// Create, with the mock answering like the API
+func createSinkConsumerWithMock(t *testing.T, mock *clienttest.Mock) *resource.CreateResponse {
+	t.Helper()
+	r := &SinkConsumerResource{client: mock}
+	plan := newRoundTripPlan(t, client.DestWebhook, map[string]attr.Value{
+		"http_endpoint": types.StringValue("orders-endpoint"),
+	})
+	planned := newResourceState(t, r, &plan)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: planned.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan:   tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw},
+		Config: tfsdk.Config{Schema: planned.Schema, Raw: planned.Raw},
+	}, resp)
+	return resp
+}
+
+func TestSinkConsumerResource_CreateWithMock(t *testing.T) {
+	var sent *client.SinkConsumerRequest
+	mock := &clienttest.Mock{
+		CreateSinkConsumerFunc: func(ctx context.Context, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+			sent = req
+			return simulateSinkConsumerAPI(t, req), nil
+		},
+	}
+
+	resp := createSinkConsumerWithMock(t, mock)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	if sent == nil || sent.Name != "roundtrip" || sent.Database != "b3f1c2d4-5e6f-4a7b-8c9d-0e1f2a3b4c5d" {
+		t.Errorf("request = %+v, want the planned sink on the planned database", sent)
+	}
+	if sent.Destination.Type != client.DestWebhook || sent.Destination.HTTPEndpoint != "orders-endpoint" {
+		t.Errorf("destination = %+v, want the planned webhook", sent.Destination)
+	}
+
+	var data SinkConsumerResourceModel
+	resp.State.Get(context.Background(), &data)
+	if data.ID.ValueString() != "sink-roundtrip" || data.Status.ValueString() != client.SinkStatusActive {
+		t.Errorf("state = %+v, want the created sink consumer", data)
+	}
+	if calls := mock.Calls(); len(calls) != 1 || calls[0] != "CreateSinkConsumer" {
+		t.Errorf("calls = %v, want a single CreateSinkConsumer", calls)
+	}
+}
+
+func TestSinkConsumerResource_CreateWithMock_DefaultAnnotations(t *testing.T) {
+	var sent *client.SinkConsumerRequest
+	mock := &clienttest.Mock{
+		DefaultAnnotations: map[string]string{"team": "data-eng", "env": "prod"},
+		CreateSinkConsumerFunc: func(ctx context.Context, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+			sent = req
+			return simulateSinkConsumerAPI(t, req), nil
+		},
+	}
+
+	resp := createSinkConsumerWithMock(t, mock)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	if want := map[string]string{"team": "data-eng", "env": "prod"}; !maps.Equal(sent.Annotations, want) {
+		t.Errorf("annotations sent = %v, want the provider defaults %v", sent.Annotations, want)
+	}
+
+	var data SinkConsumerResourceModel
+	resp.State.Get(context.Background(), &data)
+	if !data.Annotations.IsNull() {
+		t.Errorf("annotations = %s, want null since the sink configures none", data.Annotations)
+	}
+	if len(data.AnnotationsAll.Elements()) != 2 {
+		t.Errorf("annotations_all = %s, want the provider defaults", data.AnnotationsAll)
+	}
+}
+
+func TestSinkConsumerResource_CreateWithMock_RollsBackFailedCreate(t *testing.T) {
+	var deleted string
+	mock := &clienttest.Mock{
+		CreateSinkConsumerFunc: func(ctx context.Context, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+			return simulateSinkConsumerAPI(t, req), nil
+		},
+		ResetSinkConsumerCursorFunc: func(ctx context.Context, sinkIDOrName string, req *client.SinkConsumerCursorResetRequest) (*client.SinkConsumerCursor, error) {
+			return nil, &client.APIError{StatusCode: 422, Body: "invalid LSN"}
+		},
+		DeleteSinkConsumerFunc: func(ctx context.Context, id string) error {
+			deleted = id
+			return nil
+		},
+	}
+	r := &SinkConsumerResource{client: mock}
+	plan := newRoundTripPlan(t, client.DestWebhook, map[string]attr.Value{
+		"http_endpoint": types.StringValue("orders-endpoint"),
+	})
+	plan.CursorResetLSN = types.StringValue("0/16B3748")
+	planned := newResourceState(t, r, &plan)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: planned.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan:   tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw},
+		Config: tfsdk.Config{Schema: planned.Schema, Raw: planned.Raw},
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected Create() to fail when the cursor reset fails")
+	}
+	if deleted != "sink-roundtrip" {
+		t.Errorf("deleted %q, want the half-created sink rolled back", deleted)
+	}
+}
+
+func TestSinkConsumerResource_ReadWithMock(t *testing.T) {
+	ctx := context.Background()
+	var created *client.SinkConsumerResponse
+	mock := &clienttest.Mock{
+		CreateSinkConsumerFunc: func(ctx context.Context, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+			created = simulateSinkConsumerAPI(t, req)
+			return created, nil
+		},
+		GetSinkConsumerFunc: func(ctx context.Context, id string) (*client.SinkConsumerResponse, error) {
+			consumer := *created
+			consumer.Status = client.SinkStatusDisabled
+			return &consumer, nil
+		},
+	}
+	state := createSinkConsumerWithMock(t, mock).State
+
+	r := &SinkConsumerResource{client: mock}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	var data SinkConsumerResourceModel
+	resp.State.Get(ctx, &data)
+	if data.Status.ValueString() != client.SinkStatusDisabled {
+		t.Errorf("status = %s, want the drifted status from the API", data.Status)
+	}
+	if calls := mock.Calls(); len(calls) != 2 || calls[1] != "GetSinkConsumer" {
+		t.Errorf("calls = %v, want a single GetSinkConsumer after the create", calls)
+	}
+}
+
+func TestSinkConsumerResource_ReadWithMock_NotFound(t *testing.T) {
+	ctx := context.Background()
+	mock := &clienttest.Mock{
+		CreateSinkConsumerFunc: func(ctx context.Context, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+			return simulateSinkConsumerAPI(t, req), nil
+		},
+		GetSinkConsumerFunc: func(ctx context.Context, id string) (*client.SinkConsumerResponse, error) {
+			return nil, &client.NotFoundError{Resource: "sink consumer", ID: id}
+		},
+	}
+	state := createSinkConsumerWithMock(t, mock).State
+
+	r := &SinkConsumerResource{client: mock}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() errors: %v", resp.Diagnostics.Errors())
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("a sink consumer deleted outside Terraform should be removed from state")
+	}
+}
+
+func TestSinkConsumerResource_UpdateWithMock(t *testing.T) {
+	ctx := context.Background()
+	var updatedID string
+	var sent *client.SinkConsumerRequest
+	mock := &clienttest.Mock{
+		CreateSinkConsumerFunc: func(ctx context.Context, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+			return simulateSinkConsumerAPI(t, req), nil
+		},
+		UpdateSinkConsumerFunc: func(ctx context.Context, id string, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+			updatedID, sent = id, req
+			return simulateSinkConsumerAPI(t, req), nil
+		},
+	}
+	state := createSinkConsumerWithMock(t, mock).State
+
+	var plan SinkConsumerResourceModel
+	state.Get(ctx, &plan)
+	plan.BatchSize = types.Int64Value(50)
+	r := &SinkConsumerResource{client: mock}
+	planned := newResourceState(t, r, &plan)
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{
+		Plan:   tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw},
+		Config: tfsdk.Config{Schema: planned.Schema, Raw: planned.Raw},
+		State:  state,
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	if updatedID != "sink-roundtrip" || sent.BatchSize == nil || *sent.BatchSize != 50 {
+		t.Errorf("updated %q with %+v, want batch size 50", updatedID, sent)
+	}
+	var data SinkConsumerResourceModel
+	resp.State.Get(ctx, &data)
+	if data.BatchSize.ValueInt64() != 50 {
+		t.Errorf("batch_size = %d, want the updated 50", data.BatchSize.ValueInt64())
+	}
+	if calls := mock.Calls(); len(calls) != 2 || calls[1] != "UpdateSinkConsumer" {
+		t.Errorf("calls = %v, want a single UpdateSinkConsumer after the create", calls)
+	}
+}
+
+func TestSinkConsumerResource_DeleteWithMock(t *testing.T) {
+	ctx := context.Background()
+	var deletedID string
+	mock := &clienttest.Mock{
+		CreateSinkConsumerFunc: func(ctx context.Context, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+			return simulateSinkConsumerAPI(t, req), nil
+		},
+		DeleteSinkConsumerFunc: func(ctx context.Context, id string) error {
+			deletedID = id
+			return nil
+		},
+	}
+	state := createSinkConsumerWithMock(t, mock).State
+
+	r := &SinkConsumerResource{client: mock}
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Delete() errors: %v", resp.Diagnostics.Errors())
+	}
+	if deletedID != "sink-roundtrip" {
+		t.Errorf("deleted %q, want sink-roundtrip", deletedID)
+	}
+}
+
+func TestSinkConsumerResource_UpdateWithMock_KeepsEndpointName(t *testing.T) {
+	ctx := context.Background()
+	endpointID := "9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	var sent *client.SinkConsumerRequest
+	mock := &clienttest.Mock{
+		GetHTTPEndpointFunc: func(ctx context.Context, idOrName string) (*client.HTTPEndpointResponse, error) {
+			return &client.HTTPEndpointResponse{ID: endpointID, Name: idOrName}, nil
+		},
+		UpdateSinkConsumerFunc: func(ctx context.Context, id string, req *client.SinkConsumerRequest) (*client.SinkConsumerResponse, error) {
+			sent = req
+			return simulateSinkConsumerAPI(t, req), nil
+		},
+	}
+	r := &SinkConsumerResource{client: mock}
+
+	plan := newRoundTripPlan(t, client.DestWebhook, map[string]attr.Value{
+		"http_endpoint_id": types.StringValue("billing"),
+	})
+	plan.ID = types.StringValue("sink-roundtrip")
+	planned := newResourceState(t, r, &plan)
+
+	resp := &resource.UpdateResponse{State: planned}
+	r.Update(ctx, resource.UpdateRequest{
+		Plan:   tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw},
+		Config: tfsdk.Config{Schema: planned.Schema, Raw: planned.Raw},
+		State:  planned,
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update() errors: %v", resp.Diagnostics.Errors())
+	}
+
+	if sent == nil || sent.Destination.HTTPEndpointID != endpointID {
+		t.Errorf("request destination = %+v, want the endpoint ID", sent)
+	}
+	var data SinkConsumerResourceModel
+	resp.State.Get(ctx, &data)
+	if got := httpEndpointID(data.Destination).ValueString(); got != "billing" {
+		t.Errorf("http_endpoint_id = %q, want the configured name kept", got)
+	}
+}
+
+func TestValidateDocumentIDColumns(t *testing.T) {
+	columns := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("sku")})
+	for destType, wantErrors := range map[string]int{"typesense": 0, "elasticsearch": 0, "kafka": 1} {
+		plan := newRoundTripPlan(t, destType, map[string]attr.Value{"document_id_columns": columns})
+		var diags diag.Diagnostics
+		validateDocumentIDColumns(plan.Destination, path.Root("destination"), &diags)
+		if diags.ErrorsCount() != wantErrors {
+			t.Errorf("%s: errors = %d, want %d", destType, diags.ErrorsCount(), wantErrors)
+		}
+	}
+}
+
+func TestSinkConsumerResource_ValidatePlannedDocumentIDColumns(t *testing.T) {
+	ctx := context.Background()
+	lookups := 0
+	mock := &clienttest.Mock{
+		ListTableColumnsFunc: func(ctx context.Context, databaseIDOrName, table string) ([]client.TableColumn, error) {
+			lookups++
+			if table != "public.orders" {
+				t.Errorf("table = %q, want public.orders", table)
+			}
+			return []client.TableColumn{{Name: "id", Type: "bigint"}, {Name: "sku", Type: "text"}}, nil
+		},
+	}
+	r := &SinkConsumerResource{client: mock}
+
+	run := func(columns []string, state tfsdk.State) *resource.ModifyPlanResponse {
+		values := make([]attr.Value, len(columns))
+		for i, column := range columns {
+			values[i] = types.StringValue(column)
+		}
+		model := newRoundTripPlan(t, client.DestTypesense, map[string]attr.Value{
+			"collection_name":     types.StringValue("orders"),
+			"document_id_columns": types.ListValueMust(types.StringType, values),
+		})
+		planned := newResourceState(t, r, &model)
+		plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		r.validatePlannedDocumentIDColumns(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+		return resp
+	}
+	noState := tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil)}
+
+	if resp := run([]string{"sku"}, noState); resp.Diagnostics.HasError() {
+		t.Errorf("existing column should pass, got: %v", resp.Diagnostics.Errors())
+	}
+
+	resp := run([]string{"sku", "skew"}, noState)
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("errors = %d, want one for the unknown column", resp.Diagnostics.ErrorsCount())
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "skew") {
+		t.Errorf("detail = %q, want the unknown column named", detail)
+	}
+
+	// Unchanged columns skip the lookup
+	model := newRoundTripPlan(t, client.DestTypesense, map[string]attr.Value{
+		"collection_name":     types.StringValue("orders"),
+		"document_id_columns": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("sku")}),
+	})
+	before := lookups
+	if resp := run([]string{"sku"}, newResourceState(t, r, &model)); resp.Diagnostics.HasError() {
+		t.Errorf("unchanged columns should not be rechecked, got: %v", resp.Diagnostics.Errors())
+	}
+	if lookups != before {
+		t.Errorf("unchanged columns made %d lookups, want 0", lookups-before)
+	}
+}
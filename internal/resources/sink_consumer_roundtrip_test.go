@@ -0,0 +1,515 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Round-trip tests push planned models through the same path as an apply:
+// plan -> API request -> JSON on the wire -> simulated API response -> state,
+// then refresh the state again. Every configured value must survive, and the
+// refresh must not produce a diff.
+
+// roundTripDestinationAttributes lists the destination attributes exercised
+// for each destination type. Every destination attribute must appear under at
+// least one type (see TestRoundTrip_CoversEveryDestinationAttribute).
+var roundTripDestinationAttributes = map[string][]string{
+	"kafka": {
+		"hosts", "topic", "tls", "username", "password", "sasl_mechanism",
+		"aws_region", "aws_access_key_id", "aws_secret_access_key", "partition_key_expression",
+	},
+	"sqs":          {"queue_url", "region", "access_key_id", "secret_access_key", "is_fifo", "credential_ref"},
+	"kinesis":      {"stream_arn", "region", "access_key_id", "secret_access_key", "aws_credentials_source"},
+	"webhook":      {"http_endpoint", "http_endpoint_id", "http_endpoint_path", "batch", "batch_format", "batch_max_bytes"},
+	"gcp_pubsub":   {"project_id", "topic_id", "gcp_credentials", "use_workload_identity"},
+	"redis_stream": {"hosts", "tls", "username", "password", "database_index", "stream_key", "max_len"},
+	"redis_string": {"hosts", "tls", "username", "password", "database_index", "ttl_seconds"},
+	"elasticsearch": {
+		"endpoint_url", "index_name", "auth_type", "api_key", "username", "password",
+		"document_id_columns", "auto_create_index", "index_settings",
+	},
+	"typesense":   {"endpoint_url", "collection_name", "api_key", "document_id_columns"},
+	"meilisearch": {"endpoint_url", "index_name", "api_key", "document_id_columns"},
+	"rabbitmq": {
+		"hosts", "tls", "username", "password", "virtual_host", "exchange", "exchange_type",
+		"durable", "auto_delete", "publisher_confirms", "headers",
+	},
+	"nats": {"hosts", "tls", "username", "password", "creds", "nkey_seed", "jwt"},
+	"azure_event_hub": {
+		"namespace", "event_hub_name", "shared_access_key_name", "shared_access_key", "managed_identity_client_id",
+	},
+}
+
+// roundTripIterations is the number of random attribute subsets tried per
+// destination type, in addition to the all-attributes and single-attribute cases
+const roundTripIterations = 25
+
+// simulateSinkConsumerAPI mimics the Sequin API accepting a request: the
+// request is echoed back with server defaults filled in and secrets withheld
+func simulateSinkConsumerAPI(t *testing.T, apiReq *client.SinkConsumerRequest) *client.SinkConsumerResponse {
+	t.Helper()
+
+	body, err := json.Marshal(apiReq)
+	if err != nil {
+		t.Fatalf("marshal request: %v", err)
+	}
+	var response client.SinkConsumerResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+
+	response.ID = "sink-roundtrip"
+	if response.Status == "" {
+		response.Status = "active"
+	}
+	for _, fn := range []*string{&response.Filter, &response.Transform, &response.Enrichment, &response.Routing} {
+		if *fn == "" {
+			*fn = "none"
+		}
+	}
+	if apiReq.BatchSize == nil {
+		response.BatchSize = 1
+	}
+	if apiReq.MessageGrouping == nil {
+		response.MessageGrouping = true
+	}
+	if response.LoadSheddingPolicy == "" {
+		response.LoadSheddingPolicy = "pause_on_full"
+	}
+	if response.TimestampFormat == "" {
+		response.TimestampFormat = "iso8601"
+	}
+	// The API reports the full default message shape
+	if response.MessageShape == nil {
+		response.MessageShape = &client.SinkConsumerMessageShape{}
+	}
+	if response.MessageShape.IncludeOldValues == nil {
+		includeOldValues := true
+		response.MessageShape.IncludeOldValues = &includeOldValues
+	}
+	response.StatusInfo = client.StatusResponse{
+		State:     "active",
+		CreatedAt: "2024-01-01T00:00:00Z",
+		UpdatedAt: "2024-01-01T00:00:00Z",
+	}
+
+	simulateDestinationAPI(&response.Destination)
+
+	// The API reports the failover defaults
+	if failover := response.FailoverDestination; failover != nil {
+		simulateDestinationAPI(&failover.SinkConsumerDestination)
+		if failover.UnavailableForSeconds == nil {
+			seconds := int64(300)
+			failover.UnavailableForSeconds = &seconds
+		}
+		if len(failover.Conditions) == 0 {
+			failover.Conditions = []string{"timeout", "connection_error"}
+		}
+		if failover.FailBack == nil {
+			failBack := true
+			failover.FailBack = &failBack
+		}
+	}
+
+	return &response
+}
+
+// simulateDestinationAPI applies the API's destination defaults and drops the
+// credentials it never returns
+func simulateDestinationAPI(dest *client.SinkConsumerDestination) {
+	// The API reports the default AWS credentials source
+	if dest.AWSCredentialsSource == "" && (dest.Type == "sqs" || dest.Type == "kinesis") {
+		dest.AWSCredentialsSource = "static"
+	}
+
+	// The API reports the default batch format for batched webhooks
+	if dest.Batch != nil && *dest.Batch && dest.BatchFormat == "" {
+		dest.BatchFormat = "json_array"
+	}
+
+	// The API never returns credentials
+	dest.Password = ""
+	dest.AWSAccessKeyID = ""
+	dest.AWSSecretAccessKey = ""
+	dest.AccessKeyID = ""
+	dest.SecretAccessKey = ""
+	dest.GCPCredentials = ""
+	dest.SharedAccessKey = ""
+	dest.Creds = ""
+	dest.NKeySeed = ""
+	dest.JWT = ""
+	dest.APIKey = ""
+}
+
+// newRoundTripPlan returns a planned sink consumer with the given destination
+// attributes set and every other destination attribute null
+func newRoundTripPlan(t *testing.T, destType string, destValues map[string]attr.Value) SinkConsumerResourceModel {
+	t.Helper()
+	ctx := context.Background()
+
+	destAttrs := make(map[string]attr.Value, len(destinationAttrTypes))
+	for name, attrType := range destinationAttrTypes {
+		destAttrs[name] = nullValue(t, attrType)
+	}
+	destAttrs["type"] = types.StringValue(destType)
+	for name, value := range destValues {
+		destAttrs[name] = value
+	}
+	destination, diags := types.ObjectValue(destinationAttrTypes, destAttrs)
+	if diags.HasError() {
+		t.Fatalf("destination: %v", diags.Errors())
+	}
+
+	tableType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":               types.StringType,
+		"group_column_names": types.ListType{ElemType: types.StringType},
+	}}
+	groupColumns, _ := types.ListValueFrom(ctx, types.StringType, []string{"id"})
+	table, _ := types.ObjectValue(tableType.AttrTypes, map[string]attr.Value{
+		"name":               types.StringValue("public.orders"),
+		"group_column_names": groupColumns,
+	})
+	tables, _ := types.ListValue(tableType, []attr.Value{table})
+	actions, _ := types.ListValueFrom(ctx, types.StringType, []string{"insert", "update", "delete"})
+
+	return SinkConsumerResourceModel{
+		ID:                      types.StringUnknown(),
+		Name:                    types.StringValue("roundtrip"),
+		Status:                  types.StringValue("active"),
+		Database:                types.StringValue("b3f1c2d4-5e6f-4a7b-8c9d-0e1f2a3b4c5d"),
+		DatabaseID:              types.StringValue("b3f1c2d4-5e6f-4a7b-8c9d-0e1f2a3b4c5d"),
+		Source:                  types.ObjectNull(map[string]attr.Type{"include_schemas": types.ListType{ElemType: types.StringType}, "exclude_schemas": types.ListType{ElemType: types.StringType}, "include_tables": types.ListType{ElemType: types.StringType}, "exclude_tables": types.ListType{ElemType: types.StringType}}),
+		Tables:                  tables,
+		Actions:                 actions,
+		Destination:             destination,
+		Filter:                  types.StringNull(),
+		Transform:               types.StringNull(),
+		FunctionVersion:         types.Int64Null(),
+		Enrichment:              types.StringNull(),
+		Routing:                 types.StringNull(),
+		MessageGrouping:         types.BoolValue(true),
+		BatchSize:               types.Int64Value(10),
+		MaxRetryCount:           types.Int64Null(),
+		LoadSheddingPolicy:      types.StringValue("pause_on_full"),
+		TimestampFormat:         types.StringValue("iso8601"),
+		MessageShape:            types.ObjectNull(messageShapeAttrTypes),
+		FailoverDestination:     types.ObjectNull(failoverDestinationAttrTypes),
+		BlueGreen:               types.ObjectNull(blueGreenAttrTypes),
+		CredentialVerifySeconds: types.Int64Null(),
+		CursorResetLSN:          types.StringNull(),
+		Annotations:             types.MapNull(types.StringType),
+		AnnotationsAll:          types.MapUnknown(types.StringType),
+		StatusInfo:              types.ObjectUnknown(map[string]attr.Type{"state": types.StringType, "created_at": types.StringType, "updated_at": types.StringType, "last_error": types.StringType}),
+		Timeouts:                types.ObjectNull(timeoutsAttrTypes),
+	}
+}
+
+// nullValue returns the null value of a destination attribute type
+func nullValue(t *testing.T, attrType attr.Type) attr.Value {
+	t.Helper()
+	switch attrType {
+	case types.StringType:
+		return types.StringNull()
+	case types.BoolType:
+		return types.BoolNull()
+	case types.Int64Type:
+		return types.Int64Null()
+	case types.MapType{ElemType: types.StringType}:
+		return types.MapNull(types.StringType)
+	case types.ListType{ElemType: types.StringType}:
+		return types.ListNull(types.StringType)
+	}
+	t.Fatalf("unsupported destination attribute type %s", attrType)
+	return nil
+}
+
+// sampleValue returns a non-null value for a destination attribute
+func sampleValue(t *testing.T, name string, rng *rand.Rand) attr.Value {
+	t.Helper()
+	switch destinationAttrTypes[name] {
+	case types.StringType:
+		return types.StringValue(fmt.Sprintf("%s-%d", name, rng.Intn(1000)))
+	case types.BoolType:
+		return types.BoolValue(rng.Intn(2) == 0)
+	case types.Int64Type:
+		return types.Int64Value(int64(rng.Intn(1000) + 1))
+	case types.ListType{ElemType: types.StringType}:
+		return types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue(fmt.Sprintf("%s_%d", name, rng.Intn(1000))),
+		})
+	case types.MapType{ElemType: types.StringType}:
+		return types.MapValueMust(types.StringType, map[string]attr.Value{
+			"x-source": types.StringValue(fmt.Sprintf("%s-%d", name, rng.Intn(1000))),
+		})
+	}
+	t.Fatalf("unsupported destination attribute %s", name)
+	return nil
+}
+
+// assertRoundTrip applies the plan and refreshes the result, failing on any
+// lost value or spurious diff
+func assertRoundTrip(t *testing.T, plan SinkConsumerResourceModel) {
+	t.Helper()
+	ctx := context.Background()
+	r := &SinkConsumerResource{}
+	diags := diag.Diagnostics{}
+
+	apiReq := buildSinkConsumerRequest(ctx, &plan, &diags)
+	apiReq.Database = plan.DatabaseID.ValueString()
+	apiReq.Status = plan.Status.ValueString()
+	apiReq.Annotations = mergeAnnotations(nil, plan.Annotations)
+	response := simulateSinkConsumerAPI(t, apiReq)
+
+	// Apply: state starts from the plan, as in Create
+	state := plan
+	r.mapResponseToModel(ctx, response, &state, &diags)
+	if diags.HasError() {
+		t.Fatalf("apply mapping errors: %v", diags.Errors())
+	}
+	assertModelsEqual(t, "apply", plan, state)
+
+	// Refresh: mapping the same response onto state must be a no-op
+	refreshed := state
+	r.mapResponseToModel(ctx, response, &refreshed, &diags)
+	if diags.HasError() {
+		t.Fatalf("refresh mapping errors: %v", diags.Errors())
+	}
+	assertModelsEqual(t, "refresh", state, refreshed)
+	if !state.StatusInfo.Equal(refreshed.StatusInfo) {
+		t.Errorf("refresh: status_info changed from %s to %s", state.StatusInfo, refreshed.StatusInfo)
+	}
+}
+
+// assertModelsEqual compares every configurable attribute of two models
+func assertModelsEqual(t *testing.T, phase string, want, got SinkConsumerResourceModel) {
+	t.Helper()
+
+	fields := map[string][2]attr.Value{
+		"name":                      {want.Name, got.Name},
+		"status":                    {want.Status, got.Status},
+		"database":                  {want.Database, got.Database},
+		"database_id":               {want.DatabaseID, got.DatabaseID},
+		"source":                    {want.Source, got.Source},
+		"tables":                    {want.Tables, got.Tables},
+		"actions":                   {want.Actions, got.Actions},
+		"filter":                    {want.Filter, got.Filter},
+		"transform":                 {want.Transform, got.Transform},
+		"function_version":          {want.FunctionVersion, got.FunctionVersion},
+		"enrichment":                {want.Enrichment, got.Enrichment},
+		"routing":                   {want.Routing, got.Routing},
+		"message_grouping":          {want.MessageGrouping, got.MessageGrouping},
+		"batch_size":                {want.BatchSize, got.BatchSize},
+		"max_retry_count":           {want.MaxRetryCount, got.MaxRetryCount},
+		"load_shedding_policy":      {want.LoadSheddingPolicy, got.LoadSheddingPolicy},
+		"timestamp_format":          {want.TimestampFormat, got.TimestampFormat},
+		"message_shape":             {want.MessageShape, got.MessageShape},
+		"failover_destination":      {want.FailoverDestination, got.FailoverDestination},
+		"blue_green":                {want.BlueGreen, got.BlueGreen},
+		"credential_verify_seconds": {want.CredentialVerifySeconds, got.CredentialVerifySeconds},
+		"cursor_reset_lsn":          {want.CursorResetLSN, got.CursorResetLSN},
+		"annotations":               {want.Annotations, got.Annotations},
+	}
+	for name, values := range fields {
+		if !values[0].Equal(values[1]) {
+			t.Errorf("%s: %s = %s, want %s", phase, name, values[1], values[0])
+		}
+	}
+
+	wantDest, gotDest := want.Destination.Attributes(), got.Destination.Attributes()
+	for name := range destinationAttrTypes {
+		if !wantDest[name].Equal(gotDest[name]) {
+			t.Errorf("%s: destination.%s = %s, want %s", phase, name, gotDest[name], wantDest[name])
+		}
+	}
+}
+
+func TestRoundTrip_CoversEveryDestinationAttribute(t *testing.T) {
+	covered := map[string]bool{"type": true}
+	for _, names := range roundTripDestinationAttributes {
+		for _, name := range names {
+			if _, ok := destinationAttrTypes[name]; !ok {
+				t.Errorf("round-trip attribute %s is not a destination attribute", name)
+			}
+			covered[name] = true
+		}
+	}
+	for name := range destinationAttrTypes {
+		if !covered[name] {
+			t.Errorf("destination attribute %s is not covered by any round-trip destination type", name)
+		}
+	}
+}
+
+func TestRoundTrip_DestinationPermutations(t *testing.T) {
+	destTypes := make([]string, 0, len(roundTripDestinationAttributes))
+	for destType := range roundTripDestinationAttributes {
+		destTypes = append(destTypes, destType)
+	}
+	sort.Strings(destTypes)
+
+	for _, destType := range destTypes {
+		names := roundTripDestinationAttributes[destType]
+		rng := rand.New(rand.NewSource(int64(len(destType))))
+
+		t.Run(destType+"/all", func(t *testing.T) {
+			values := make(map[string]attr.Value, len(names))
+			for _, name := range names {
+				values[name] = sampleValue(t, name, rng)
+			}
+			assertRoundTrip(t, newRoundTripPlan(t, destType, values))
+		})
+
+		for _, name := range names {
+			t.Run(destType+"/only_"+name, func(t *testing.T) {
+				assertRoundTrip(t, newRoundTripPlan(t, destType, map[string]attr.Value{
+					name: sampleValue(t, name, rng),
+				}))
+			})
+		}
+
+		for i := 0; i < roundTripIterations; i++ {
+			t.Run(fmt.Sprintf("%s/random_%d", destType, i), func(t *testing.T) {
+				values := make(map[string]attr.Value)
+				for _, name := range names {
+					if rng.Intn(2) == 0 {
+						values[name] = sampleValue(t, name, rng)
+					}
+				}
+				assertRoundTrip(t, newRoundTripPlan(t, destType, values))
+			})
+		}
+	}
+}
+
+func TestRoundTrip_TopLevelOptionalFields(t *testing.T) {
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(1))
+
+	plan := newRoundTripPlan(t, "webhook", map[string]attr.Value{
+		"http_endpoint": sampleValue(t, "http_endpoint", rng),
+	})
+	includeTables, _ := types.ListValueFrom(ctx, types.StringType, []string{"public.orders"})
+	plan.Source, _ = types.ObjectValue(plan.Source.AttributeTypes(ctx), map[string]attr.Value{
+		"include_schemas": types.ListNull(types.StringType),
+		"exclude_schemas": types.ListNull(types.StringType),
+		"include_tables":  includeTables,
+		"exclude_tables":  types.ListNull(types.StringType),
+	})
+	plan.Filter = types.StringValue("only_paid")
+	plan.Transform = types.StringValue("reshape")
+	plan.Enrichment = types.StringValue("add_customer")
+	plan.Routing = types.StringValue("by_region")
+	plan.MessageGrouping = types.BoolValue(false)
+	plan.MaxRetryCount = types.Int64Value(3)
+	plan.LoadSheddingPolicy = types.StringValue("discard_on_full")
+	plan.TimestampFormat = types.StringValue("unix_microsecond")
+	plan.Status = types.StringValue("paused")
+
+	assertRoundTrip(t, plan)
+}
+
+func TestRoundTrip_PinnedFunctionVersion(t *testing.T) {
+	plan := newRoundTripPlan(t, "webhook", map[string]attr.Value{
+		"http_endpoint": types.StringValue("https://api.example.com"),
+	})
+	plan.Transform = types.StringValue("reshape-order")
+	plan.FunctionVersion = types.Int64Value(3)
+	assertRoundTrip(t, plan)
+}
+
+func TestRoundTrip_CursorResetLSN(t *testing.T) {
+	plan := newRoundTripPlan(t, "webhook", map[string]attr.Value{
+		"http_endpoint": types.StringValue("https://api.example.com"),
+	})
+	plan.CursorResetLSN = types.StringValue("0/16B3748")
+	assertRoundTrip(t, plan)
+}
+
+func TestRoundTrip_MessageShape(t *testing.T) {
+	ctx := context.Background()
+	list := func(values ...string) types.List {
+		l, _ := types.ListValueFrom(ctx, types.StringType, values)
+		return l
+	}
+
+	for name, attrs := range map[string]map[string]attr.Value{
+		"include_columns": {
+			"include_columns":    list("id", "public.orders.total"),
+			"exclude_columns":    types.ListNull(types.StringType),
+			"include_old_values": types.BoolValue(false),
+			"metadata_fields":    list("table_name", "commit_timestamp"),
+		},
+		"exclude_columns": {
+			"include_columns":    types.ListNull(types.StringType),
+			"exclude_columns":    list("public.users.password_hash"),
+			"include_old_values": types.BoolNull(),
+			"metadata_fields":    types.ListNull(types.StringType),
+		},
+		"default_old_values": {
+			"include_columns":    types.ListNull(types.StringType),
+			"exclude_columns":    types.ListNull(types.StringType),
+			"include_old_values": types.BoolValue(true),
+			"metadata_fields":    types.ListNull(types.StringType),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			plan := newRoundTripPlan(t, "webhook", map[string]attr.Value{
+				"http_endpoint": types.StringValue("https://api.example.com"),
+			})
+			plan.MessageShape = types.ObjectValueMust(messageShapeAttrTypes, attrs)
+			assertRoundTrip(t, plan)
+		})
+	}
+}
+
+func TestRoundTrip_FailoverDestination(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for destType, names := range roundTripDestinationAttributes {
+		for _, policy := range []map[string]attr.Value{
+			{
+				"unavailable_for_seconds": types.Int64Null(),
+				"conditions":              types.ListNull(types.StringType),
+				"fail_back":               types.BoolNull(),
+			},
+			{
+				"unavailable_for_seconds": types.Int64Value(300),
+				"conditions":              types.ListValueMust(types.StringType, []attr.Value{types.StringValue("connection_error"), types.StringValue("timeout")}),
+				"fail_back":               types.BoolValue(false),
+			},
+		} {
+			t.Run(destType, func(t *testing.T) {
+				attrs := make(map[string]attr.Value, len(failoverDestinationAttrTypes))
+				for name, attrType := range destinationAttrTypes {
+					attrs[name] = nullValue(t, attrType)
+				}
+				attrs["type"] = types.StringValue(destType)
+				for _, name := range names {
+					attrs[name] = sampleValue(t, name, rng)
+				}
+				for name, value := range policy {
+					attrs[name] = value
+				}
+
+				plan := newRoundTripPlan(t, "webhook", map[string]attr.Value{
+					"http_endpoint": types.StringValue("https://api.example.com"),
+				})
+				var diags diag.Diagnostics
+				plan.FailoverDestination, diags = types.ObjectValue(failoverDestinationAttrTypes, attrs)
+				if diags.HasError() {
+					t.Fatalf("failover object: %v", diags)
+				}
+				assertRoundTrip(t, plan)
+			})
+		}
+	}
+}
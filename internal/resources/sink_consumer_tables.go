@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Sinks may stream thousands of tables, so the conversions in this file build
+// framework values directly instead of through the reflection-based
+// ListValueFrom and ElementsAs, which dominate plan time for large sinks.
+
+// sourceAttrTypes describes the sink consumer source object
+var sourceAttrTypes = map[string]attr.Type{
+	"include_schemas": types.ListType{ElemType: types.StringType},
+	"exclude_schemas": types.ListType{ElemType: types.StringType},
+	"include_tables":  types.ListType{ElemType: types.StringType},
+	"exclude_tables":  types.ListType{ElemType: types.StringType},
+}
+
+// tableAttrTypes describes an element of the sink consumer tables list
+var tableAttrTypes = map[string]attr.Type{
+	"name":               types.StringType,
+	"group_column_names": types.ListType{ElemType: types.StringType},
+}
+
+// tableObjectType is the element type of the sink consumer tables list
+var tableObjectType = types.ObjectType{AttrTypes: tableAttrTypes}
+
+// stringListValue converts strings into a list value, or a null list when
+// there are none
+func stringListValue(values []string) types.List {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elems[i] = types.StringValue(v)
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
+// listStrings returns the strings of a list value, or nil when it is null or
+// unknown
+func listStrings(list types.List) []string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	elems := list.Elements()
+	values := make([]string, len(elems))
+	for i, elem := range elems {
+		if s, ok := elem.(types.String); ok {
+			values[i] = s.ValueString()
+		}
+	}
+	return values
+}
+
+// mapSourceToObject converts the API source into the source object. A source
+// without filters maps to null to avoid drift.
+func mapSourceToObject(source *client.SinkConsumerSource) types.Object {
+	if source == nil || (len(source.IncludeSchemas) == 0 && len(source.ExcludeSchemas) == 0 &&
+		len(source.IncludeTables) == 0 && len(source.ExcludeTables) == 0) {
+		return types.ObjectNull(sourceAttrTypes)
+	}
+
+	return types.ObjectValueMust(sourceAttrTypes, map[string]attr.Value{
+		"include_schemas": stringListValue(source.IncludeSchemas),
+		"exclude_schemas": stringListValue(source.ExcludeSchemas),
+		"include_tables":  stringListValue(source.IncludeTables),
+		"exclude_tables":  stringListValue(source.ExcludeTables),
+	})
+}
+
+// buildSourceRequest converts the source object into its API representation,
+// or nil when it is null
+func buildSourceRequest(source types.Object) *client.SinkConsumerSource {
+	if source.IsNull() {
+		return nil
+	}
+
+	attrs := source.Attributes()
+	result := &client.SinkConsumerSource{}
+	for name, target := range map[string]*[]string{
+		"include_schemas": &result.IncludeSchemas,
+		"exclude_schemas": &result.ExcludeSchemas,
+		"include_tables":  &result.IncludeTables,
+		"exclude_tables":  &result.ExcludeTables,
+	} {
+		if list, ok := attrs[name].(types.List); ok && !list.IsNull() {
+			*target = listStrings(list)
+		}
+	}
+	return result
+}
+
+// mapTablesToList converts the API tables into the tables list
+func mapTablesToList(tables []client.SinkConsumerTable) types.List {
+	elems := make([]attr.Value, len(tables))
+	for i, table := range tables {
+		elems[i] = types.ObjectValueMust(tableAttrTypes, map[string]attr.Value{
+			"name":               types.StringValue(table.Name),
+			"group_column_names": stringListValue(table.GroupColumnNames),
+		})
+	}
+	return types.ListValueMust(tableObjectType, elems)
+}
+
+// buildTablesRequest converts the tables list into its API representation
+func buildTablesRequest(tables types.List) []client.SinkConsumerTable {
+	if tables.IsNull() || tables.IsUnknown() {
+		return make([]client.SinkConsumerTable, 0)
+	}
+
+	elems := tables.Elements()
+	result := make([]client.SinkConsumerTable, len(elems))
+	for i, elem := range elems {
+		obj, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		attrs := obj.Attributes()
+		if name, ok := attrs["name"].(types.String); ok {
+			result[i].Name = name.ValueString()
+		}
+		if groupCols, ok := attrs["group_column_names"].(types.List); ok && !groupCols.IsNull() {
+			result[i].GroupColumnNames = listStrings(groupCols)
+		}
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// manyTablesResponse returns a sink streaming n tables, every other one with
+// group columns
+func manyTablesResponse(n int) *client.SinkConsumerResponse {
+	response := &client.SinkConsumerResponse{
+		ID:     "sink-1",
+		Name:   "big-sink",
+		Source: &client.SinkConsumerSource{IncludeSchemas: []string{"public"}},
+	}
+	for i := 0; i < n; i++ {
+		table := client.SinkConsumerTable{Name: fmt.Sprintf("public.table_%d", i)}
+		if i%2 == 0 {
+			table.GroupColumnNames = []string{"tenant_id", "id"}
+		}
+		response.Tables = append(response.Tables, table)
+		response.Source.IncludeTables = append(response.Source.IncludeTables, table.Name)
+	}
+	return response
+}
+
+func TestSinkConsumerTablesRoundTrip(t *testing.T) {
+	response := manyTablesResponse(3)
+
+	tables := buildTablesRequest(mapTablesToList(response.Tables))
+	if !reflect.DeepEqual(tables, response.Tables) {
+		t.Errorf("tables = %+v, want %+v", tables, response.Tables)
+	}
+	source := buildSourceRequest(mapSourceToObject(response.Source))
+	if !reflect.DeepEqual(source, response.Source) {
+		t.Errorf("source = %+v, want %+v", source, response.Source)
+	}
+
+	if !mapSourceToObject(&client.SinkConsumerSource{}).IsNull() {
+		t.Error("a source without filters should map to null")
+	}
+	if tables := buildTablesRequest(mapTablesToList(nil)); tables == nil || len(tables) != 0 {
+		t.Errorf("no tables should build an empty list, got %#v", tables)
+	}
+}
+
+func BenchmarkSinkConsumerMapResponseToModel(b *testing.B) {
+	ctx := context.Background()
+	r := &SinkConsumerResource{}
+	response := manyTablesResponse(5000)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var model SinkConsumerResourceModel
+		var diags diag.Diagnostics
+		r.mapResponseToModel(ctx, response, &model, &diags)
+		if diags.HasError() {
+			b.Fatal(diags.Errors())
+		}
+	}
+}
+
+func BenchmarkBuildSinkConsumerRequest(b *testing.B) {
+	ctx := context.Background()
+	var model SinkConsumerResourceModel
+	var diags diag.Diagnostics
+	(&SinkConsumerResource{}).mapResponseToModel(ctx, manyTablesResponse(5000), &model, &diags)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buildSinkConsumerRequest(ctx, &model, &diags)
+		if diags.HasError() {
+			b.Fatal(diags.Errors())
+		}
+	}
+}
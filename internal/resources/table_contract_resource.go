@@ -0,0 +1,455 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// qualifiedTablePattern matches schema-qualified table names such as public.orders
+var qualifiedTablePattern = regexp.MustCompile(`^[^.\s]+\.[^.\s]+$`)
+
+// Ensure the implementation satisfies expected interfaces
+var (
+	_ resource.Resource                = &TableContractResource{}
+	_ resource.ResourceWithConfigure   = &TableContractResource{}
+	_ resource.ResourceWithImportState = &TableContractResource{}
+)
+
+// TableContractResource defines the resource implementation
+type TableContractResource struct {
+	client client.SequinAPI
+}
+
+// TableContractResourceModel describes the resource data model
+type TableContractResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Database          types.String `tfsdk:"database"`
+	Table             types.String `tfsdk:"table"`
+	Columns           types.List   `tfsdk:"columns"`
+	AllowExtraColumns types.Bool   `tfsdk:"allow_extra_columns"`
+	Status            types.String `tfsdk:"status"`
+	Violations        types.List   `tfsdk:"violations"`
+	CheckedAt         types.String `tfsdk:"checked_at"`
+}
+
+// TableContractColumnModel describes an expected column
+type TableContractColumnModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Nullable types.Bool   `tfsdk:"nullable"`
+}
+
+// tableContractColumnAttrTypes describes the expected column object
+var tableContractColumnAttrTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"type":     types.StringType,
+	"nullable": types.BoolType,
+}
+
+// tableContractViolationAttrTypes describes the violation object
+var tableContractViolationAttrTypes = map[string]attr.Type{
+	"column":   types.StringType,
+	"expected": types.StringType,
+	"actual":   types.StringType,
+	"message":  types.StringType,
+}
+
+// NewTableContractResource creates a new resource
+func NewTableContractResource() resource.Resource {
+	return &TableContractResource{}
+}
+
+// Metadata returns the resource type name
+func (r *TableContractResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_table_contract"
+}
+
+// Schema defines the resource schema
+func (r *TableContractResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Declares the columns and types a streamed table is expected to have. Sequin checks the source database against the contract and reports schema drift as errors on the sinks streaming the table.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the table contract.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Name or ID of the database connection the table belongs to. Changing this forces a new contract.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table": schema.StringAttribute{
+				Description: "Schema-qualified table name, e.g. public.orders. Changing this forces a new contract.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(qualifiedTablePattern, "must be a schema-qualified table name such as public.orders"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"columns": schema.ListNestedAttribute{
+				Description: "Columns the table must have.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Column name.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Postgres column type as reported by format_type, e.g. text, bigint, timestamp with time zone. Compared case-insensitively.",
+							Required:    true,
+						},
+						"nullable": schema.BoolAttribute{
+							Description: "Whether the column must allow NULL. Nullability is not checked when omitted.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"allow_extra_columns": schema.BoolAttribute{
+				Description: "Whether columns not listed in the contract are allowed. Uses the server default when omitted.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Result of the last check: satisfied, violated, pending.",
+				Computed:    true,
+			},
+			"violations": schema.ListNestedAttribute{
+				Description: "Columns that no longer match the contract as of the last check.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"column": schema.StringAttribute{
+							Description: "Column name.",
+							Computed:    true,
+						},
+						"expected": schema.StringAttribute{
+							Description: "Expected type, if the type differs.",
+							Computed:    true,
+						},
+						"actual": schema.StringAttribute{
+							Description: "Actual type, or null when the column is missing.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "Human-readable description of the violation.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"checked_at": schema.StringAttribute{
+				Description: "ISO 8601 timestamp of the last check.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the resource
+func (r *TableContractResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SequinAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SequinAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new table contract
+func (r *TableContractResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = traceOperation(ctx, "sequin_table_contract", "create")
+
+	var data TableContractResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := buildTableContractRequest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateTableContract(ctx, createReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Creating Table Contract", "Could not create table contract for "+createReq.Table, err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapTableContractToModel(ctx, created, &data)...)
+	addTableContractViolationWarning(created, &resp.Diagnostics)
+
+	privateState := &resourcePrivateState{ETag: created.ETag}
+	privateState.markCreated()
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	rollbackCreate(ctx, r.client.CreateRollbackSkipped(), "table contract", created.ID, &resp.Diagnostics, &resp.State, func(ctx context.Context) error {
+		return r.client.DeleteTableContract(ctx, created.ID)
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created table contract resource", map[string]any{"id": data.ID.ValueString()})
+}
+
+// Read refreshes the Terraform state with the latest data from the API
+func (r *TableContractResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = traceOperation(ctx, "sequin_table_contract", "read")
+
+	var data TableContractResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	contractID := data.ID.ValueString()
+	contract, err := getWithCreateGrace(ctx, privateState, contractID, func() (*client.TableContractResponse, error) {
+		return r.client.GetTableContract(ctx, contractID)
+	})
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Table contract not found, removing from state", map[string]any{"id": contractID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Table Contract",
+			"Could not read table contract ID "+contractID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapTableContractToModel(ctx, contract, &data)...)
+	addTableContractViolationWarning(contract, &resp.Diagnostics)
+
+	privateState.recordVersion(contract)
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates an existing table contract
+func (r *TableContractResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = traceOperation(ctx, "sequin_table_contract", "update")
+
+	var plan, state TableContractResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := buildTableContractRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contractID := state.ID.ValueString()
+	privateState, diags := readPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	updated, err := updateWithConflictRetry(ctx, contractID, privateState,
+		func(ctx context.Context) (*client.TableContractResponse, error) {
+			return r.client.GetTableContract(ctx, contractID)
+		},
+		func(ctx context.Context) (*client.TableContractResponse, error) {
+			return r.client.UpdateTableContract(ctx, contractID, updateReq)
+		},
+	)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating Table Contract", "Could not update table contract ID "+contractID, err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapTableContractToModel(ctx, updated, &plan)...)
+	addTableContractViolationWarning(updated, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(writePrivateState(ctx, resp.Private, privateState)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Updated table contract resource", map[string]any{"id": contractID})
+}
+
+// Delete deletes a table contract
+func (r *TableContractResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = traceOperation(ctx, "sequin_table_contract", "delete")
+
+	var data TableContractResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contractID := data.ID.ValueString()
+	if err := r.client.DeleteTableContract(ctx, contractID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Table Contract",
+			"Could not delete table contract ID "+contractID+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted table contract", map[string]any{"id": contractID})
+}
+
+// ImportState imports an existing table contract by ID
+func (r *TableContractResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// buildTableContractRequest converts the model into an API request
+func buildTableContractRequest(ctx context.Context, data *TableContractResourceModel, diags *diag.Diagnostics) *client.TableContractRequest {
+	req := &client.TableContractRequest{
+		Database: data.Database.ValueString(),
+		Table:    data.Table.ValueString(),
+		Columns:  []client.TableContractColumn{},
+	}
+	if !data.AllowExtraColumns.IsNull() && !data.AllowExtraColumns.IsUnknown() {
+		allow := data.AllowExtraColumns.ValueBool()
+		req.AllowExtraColumns = &allow
+	}
+
+	var columns []TableContractColumnModel
+	diags.Append(data.Columns.ElementsAs(ctx, &columns, false)...)
+	for _, column := range columns {
+		apiColumn := client.TableContractColumn{
+			Name: column.Name.ValueString(),
+			Type: column.Type.ValueString(),
+		}
+		if !column.Nullable.IsNull() && !column.Nullable.IsUnknown() {
+			nullable := column.Nullable.ValueBool()
+			apiColumn.Nullable = &nullable
+		}
+		req.Columns = append(req.Columns, apiColumn)
+	}
+	return req
+}
+
+// mapTableContractToModel maps the API response to the Terraform model
+func mapTableContractToModel(ctx context.Context, contract *client.TableContractResponse, data *TableContractResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(contract.ID)
+	data.Table = types.StringValue(contract.Table)
+	data.AllowExtraColumns = types.BoolValue(contract.AllowExtraColumns)
+	data.Status = types.StringValue(contract.Status)
+	data.CheckedAt = optionalString(contract.CheckedAt)
+
+	// Keep the configured name or ID; the API may echo back either form.
+	// Only fill it in when empty, e.g. after import.
+	if data.Database.IsNull() || data.Database.IsUnknown() || data.Database.ValueString() == "" {
+		data.Database = types.StringValue(contract.Database)
+	}
+
+	// The API normalises type names; keep the configured spelling when it only
+	// differs in case
+	var prior []TableContractColumnModel
+	if !data.Columns.IsNull() && !data.Columns.IsUnknown() {
+		diags.Append(data.Columns.ElementsAs(ctx, &prior, false)...)
+	}
+	columns := make([]attr.Value, 0, len(contract.Columns))
+	for i, column := range contract.Columns {
+		columnType := types.StringValue(column.Type)
+		if i < len(prior) && prior[i].Name.ValueString() == column.Name && strings.EqualFold(prior[i].Type.ValueString(), column.Type) {
+			columnType = prior[i].Type
+		}
+		nullable := types.BoolNull()
+		if column.Nullable != nil {
+			nullable = types.BoolValue(*column.Nullable)
+		}
+		obj, d := types.ObjectValue(tableContractColumnAttrTypes, map[string]attr.Value{
+			"name":     types.StringValue(column.Name),
+			"type":     columnType,
+			"nullable": nullable,
+		})
+		diags.Append(d...)
+		columns = append(columns, obj)
+	}
+	list, d := types.ListValue(types.ObjectType{AttrTypes: tableContractColumnAttrTypes}, columns)
+	diags.Append(d...)
+	data.Columns = list
+
+	// Violations are always a list so length() checks work
+	violations := make([]attr.Value, 0, len(contract.Violations))
+	for _, violation := range contract.Violations {
+		obj, d := types.ObjectValue(tableContractViolationAttrTypes, map[string]attr.Value{
+			"column":   types.StringValue(violation.Column),
+			"expected": optionalString(violation.Expected),
+			"actual":   optionalString(violation.Actual),
+			"message":  types.StringValue(violation.Message),
+		})
+		diags.Append(d...)
+		violations = append(violations, obj)
+	}
+	list, d = types.ListValue(types.ObjectType{AttrTypes: tableContractViolationAttrTypes}, violations)
+	diags.Append(d...)
+	data.Violations = list
+
+	return diags
+}
+
+// addTableContractViolationWarning reports schema drift on the source table
+// as a warning so it shows up in plan and apply output
+func addTableContractViolationWarning(contract *client.TableContractResponse, diags *diag.Diagnostics) {
+	if contract.Status != "violated" {
+		return
+	}
+
+	details := make([]string, 0, len(contract.Violations))
+	for _, violation := range contract.Violations {
+		details = append(details, "  - "+violation.Message)
+	}
+	diags.AddWarning(
+		"Table Contract Violated",
+		fmt.Sprintf("The schema of %s no longer matches its contract. Sinks streaming this table report these violations as errors:\n%s",
+			contract.Table, strings.Join(details, "\n")),
+	)
+}
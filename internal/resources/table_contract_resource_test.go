@@ -0,0 +1,172 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func tableContractColumns(columns ...map[string]attr.Value) types.List {
+	values := make([]attr.Value, 0, len(columns))
+	for _, column := range columns {
+		values = append(values, types.ObjectValueMust(tableContractColumnAttrTypes, column))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: tableContractColumnAttrTypes}, values)
+}
+
+func TestTableContractResource_Metadata(t *testing.T) {
+	r := NewTableContractResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "sequin"}, resp)
+
+	if resp.TypeName != "sequin_table_contract" {
+		t.Errorf("TypeName = %q, want sequin_table_contract", resp.TypeName)
+	}
+}
+
+func TestTableContractResource_Schema(t *testing.T) {
+	r := NewTableContractResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() error: %v", resp.Diagnostics.Errors())
+	}
+	for _, attr := range []string{"id", "database", "table", "columns", "allow_extra_columns", "status", "violations", "checked_at"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Schema() missing attribute: %s", attr)
+		}
+	}
+}
+
+func TestQualifiedTablePattern(t *testing.T) {
+	for table, want := range map[string]bool{
+		"public.orders": true,
+		"orders":        false,
+		"a.b.c":         false,
+		"public.":       false,
+		"public. x":     false,
+	} {
+		if got := qualifiedTablePattern.MatchString(table); got != want {
+			t.Errorf("qualifiedTablePattern.MatchString(%q) = %v, want %v", table, got, want)
+		}
+	}
+}
+
+func TestBuildTableContractRequest(t *testing.T) {
+	var diags diag.Diagnostics
+
+	req := buildTableContractRequest(context.Background(), &TableContractResourceModel{
+		Database: types.StringValue("production"),
+		Table:    types.StringValue("public.orders"),
+		Columns: tableContractColumns(
+			map[string]attr.Value{"name": types.StringValue("id"), "type": types.StringValue("bigint"), "nullable": types.BoolValue(false)},
+			map[string]attr.Value{"name": types.StringValue("note"), "type": types.StringValue("text"), "nullable": types.BoolNull()},
+		),
+		AllowExtraColumns: types.BoolUnknown(),
+	}, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.Database != "production" || req.Table != "public.orders" {
+		t.Errorf("req = %+v, want production public.orders", req)
+	}
+	if req.AllowExtraColumns != nil {
+		t.Error("unknown allow_extra_columns should be omitted")
+	}
+	if len(req.Columns) != 2 {
+		t.Fatalf("Columns = %+v, want 2", req.Columns)
+	}
+	if req.Columns[0].Nullable == nil || *req.Columns[0].Nullable {
+		t.Error("nullable = false should be sent explicitly")
+	}
+	if req.Columns[1].Nullable != nil {
+		t.Error("unset nullable should be omitted so it is not checked")
+	}
+}
+
+func TestMapTableContractToModel(t *testing.T) {
+	ctx := context.Background()
+	notNull := false
+	response := &client.TableContractResponse{
+		ID:       "tc-1",
+		Database: "9f1c7e2a-db-id",
+		Table:    "public.orders",
+		Columns: []client.TableContractColumn{
+			{Name: "id", Type: "bigint", Nullable: &notNull},
+			{Name: "total", Type: "numeric"},
+		},
+		AllowExtraColumns: true,
+		Status:            "violated",
+		Violations: []client.TableContractViolation{
+			{Column: "total", Expected: "numeric", Actual: "text", Message: "total is text, expected numeric"},
+		},
+	}
+
+	data := TableContractResourceModel{
+		Database: types.StringValue("production"),
+		Columns: tableContractColumns(
+			map[string]attr.Value{"name": types.StringValue("id"), "type": types.StringValue("BIGINT"), "nullable": types.BoolValue(false)},
+			map[string]attr.Value{"name": types.StringValue("total"), "type": types.StringValue("numeric"), "nullable": types.BoolNull()},
+		),
+	}
+	if diags := mapTableContractToModel(ctx, response, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if data.Database.ValueString() != "production" {
+		t.Errorf("Database = %q, want configured name kept", data.Database.ValueString())
+	}
+	var columns []TableContractColumnModel
+	data.Columns.ElementsAs(ctx, &columns, false)
+	if columns[0].Type.ValueString() != "BIGINT" {
+		t.Errorf("Type = %q, want configured spelling kept", columns[0].Type.ValueString())
+	}
+	if !columns[1].Nullable.IsNull() {
+		t.Error("nullable should stay null when not checked")
+	}
+	if len(data.Violations.Elements()) != 1 {
+		t.Errorf("Violations = %v, want one violation", data.Violations)
+	}
+	if !data.CheckedAt.IsNull() {
+		t.Error("CheckedAt should be null when the API omits it")
+	}
+
+	var imported TableContractResourceModel
+	mapTableContractToModel(ctx, &client.TableContractResponse{ID: "tc-1", Database: "9f1c7e2a-db-id", Status: "satisfied"}, &imported)
+	if imported.Database.ValueString() != "9f1c7e2a-db-id" {
+		t.Errorf("Database = %q, want API value on import", imported.Database.ValueString())
+	}
+	if imported.Violations.IsNull() || len(imported.Violations.Elements()) != 0 {
+		t.Error("Violations should be an empty list when the contract is satisfied")
+	}
+}
+
+func TestAddTableContractViolationWarning(t *testing.T) {
+	var diags diag.Diagnostics
+	addTableContractViolationWarning(&client.TableContractResponse{Table: "public.orders", Status: "satisfied"}, &diags)
+	if len(diags) != 0 {
+		t.Errorf("satisfied contract produced diagnostics: %v", diags)
+	}
+
+	addTableContractViolationWarning(&client.TableContractResponse{
+		Table:      "public.orders",
+		Status:     "violated",
+		Violations: []client.TableContractViolation{{Column: "total", Message: "column total is missing"}},
+	}, &diags)
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("diags = %v, want a single warning", diags)
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "public.orders") || !strings.Contains(detail, "column total is missing") {
+		t.Errorf("warning detail = %q, want table and violation", detail)
+	}
+}
@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Operations a timeouts block can bound
+const (
+	operationCreate = "create"
+	operationRead   = "read"
+	operationUpdate = "update"
+	operationDelete = "delete"
+)
+
+// durationPattern matches Go durations such as 90s or 1h30m
+var durationPattern = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
+// timeoutsAttrTypes describes the timeouts object
+var timeoutsAttrTypes = map[string]attr.Type{
+	operationCreate: types.StringType,
+	operationRead:   types.StringType,
+	operationUpdate: types.StringType,
+	operationDelete: types.StringType,
+}
+
+// timeoutsAttribute returns the schema of the timeouts attribute of resources
+// whose operations can outlast the provider's request_timeout
+func timeoutsAttribute() schema.SingleNestedAttribute {
+	attributes := map[string]schema.Attribute{}
+	for _, operation := range []string{operationCreate, operationRead, operationUpdate, operationDelete} {
+		attributes[operation] = schema.StringAttribute{
+			Description: fmt.Sprintf("Time the %s operation may take, such as 10m. Each API request it makes may take as long, "+
+				"instead of the provider's request_timeout. Unset uses request_timeout per request and no overall limit.", operation),
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(durationPattern, "must be a duration such as 30s or 10m"),
+			},
+		}
+	}
+	return schema.SingleNestedAttribute{
+		Description: "Per-operation timeouts.",
+		Optional:    true,
+		Attributes:  attributes,
+	}
+}
+
+// operationTimeout returns the configured timeout of an operation, or zero
+// when it is unset
+func operationTimeout(timeouts types.Object, operation string) time.Duration {
+	if timeouts.IsNull() || timeouts.IsUnknown() {
+		return 0
+	}
+	value, ok := timeouts.Attributes()[operation].(types.String)
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return 0
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// withOperationTimeout bounds an operation by its configured timeout, which
+// also replaces the provider's request_timeout for each of its API requests.
+// The returned cancel function must be called when the operation ends.
+func withOperationTimeout(ctx context.Context, timeouts types.Object, operation string) (context.Context, context.CancelFunc) {
+	d := operationTimeout(timeouts, operation)
+	if d <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(client.WithRequestTimeout(ctx, d), d)
+}
@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOperationTimeout(t *testing.T) {
+	timeouts := types.ObjectValueMust(timeoutsAttrTypes, map[string]attr.Value{
+		operationCreate: types.StringValue("20m"),
+		operationRead:   types.StringNull(),
+		operationUpdate: types.StringValue("1h30m"),
+		operationDelete: types.StringValue("soon"),
+	})
+
+	tests := []struct {
+		timeouts  types.Object
+		operation string
+		want      time.Duration
+	}{
+		{timeouts, operationCreate, 20 * time.Minute},
+		{timeouts, operationRead, 0},
+		{timeouts, operationUpdate, 90 * time.Minute},
+		{timeouts, operationDelete, 0},
+		{types.ObjectNull(timeoutsAttrTypes), operationCreate, 0},
+	}
+	for _, tt := range tests {
+		if got := operationTimeout(tt.timeouts, tt.operation); got != tt.want {
+			t.Errorf("operationTimeout(%s) = %s, want %s", tt.operation, got, tt.want)
+		}
+	}
+}
+
+func TestWithOperationTimeout(t *testing.T) {
+	timeouts := types.ObjectValueMust(timeoutsAttrTypes, map[string]attr.Value{
+		operationCreate: types.StringValue("20m"),
+		operationRead:   types.StringNull(),
+		operationUpdate: types.StringNull(),
+		operationDelete: types.StringNull(),
+	})
+
+	ctx, cancel := withOperationTimeout(context.Background(), timeouts, operationCreate)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > 20*time.Minute || time.Until(deadline) < 19*time.Minute {
+		t.Errorf("deadline = %v, want about 20m away", deadline)
+	}
+
+	ctx, cancel = withOperationTimeout(context.Background(), timeouts, operationRead)
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("an unset timeout should leave the operation unbounded")
+	}
+}
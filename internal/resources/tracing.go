@@ -0,0 +1,13 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/clintdigital/terraform-provider-sequin/internal/client"
+)
+
+// traceOperation tags the context of a resource operation that calls the API,
+// so its log lines and request logs share an operation ID
+func traceOperation(ctx context.Context, resourceType, operation string) context.Context {
+	return client.WithOperation(ctx, resourceType+"."+operation)
+}
@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestTraceOperation(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	ctx = traceOperation(ctx, "sequin_database", "create")
+	tflog.Debug(ctx, "Making API request")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("decode log: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("log entries = %d, want 2", len(entries))
+	}
+	id, _ := entries[0]["operation_id"].(string)
+	if len(id) != 16 {
+		t.Errorf("operation_id = %q, want 16 hex characters", id)
+	}
+	if entries[1]["operation_id"] != id || entries[1]["operation"] != "sequin_database.create" {
+		t.Errorf("later log lines should carry the operation, got %v", entries[1])
+	}
+}